@@ -0,0 +1,51 @@
+resource "mongodbatlas_app_services_secret" "webhook_token" {
+  project_id = var.project_id
+  app_id     = var.app_id
+  name       = "webhookToken"
+  value      = var.webhook_token
+}
+
+resource "mongodbatlas_app_services_value" "webhook_token" {
+  project_id  = var.project_id
+  app_id      = var.app_id
+  name        = "webhookToken"
+  value       = jsonencode(mongodbatlas_app_services_secret.webhook_token.name)
+  from_secret = true
+  private     = true
+}
+
+resource "mongodbatlas_app_services_value" "webhook_config" {
+  project_id = var.project_id
+  app_id     = var.app_id
+  name       = "webhookConfig"
+  value = jsonencode({
+    url     = "https://example.com/hooks/orders"
+    retries = 3
+  })
+}
+
+resource "mongodbatlas_app_services_function" "notify" {
+  project_id = var.project_id
+  app_id     = var.app_id
+  name       = "notifyOrders"
+  private    = true
+  source     = <<-EOF
+    exports = async function() {
+      const config = context.values.get("${mongodbatlas_app_services_value.webhook_config.name}");
+      const token = context.values.get("${mongodbatlas_app_services_value.webhook_token.name}");
+      await context.http.post({ url: config.url, headers: { Authorization: [`Bearer $${token}`] } });
+    };
+  EOF
+}
+
+resource "mongodbatlas_event_trigger" "notify" {
+  project_id  = var.project_id
+  app_id      = var.app_id
+  name        = "notifyOrders"
+  type        = "SCHEDULED"
+  function_id = mongodbatlas_app_services_function.notify.function_id
+  disabled    = false
+  scheduled {
+    schedule = "*/5 * * * *"
+  }
+}
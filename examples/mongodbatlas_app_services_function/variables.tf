@@ -0,0 +1,25 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+
+variable "project_id" {
+  description = "Unique 24-hexadecimal digit string that identifies your project"
+  type        = string
+}
+
+variable "app_id" {
+  description = "Unique identifier of an existing App Services application in your project"
+  type        = string
+}
+
+variable "webhook_token" {
+  description = "Token used by the function to call an external webhook"
+  type        = string
+  sensitive   = true
+}
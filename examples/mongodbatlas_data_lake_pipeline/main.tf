@@ -55,3 +55,15 @@ resource "mongodbatlas_data_lake_pipeline" "test" {
 
 }
 
+resource "mongodbatlas_cloud_backup_snapshot" "test" {
+  project_id        = mongodbatlas_project.atlas-project.id
+  cluster_name      = mongodbatlas_advanced_cluster.automated_backup_test.name
+  description       = "Snapshot for the Data Lake Pipeline run"
+  retention_in_days = 1
+}
+
+resource "mongodbatlas_data_lake_pipeline_run_trigger" "test" {
+  project_id    = mongodbatlas_project.atlas-project.id
+  pipeline_name = mongodbatlas_data_lake_pipeline.test.name
+  snapshot_id   = mongodbatlas_cloud_backup_snapshot.test.snapshot_id
+}
@@ -96,6 +96,12 @@ data "mongodbatlas_stream_processor" "example-stream-processor" {
   processor_name = mongodbatlas_stream_processor.stream-processor-sample-example.processor_name
 }
 
+data "mongodbatlas_stream_processor_stats" "example-stream-processor-stats" {
+  project_id     = var.project_id
+  instance_name  = mongodbatlas_stream_instance.example.instance_name
+  processor_name = mongodbatlas_stream_processor.stream-processor-sample-example.processor_name
+}
+
 # example making use of data sources
 output "stream_processors_state" {
   value = data.mongodbatlas_stream_processor.example-stream-processor.state
@@ -104,3 +110,7 @@ output "stream_processors_state" {
 output "stream_processors_results" {
   value = data.mongodbatlas_stream_processors.example-stream-processors.results
 }
+
+output "stream_processor_input_message_count" {
+  value = data.mongodbatlas_stream_processor_stats.example-stream-processor-stats.input_message_count
+}
@@ -6,6 +6,7 @@ const (
 	DeprecationParamByVersion                   = "This parameter is deprecated and will be removed in version %s."
 	DeprecationParamByVersionWithReplacement    = "This parameter is deprecated and will be removed in version %s. Please transition to %s."
 	DeprecationParamFutureWithReplacement       = "This parameter is deprecated and will be removed in the future. Please transition to %s"
+	DeprecationResourceFutureWithReplacement    = "This resource is deprecated and will be removed in the future. Please transition to %s."
 	DeprecationResourceByDateWithReplacement    = "This resource is deprecated and will be removed in %s. Please transition to %s."
 	DeprecationDataSourceByDateWithReplacement  = "This data source is deprecated and will be removed in %s. Please transition to %s."
 	DeprecationResourceByDateWithExternalLink   = "This resource is deprecated and will be removed in %s. For more details see %s."
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -38,6 +39,18 @@ func ImportStateProjectIDClusterName(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attrNameClusterName), clusterName)...)
 }
 
+// ImportStateAttributes sets the attributes from the import ID, which contains the attribute values separated by "/" in the same order as attrNames.
+func ImportStateAttributes(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attrNames ...string) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != len(attrNames) || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError("invalid import ID", fmt.Sprintf("expected %d parts separated by / with %s: %s", len(attrNames), strings.Join(attrNames, ", "), req.ID))
+		return
+	}
+	for i, attrName := range attrNames {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attrName), parts[i])...)
+	}
+}
+
 func ValidateProjectID(projectID string) error {
 	re := regexp.MustCompile("^([a-f0-9]{24})$")
 	if !re.MatchString(projectID) {
@@ -1,8 +1,16 @@
 package conversion_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -72,3 +80,53 @@ func TestImportSplit3(t *testing.T) {
 		})
 	}
 }
+
+func TestImportStateAttributes(t *testing.T) {
+	tests := map[string]struct {
+		importID      string
+		expectedAttrs map[string]string
+		expectedError bool
+	}{
+		"valid input": {
+			importID:      "projectID/clusterName",
+			expectedAttrs: map[string]string{"project_id": "projectID", "cluster_name": "clusterName"},
+		},
+		"invalid input with more parts": {
+			importID:      "projectID/clusterName/other",
+			expectedError: true,
+		},
+		"invalid input with one part": {
+			importID:      "projectID",
+			expectedError: true,
+		},
+		"invalid input with empty part": {
+			importID:      "projectID/",
+			expectedError: true,
+		},
+	}
+
+	ctx := context.Background()
+	rsSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id":   schema.StringAttribute{Required: true},
+			"cluster_name": schema.StringAttribute{Required: true},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: rsSchema,
+					Raw:    tftypes.NewValue(rsSchema.Type().TerraformType(ctx), nil),
+				},
+			}
+			conversion.ImportStateAttributes(ctx, resource.ImportStateRequest{ID: tc.importID}, resp, "project_id", "cluster_name")
+			require.Equal(t, tc.expectedError, resp.Diagnostics.HasError())
+			for attrName, expected := range tc.expectedAttrs {
+				var value types.String
+				require.False(t, resp.State.GetAttribute(ctx, path.Root(attrName), &value).HasError())
+				assert.Equal(t, expected, value.ValueString())
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package conversion
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -33,6 +34,45 @@ func ToTFMapOfString(ctx context.Context, diags *diag.Diagnostics, values *map[s
 	return mapValue
 }
 
+// ToTFList converts a slice field of an Atlas SDK struct to a Framework List, a nil slice pointer is converted to a null List.
+func ToTFList[T any](ctx context.Context, diags *diag.Diagnostics, elemType attr.Type, values *[]T) types.List {
+	if values == nil {
+		return types.ListNull(elemType)
+	}
+	listValue, localDiags := types.ListValueFrom(ctx, elemType, values)
+	diags.Append(localDiags...)
+	return listValue
+}
+
+// ToTFSet is similar to ToTFList but returns a Framework Set.
+func ToTFSet[T any](ctx context.Context, diags *diag.Diagnostics, elemType attr.Type, values *[]T) types.Set {
+	if values == nil {
+		return types.SetNull(elemType)
+	}
+	setValue, localDiags := types.SetValueFrom(ctx, elemType, values)
+	diags.Append(localDiags...)
+	return setValue
+}
+
+type TFCollection interface {
+	IsNull() bool
+	IsUnknown() bool
+	ElementsAs(ctx context.Context, target any, allowUnhandled bool) diag.Diagnostics
+}
+
+// ToSlicePointer converts a Framework List or Set to a slice pointer as used in Atlas SDK structs, null or unknown values are converted to nil.
+func ToSlicePointer[T any](ctx context.Context, diags *diag.Diagnostics, values TFCollection) *[]T {
+	if values.IsNull() || values.IsUnknown() {
+		return nil
+	}
+	results := []T{}
+	if localDiags := values.ElementsAs(ctx, &results, false); localDiags.HasError() {
+		diags.Append(localDiags...)
+		return nil
+	}
+	return &results
+}
+
 // StringNullIfEmpty converts a string value to a Framework String value.
 // An empty string is converted to a null String. Useful for optional attributes.
 func StringNullIfEmpty(v string) types.String {
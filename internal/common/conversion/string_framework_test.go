@@ -0,0 +1,75 @@
+package conversion_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToTFListAndSet(t *testing.T) {
+	testCases := map[string]struct {
+		values       *[]string
+		expectedList types.List
+		expectedSet  types.Set
+	}{
+		"nil": {
+			values:       nil,
+			expectedList: types.ListNull(types.StringType),
+			expectedSet:  types.SetNull(types.StringType),
+		},
+		"empty": {
+			values:       &[]string{},
+			expectedList: types.ListValueMust(types.StringType, []attr.Value{}),
+			expectedSet:  types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+		"values": {
+			values:       &[]string{"val1", "val2"},
+			expectedList: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("val1"), types.StringValue("val2")}),
+			expectedSet:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("val1"), types.StringValue("val2")}),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := &diag.Diagnostics{}
+			assert.Equal(t, tc.expectedList, conversion.ToTFList(context.Background(), diags, types.StringType, tc.values))
+			assert.Equal(t, tc.expectedSet, conversion.ToTFSet(context.Background(), diags, types.StringType, tc.values))
+			assert.False(t, diags.HasError())
+		})
+	}
+}
+
+func TestToSlicePointer(t *testing.T) {
+	testCases := map[string]struct {
+		values   conversion.TFCollection
+		expected *[]int
+	}{
+		"null list": {
+			values:   types.ListNull(types.Int64Type),
+			expected: nil,
+		},
+		"unknown set": {
+			values:   types.SetUnknown(types.Int64Type),
+			expected: nil,
+		},
+		"empty list": {
+			values:   types.ListValueMust(types.Int64Type, []attr.Value{}),
+			expected: &[]int{},
+		},
+		"set with values": {
+			values:   types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2)}),
+			expected: &[]int{1, 2},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := &diag.Diagnostics{}
+			assert.Equal(t, tc.expected, conversion.ToSlicePointer[int](context.Background(), diags, tc.values))
+			assert.False(t, diags.HasError())
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package customplanmodifier
+
+import (
+	"context"
+
+	planmodifier "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// UseStateForUnknownIfSetStringPlanModifier copies the state value to the plan only when it is set in state, not null and not empty.
+// Useful for computed attributes that are only set once a pending operation finishes, e.g. the user_id of an org user once the invitation is accepted.
+func UseStateForUnknownIfSetStringPlanModifier() planmodifier.String {
+	return &useStateForUnknownIfSetStringPlanModifier{}
+}
+
+type useStateForUnknownIfSetStringPlanModifier struct {
+}
+
+func (d *useStateForUnknownIfSetStringPlanModifier) Description(ctx context.Context) string {
+	return d.MarkdownDescription(ctx)
+}
+
+func (d *useStateForUnknownIfSetStringPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "Once set in state, the value of this attribute will not change."
+}
+
+func (d *useStateForUnknownIfSetStringPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.StateValue.ValueString() == "" {
+		return
+	}
+	if !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,49 @@
+package customplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/customplanmodifier"
+)
+
+func TestUseStateForUnknownIfSetStringPlanModifier(t *testing.T) {
+	testCases := map[string]struct {
+		state    types.String
+		plan     types.String
+		expected types.String
+	}{
+		"set in state": {
+			state:    types.StringValue("5f4d8f0f6e2b6c3e1a7d9b21"),
+			plan:     types.StringUnknown(),
+			expected: types.StringValue("5f4d8f0f6e2b6c3e1a7d9b21"),
+		},
+		"null in state while pending": {
+			state:    types.StringNull(),
+			plan:     types.StringUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"empty in state": {
+			state:    types.StringValue(""),
+			plan:     types.StringUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"known plan": {
+			state:    types.StringValue("5f4d8f0f6e2b6c3e1a7d9b21"),
+			plan:     types.StringValue("other"),
+			expected: types.StringValue("other"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: tc.state, PlanValue: tc.plan, ConfigValue: types.StringNull()}
+			resp := &planmodifier.StringResponse{PlanValue: tc.plan}
+			customplanmodifier.UseStateForUnknownIfSetStringPlanModifier().PlanModifyString(context.Background(), req, resp)
+			assert.Equal(t, tc.expected, resp.PlanValue)
+		})
+	}
+}
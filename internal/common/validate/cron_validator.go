@@ -0,0 +1,111 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type cronField struct {
+	names    []string
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 6, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+type CronValidator struct{}
+
+func (v CronValidator) Description(_ context.Context) string {
+	return "string value must be a valid cron expression with 5 fields: minute, hour, day of month, month and day of week"
+}
+
+func (v CronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v CronValidator) ValidateString(ctx context.Context, req validator.StringRequest, response *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if err := ValidateCron(req.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			fmt.Sprintf("%s, %s", v.Description(ctx), err),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+func ValidCron() validator.String {
+	return CronValidator{}
+}
+
+// ValidateCron checks a standard 5 field cron expression, each field accepts *, values, ranges, steps and comma separated lists.
+func ValidateCron(expression string) error {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+	for i, field := range fields {
+		for _, item := range strings.Split(field, ",") {
+			if err := cronFields[i].validateItem(item); err != nil {
+				return fmt.Errorf("invalid %s %q: %w", cronFields[i].name, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (f cronField) validateItem(item string) error {
+	valueRange, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+			return fmt.Errorf("step %q must be a positive number", step)
+		}
+	}
+	if valueRange == "*" {
+		return nil
+	}
+	start, end, isRange := strings.Cut(valueRange, "-")
+	startValue, err := f.value(start)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	endValue, err := f.value(end)
+	if err != nil {
+		return err
+	}
+	if startValue > endValue {
+		return fmt.Errorf("range start %s is greater than range end %s", start, end)
+	}
+	return nil
+}
+
+func (f cronField) value(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %q must be between %d and %d", value, f.min, f.max)
+	}
+	return n, nil
+}
@@ -0,0 +1,43 @@
+package validate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidCron(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{name: "every minute", expression: "* * * * *"},
+		{name: "steps", expression: "*/2 */3 * * *"},
+		{name: "lists and ranges", expression: "0,30 8-18 1-15 1,6 MON-FRI"},
+		{name: "range with step", expression: "0-30/5 0 1 jan sun"},
+		{name: "too few fields", expression: "* * * *", wantErr: true},
+		{name: "too many fields", expression: "0 * * * * *", wantErr: true},
+		{name: "minute out of range", expression: "60 * * * *", wantErr: true},
+		{name: "hour out of range", expression: "0 24 * * *", wantErr: true},
+		{name: "day of month zero", expression: "0 0 0 * *", wantErr: true},
+		{name: "invalid month name", expression: "0 0 1 JUNE *", wantErr: true},
+		{name: "invalid step", expression: "*/0 * * * *", wantErr: true},
+		{name: "inverted range", expression: "0 18-8 * * *", wantErr: true},
+		{name: "empty", expression: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			response := validator.StringResponse{}
+			validate.CronValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.expression),
+			}, &response)
+			assert.Equal(t, tt.wantErr, response.Diagnostics.HasError(), response.Diagnostics)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -36,3 +37,33 @@ func (v JSONStringValidator) ValidateString(ctx context.Context, req validator.S
 func StringIsJSON() validator.String {
 	return JSONStringValidator{}
 }
+
+type JSONObjectValidator struct{}
+
+func (v JSONObjectValidator) Description(_ context.Context) string {
+	return "string value must be a valid JSON object"
+}
+
+func (v JSONObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v JSONObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, response *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil || object == nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+func StringIsJSONObject() validator.String {
+	return JSONObjectValidator{}
+}
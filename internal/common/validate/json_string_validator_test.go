@@ -64,3 +64,47 @@ func TestStringIsJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestStringIsJSONObject(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr bool
+	}{
+		{
+			name: "object",
+			json: `{"updateDescription.updatedFields": {"status": "blocked"}}`,
+		},
+		{
+			name: "empty object",
+			json: "{}",
+		},
+		{
+			name:    "array",
+			json:    `[{"status": "blocked"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "null",
+			json:    "null",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			json:    `{"status"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			validatorResponse := validator.StringResponse{}
+			validate.JSONObjectValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.json),
+			}, &validatorResponse)
+			if validatorResponse.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("StringIsJSONObject() error = %v, wantErr %v", validatorResponse.Diagnostics.Errors(), tt.wantErr)
+			}
+		})
+	}
+}
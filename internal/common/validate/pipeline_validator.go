@@ -0,0 +1,44 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StringIsAggregationPipeline checks that the value is a JSON array of aggregation stages, each stage being an object with a single `$` operator.
+func StringIsAggregationPipeline() schema.SchemaValidateDiagFunc {
+	return func(v any, p cty.Path) diag.Diagnostics {
+		if err := ValidateAggregationPipeline(v.(string)); err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("The provided string %q must be a valid aggregation pipeline.", v),
+				Detail:        err.Error(),
+				AttributePath: p,
+			}}
+		}
+		return nil
+	}
+}
+
+func ValidateAggregationPipeline(value string) error {
+	var stages []map[string]any
+	if err := json.Unmarshal([]byte(value), &stages); err != nil {
+		return fmt.Errorf("pipeline must be a JSON array of stage objects: %w", err)
+	}
+	for i, stage := range stages {
+		if len(stage) != 1 {
+			return fmt.Errorf("stage %d must have exactly one operator, found %d", i, len(stage))
+		}
+		for operator := range stage {
+			if !strings.HasPrefix(operator, "$") {
+				return fmt.Errorf("stage %d operator %q must start with $", i, operator)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+func TestValidateAggregationPipeline(t *testing.T) {
+	testCases := map[string]struct {
+		pipeline      string
+		expectedError bool
+	}{
+		"empty pipeline": {
+			pipeline: "[]",
+		},
+		"single stage": {
+			pipeline: `[{"$match": {"status": "A"}}]`,
+		},
+		"multiple stages": {
+			pipeline: `[{"$match": {"status": "A"}}, {"$project": {"_id": 0, "name": 1}}]`,
+		},
+		"invalid JSON": {
+			pipeline:      `[{"$match": }]`,
+			expectedError: true,
+		},
+		"object instead of array": {
+			pipeline:      `{"$match": {"status": "A"}}`,
+			expectedError: true,
+		},
+		"stage not an object": {
+			pipeline:      `["$match"]`,
+			expectedError: true,
+		},
+		"operator without $": {
+			pipeline:      `[{"match": {"status": "A"}}]`,
+			expectedError: true,
+		},
+		"stage with two operators": {
+			pipeline:      `[{"$match": {"status": "A"}, "$limit": 1}]`,
+			expectedError: true,
+		},
+		"empty stage": {
+			pipeline:      `[{}]`,
+			expectedError: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validate.ValidateAggregationPipeline(tc.pipeline)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("Case %s: expected error %t, received: %v", name, tc.expectedError, err)
+			}
+			diags := validate.StringIsAggregationPipeline()(tc.pipeline, nil)
+			if diags.HasError() != tc.expectedError {
+				t.Errorf("Case %s: expected error %t, received diagnostics: %v", name, tc.expectedError, diags)
+			}
+		})
+	}
+}
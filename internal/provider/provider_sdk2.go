@@ -249,8 +249,6 @@ func getResourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_cloud_provider_access_setup":                                 cloudprovideraccess.ResourceSetup(),
 		"mongodbatlas_cloud_provider_access_authorization":                         cloudprovideraccess.ResourceAuthorization(),
 		"mongodbatlas_search_index":                                                searchindex.Resource(),
-		"mongodbatlas_data_lake_pipeline":                                          datalakepipeline.Resource(),
-		"mongodbatlas_event_trigger":                                               eventtrigger.Resource(),
 		"mongodbatlas_project_invitation":                                          projectinvitation.Resource(),
 		"mongodbatlas_org_invitation":                                              orginvitation.Resource(),
 		"mongodbatlas_organization":                                                organization.Resource(),
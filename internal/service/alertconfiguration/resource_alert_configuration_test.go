@@ -29,7 +29,7 @@ func TestAccConfigRSAlertConfiguration_basic(t *testing.T) {
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -68,7 +68,7 @@ func TestAccConfigRSAlertConfiguration_withEmptyMetricThresholdConfig(t *testing
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -87,7 +87,7 @@ func TestAccConfigRSAlertConfiguration_withEmptyMatcherMetricThresholdConfig(t *
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -110,7 +110,7 @@ func TestAccConfigRSAlertConfiguration_withNotifications(t *testing.T) {
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -147,7 +147,7 @@ func TestAccConfigRSAlertConfiguration_withMatchers(t *testing.T) {
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -197,7 +197,7 @@ func TestAccConfigRSAlertConfiguration_withMetricUpdated(t *testing.T) {
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -227,7 +227,7 @@ func TestAccConfigRSAlertConfiguration_withThresholdUpdated(t *testing.T) {
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -264,7 +264,7 @@ func TestAccConfigRSAlertConfiguration_withoutRoles(t *testing.T) {
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -287,7 +287,7 @@ func TestAccConfigRSAlertConfiguration_withoutOptionalAttributes(t *testing.T) {
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -310,7 +310,7 @@ func TestAccConfigRSAlertConfiguration_importIncorrectId(t *testing.T) {
 		projectID = replay.ManageProjectID(t, acc.ProjectIDExecution)
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -341,7 +341,7 @@ func TestAccConfigRSAlertConfiguration_updatePagerDutyWithNotifierId(t *testing.
 		notifierID = "651dd9336afac13e1c112222"
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -368,7 +368,7 @@ func TestAccConfigRSAlertConfiguration_updatePagerDutyWithNotifierId(t *testing.
 }
 
 func TestAccConfigRSAlertConfiguration_withDataDog(t *testing.T) {
-	resource.Test(t, *datadogTestCase(t)) // not run in parallel so acc and mig tests don't interfere
+	replay.Test(t, *datadogTestCase(t)) // not run in parallel so acc and mig tests don't interfere
 }
 
 func datadogTestCase(t *testing.T) *resource.TestCase {
@@ -439,7 +439,7 @@ func TestAccConfigRSAlertConfiguration_withPagerDuty(t *testing.T) {
 		serviceKey = dummy32CharKey
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -497,7 +497,7 @@ func TestAccConfigRSAlertConfiguration_withOpsGenie(t *testing.T) {
 		apiKey    = dummy36CharKey
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -521,7 +521,7 @@ func TestAccConfigRSAlertConfiguration_withVictorOps(t *testing.T) {
 		apiKey    = dummy36CharKey
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	replay.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acc.PreCheckBasic(t) },
 		ProtoV6ProviderFactories: acc.TestAccProviderV6FactoriesWithProxy(proxyPort),
 		CheckDestroy:             checkDestroyUsingProxy(proxyPort),
@@ -0,0 +1,153 @@
+package appservices
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.mongodb.org/realm/realm"
+)
+
+// The realm client only provides the apps and event triggers endpoints of the App Services Admin API,
+// so the functions, values and secrets endpoints are called here with the realm client requests.
+
+const (
+	functionsPath = "groups/%s/apps/%s/functions"
+	valuesPath    = "groups/%s/apps/%s/values"
+	secretsPath   = "groups/%s/apps/%s/secrets"
+)
+
+type Function struct {
+	CanEvaluate    map[string]any `json:"can_evaluate,omitempty"`
+	ID             string         `json:"_id,omitempty"`
+	Name           string         `json:"name"`
+	Source         string         `json:"source,omitempty"`
+	Private        bool           `json:"private"`
+	RunAsSystem    bool           `json:"run_as_system"`
+	DisableArgLogs bool           `json:"disable_arg_logs"`
+}
+
+type Value struct {
+	Value      any    `json:"value"`
+	ID         string `json:"_id,omitempty"`
+	Name       string `json:"name"`
+	Private    bool   `json:"private"`
+	FromSecret bool   `json:"from_secret"`
+}
+
+// Secret values are write-only, the API never returns them.
+type Secret struct {
+	ID    string `json:"_id,omitempty"`
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// Client provides access to the App Services functions, values and secrets using a realm client.
+type Client struct {
+	realm *realm.Client
+}
+
+func NewClient(realmClient *realm.Client) *Client {
+	return &Client{realm: realmClient}
+}
+
+func (c *Client) CreateFunction(ctx context.Context, groupID, appID string, function *Function) (*Function, *realm.Response, error) {
+	root := new(Function)
+	resp, err := c.do(ctx, http.MethodPost, collectionPath(functionsPath, groupID, appID), function, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func (c *Client) GetFunction(ctx context.Context, groupID, appID, functionID string) (*Function, *realm.Response, error) {
+	root := new(Function)
+	resp, err := c.do(ctx, http.MethodGet, itemPath(functionsPath, groupID, appID, functionID), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func (c *Client) UpdateFunction(ctx context.Context, groupID, appID, functionID string, function *Function) (*realm.Response, error) {
+	return c.do(ctx, http.MethodPut, itemPath(functionsPath, groupID, appID, functionID), function, nil)
+}
+
+func (c *Client) DeleteFunction(ctx context.Context, groupID, appID, functionID string) (*realm.Response, error) {
+	return c.do(ctx, http.MethodDelete, itemPath(functionsPath, groupID, appID, functionID), nil, nil)
+}
+
+func (c *Client) CreateValue(ctx context.Context, groupID, appID string, value *Value) (*Value, *realm.Response, error) {
+	root := new(Value)
+	resp, err := c.do(ctx, http.MethodPost, collectionPath(valuesPath, groupID, appID), value, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func (c *Client) GetValue(ctx context.Context, groupID, appID, valueID string) (*Value, *realm.Response, error) {
+	root := new(Value)
+	resp, err := c.do(ctx, http.MethodGet, itemPath(valuesPath, groupID, appID, valueID), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func (c *Client) UpdateValue(ctx context.Context, groupID, appID, valueID string, value *Value) (*realm.Response, error) {
+	return c.do(ctx, http.MethodPut, itemPath(valuesPath, groupID, appID, valueID), value, nil)
+}
+
+func (c *Client) DeleteValue(ctx context.Context, groupID, appID, valueID string) (*realm.Response, error) {
+	return c.do(ctx, http.MethodDelete, itemPath(valuesPath, groupID, appID, valueID), nil, nil)
+}
+
+func (c *Client) CreateSecret(ctx context.Context, groupID, appID string, secret *Secret) (*Secret, *realm.Response, error) {
+	root := new(Secret)
+	resp, err := c.do(ctx, http.MethodPost, collectionPath(secretsPath, groupID, appID), secret, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+// GetSecret returns nil without error if the secret doesn't exist, the API doesn't have an endpoint to get a single secret.
+func (c *Client) GetSecret(ctx context.Context, groupID, appID, secretID string) (*Secret, *realm.Response, error) {
+	var secrets []Secret
+	resp, err := c.do(ctx, http.MethodGet, collectionPath(secretsPath, groupID, appID), nil, &secrets)
+	if err != nil {
+		return nil, resp, err
+	}
+	for i := range secrets {
+		if secrets[i].ID == secretID {
+			return &secrets[i], resp, nil
+		}
+	}
+	return nil, resp, nil
+}
+
+func (c *Client) UpdateSecret(ctx context.Context, groupID, appID, secretID string, secret *Secret) (*realm.Response, error) {
+	return c.do(ctx, http.MethodPut, itemPath(secretsPath, groupID, appID, secretID), secret, nil)
+}
+
+func (c *Client) DeleteSecret(ctx context.Context, groupID, appID, secretID string) (*realm.Response, error) {
+	return c.do(ctx, http.MethodDelete, itemPath(secretsPath, groupID, appID, secretID), nil, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, v any) (*realm.Response, error) {
+	req, err := c.realm.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.realm.Do(ctx, req, v)
+}
+
+func collectionPath(format, groupID, appID string) string {
+	return fmt.Sprintf(format, url.PathEscape(groupID), url.PathEscape(appID))
+}
+
+func itemPath(format, groupID, appID, id string) string {
+	return collectionPath(format, groupID, appID) + "/" + url.PathEscape(id)
+}
@@ -0,0 +1,199 @@
+package appservices_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/realm/realm"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/appservices"
+)
+
+const (
+	groupID = "664619d870c247237f4b86a6"
+	appID   = "6646255cd2d6fd9c0e3a1b2c"
+)
+
+// adminAPI is a stand-in of the App Services Admin API that keeps functions, values and secrets in memory.
+type adminAPI struct {
+	items  map[string]map[string]map[string]any
+	mu     sync.Mutex
+	nextID int
+}
+
+func newAdminAPI(t *testing.T) (*appservices.Client, *adminAPI) {
+	t.Helper()
+	api := &adminAPI{items: map[string]map[string]map[string]any{"functions": {}, "values": {}, "secrets": {}}}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	realmClient, err := realm.New(server.Client(), realm.SetBaseURL(server.URL+"/api/admin/v3.0/"))
+	require.NoError(t, err)
+	return appservices.NewClient(realmClient), api
+}
+
+func (a *adminAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	prefix := fmt.Sprintf("/api/admin/v3.0/groups/%s/apps/%s/", groupID, appID)
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	items, ok := a.items[parts[0]]
+	if !strings.HasPrefix(r.URL.Path, prefix) || !ok || len(parts) > 2 {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+	var body map[string]any
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		list := make([]map[string]any, 0, len(items))
+		for _, item := range items {
+			list = append(list, withoutSecretValue(parts[0], item))
+		}
+		writeJSON(w, http.StatusOK, list)
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		a.nextID++
+		body["_id"] = fmt.Sprintf("%024d", a.nextID)
+		items[body["_id"].(string)] = body
+		if parts[0] == "values" {
+			writeJSON(w, http.StatusCreated, body)
+			return
+		}
+		writeJSON(w, http.StatusCreated, map[string]any{"_id": body["_id"], "name": body["name"]})
+	case items[parts[len(parts)-1]] == nil:
+		writeError(w, http.StatusNotFound)
+	case r.Method == http.MethodGet && parts[0] != "secrets":
+		writeJSON(w, http.StatusOK, items[parts[1]])
+	case r.Method == http.MethodPut:
+		body["_id"] = parts[1]
+		items[parts[1]] = body
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(items, parts[1])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+func withoutSecretValue(kind string, item map[string]any) map[string]any {
+	if kind != "secrets" {
+		return item
+	}
+	return map[string]any{"_id": item["_id"], "name": item["name"]}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int) {
+	writeJSON(w, status, map[string]string{"error": http.StatusText(status), "error_code": "NotFound"})
+}
+
+func TestFunctionLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newAdminAPI(t)
+	created, _, err := client.CreateFunction(ctx, groupID, appID, &appservices.Function{
+		Name:        "onInsert",
+		Source:      "exports = function(changeEvent) {};",
+		CanEvaluate: map[string]any{"%%true": true},
+		Private:     true,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "onInsert", created.Name)
+
+	function, _, err := client.GetFunction(ctx, groupID, appID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &appservices.Function{
+		ID:          created.ID,
+		Name:        "onInsert",
+		Source:      "exports = function(changeEvent) {};",
+		CanEvaluate: map[string]any{"%%true": true},
+		Private:     true,
+	}, function)
+
+	function.RunAsSystem = true
+	_, err = client.UpdateFunction(ctx, groupID, appID, created.ID, function)
+	require.NoError(t, err)
+	function, _, err = client.GetFunction(ctx, groupID, appID, created.ID)
+	require.NoError(t, err)
+	assert.True(t, function.RunAsSystem)
+
+	_, err = client.DeleteFunction(ctx, groupID, appID, created.ID)
+	require.NoError(t, err)
+	_, resp, err := client.GetFunction(ctx, groupID, appID, created.ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestValueLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newAdminAPI(t)
+	created, _, err := client.CreateValue(ctx, groupID, appID, &appservices.Value{
+		Name:  "config",
+		Value: map[string]any{"retries": float64(3)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "config", created.Name)
+	assert.Equal(t, map[string]any{"retries": float64(3)}, created.Value)
+
+	created.Value = "apiKey"
+	created.FromSecret = true
+	_, err = client.UpdateValue(ctx, groupID, appID, created.ID, created)
+	require.NoError(t, err)
+	value, _, err := client.GetValue(ctx, groupID, appID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created, value)
+
+	_, err = client.DeleteValue(ctx, groupID, appID, created.ID)
+	require.NoError(t, err)
+	_, resp, err := client.GetValue(ctx, groupID, appID, created.ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestSecretLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, api := newAdminAPI(t)
+	created, _, err := client.CreateSecret(ctx, groupID, appID, &appservices.Secret{Name: "apiKey", Value: "s3cr3t"})
+	require.NoError(t, err)
+	assert.Equal(t, &appservices.Secret{ID: created.ID, Name: "apiKey"}, created)
+
+	secret, _, err := client.GetSecret(ctx, groupID, appID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created, secret)
+
+	_, err = client.UpdateSecret(ctx, groupID, appID, created.ID, &appservices.Secret{ID: created.ID, Name: "apiKey", Value: "n3w"})
+	require.NoError(t, err)
+	assert.Equal(t, "n3w", api.items["secrets"][created.ID]["value"])
+
+	_, err = client.DeleteSecret(ctx, groupID, appID, created.ID)
+	require.NoError(t, err)
+	secret, _, err = client.GetSecret(ctx, groupID, appID, created.ID)
+	require.NoError(t, err)
+	assert.Nil(t, secret)
+}
+
+func TestAPIErrors(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newAdminAPI(t)
+	_, resp, err := client.GetFunction(ctx, groupID, "otherApp", "000000000000000000000001")
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	var errResp *realm.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, "NotFound", errResp.ErrorCode)
+}
@@ -0,0 +1,141 @@
+package appservices
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+)
+
+const errorRealmClient = "error getting the realm client"
+
+func projectIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func appIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Unique identifier of the App Services application, the `_id` of the application in the App Services Admin API.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// splitImportID splits import IDs with the format {project_id}--{app_id}--{id}, the same format used by mongodbatlas_event_trigger.
+func splitImportID(id, idAttribute string) (projectID, appID, resourceID string, err error) {
+	parts := strings.Split(id, "--")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		err = errors.New("use the format {project_id}--{app_id}--{" + idAttribute + "}")
+		return
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func NewFunctionReq(plan *TFFunctionModel) (*Function, diag.Diagnostics) {
+	function := &Function{
+		Name:           plan.Name.ValueString(),
+		Source:         plan.Source.ValueString(),
+		Private:        plan.Private.ValueBool(),
+		RunAsSystem:    plan.RunAsSystem.ValueBool(),
+		DisableArgLogs: plan.DisableArgLogs.ValueBool(),
+	}
+	if canEvaluate := plan.CanEvaluate.ValueString(); canEvaluate != "" {
+		if err := json.Unmarshal([]byte(canEvaluate), &function.CanEvaluate); err != nil {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic("failed to unmarshal can_evaluate", err.Error())}
+		}
+	}
+	return function, nil
+}
+
+// NewTFFunction keeps the can_evaluate of the plan or state when the API doesn't return it, as an empty expression is omitted by the API.
+func NewTFFunction(projectID, appID string, canEvaluate *fwtypes.JSONString, apiResp *Function) (*TFFunctionModel, diag.Diagnostics) {
+	if apiResp == nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("function API response is nil", "")}
+	}
+	canEvaluateTF := fwtypes.JSONStringNull()
+	if canEvaluate != nil {
+		canEvaluateTF = *canEvaluate
+	}
+	if len(apiResp.CanEvaluate) > 0 {
+		canEvaluateJSON, err := json.Marshal(apiResp.CanEvaluate)
+		if err != nil {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic("failed to marshal can_evaluate", err.Error())}
+		}
+		canEvaluateTF = fwtypes.JSONStringValue(string(canEvaluateJSON))
+	}
+	return &TFFunctionModel{
+		ProjectID:      types.StringValue(projectID),
+		AppID:          types.StringValue(appID),
+		FunctionID:     types.StringValue(apiResp.ID),
+		Name:           types.StringValue(apiResp.Name),
+		Source:         types.StringValue(apiResp.Source),
+		CanEvaluate:    canEvaluateTF,
+		Private:        types.BoolValue(apiResp.Private),
+		RunAsSystem:    types.BoolValue(apiResp.RunAsSystem),
+		DisableArgLogs: types.BoolValue(apiResp.DisableArgLogs),
+	}, nil
+}
+
+func NewValueReq(plan *TFValueModel) (*Value, diag.Diagnostics) {
+	value := &Value{
+		Name:       plan.Name.ValueString(),
+		Private:    plan.Private.ValueBool(),
+		FromSecret: plan.FromSecret.ValueBool(),
+	}
+	if err := json.Unmarshal([]byte(plan.Value.ValueString()), &value.Value); err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("failed to unmarshal value", err.Error())}
+	}
+	return value, nil
+}
+
+func NewTFValue(projectID, appID string, apiResp *Value) (*TFValueModel, diag.Diagnostics) {
+	if apiResp == nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("value API response is nil", "")}
+	}
+	valueJSON, err := json.Marshal(apiResp.Value)
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("failed to marshal value", err.Error())}
+	}
+	return &TFValueModel{
+		ProjectID:  types.StringValue(projectID),
+		AppID:      types.StringValue(appID),
+		ValueID:    types.StringValue(apiResp.ID),
+		Name:       types.StringValue(apiResp.Name),
+		Value:      fwtypes.JSONStringValue(string(valueJSON)),
+		Private:    types.BoolValue(apiResp.Private),
+		FromSecret: types.BoolValue(apiResp.FromSecret),
+	}, nil
+}
+
+func NewSecretReq(plan *TFSecretModel) *Secret {
+	return &Secret{
+		ID:    plan.SecretID.ValueString(),
+		Name:  plan.Name.ValueString(),
+		Value: plan.Value.ValueString(),
+	}
+}
+
+// NewTFSecret uses the value of the plan or state as the API never returns it.
+func NewTFSecret(projectID, appID string, value types.String, apiResp *Secret) *TFSecretModel {
+	return &TFSecretModel{
+		ProjectID: types.StringValue(projectID),
+		AppID:     types.StringValue(appID),
+		SecretID:  types.StringValue(apiResp.ID),
+		Name:      types.StringValue(apiResp.Name),
+		Value:     value,
+	}
+}
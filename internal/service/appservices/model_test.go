@@ -0,0 +1,161 @@
+package appservices_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/appservices"
+)
+
+const (
+	functionID = "6646255cd2d6fd9c0e3a1b2d"
+	valueID    = "6646255cd2d6fd9c0e3a1b2e"
+	secretID   = "6646255cd2d6fd9c0e3a1b2f"
+	source     = "exports = function(changeEvent) {};"
+)
+
+func TestFunctionTFToSDKModel(t *testing.T) {
+	testCases := map[string]struct {
+		tfModel     *appservices.TFFunctionModel
+		expectedReq *appservices.Function
+	}{
+		"withCanEvaluate": {
+			tfModel: &appservices.TFFunctionModel{
+				Name:           types.StringValue("onInsert"),
+				Source:         types.StringValue(source),
+				CanEvaluate:    fwtypes.JSONStringValue(`{"%%true": true}`),
+				Private:        types.BoolValue(true),
+				RunAsSystem:    types.BoolValue(true),
+				DisableArgLogs: types.BoolValue(false),
+			},
+			expectedReq: &appservices.Function{
+				Name:        "onInsert",
+				Source:      source,
+				CanEvaluate: map[string]any{"%%true": true},
+				Private:     true,
+				RunAsSystem: true,
+			},
+		},
+		"withoutCanEvaluate": {
+			tfModel: &appservices.TFFunctionModel{
+				Name:           types.StringValue("onInsert"),
+				Source:         types.StringValue(source),
+				CanEvaluate:    fwtypes.JSONStringNull(),
+				Private:        types.BoolValue(false),
+				RunAsSystem:    types.BoolValue(false),
+				DisableArgLogs: types.BoolValue(true),
+			},
+			expectedReq: &appservices.Function{
+				Name:           "onInsert",
+				Source:         source,
+				DisableArgLogs: true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req, diags := appservices.NewFunctionReq(tc.tfModel)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tc.expectedReq, req)
+		})
+	}
+}
+
+func TestFunctionSDKToTFModel(t *testing.T) {
+	testCases := map[string]struct {
+		canEvaluate     *fwtypes.JSONString
+		apiResp         *appservices.Function
+		expectedTFModel *appservices.TFFunctionModel
+	}{
+		"withCanEvaluate": {
+			apiResp: &appservices.Function{
+				ID:          functionID,
+				Name:        "onInsert",
+				Source:      source,
+				CanEvaluate: map[string]any{"%%true": true},
+				Private:     true,
+			},
+			expectedTFModel: functionTFModel(fwtypes.JSONStringValue(`{"%%true":true}`), true),
+		},
+		"emptyCanEvaluateKeepsPlan": {
+			canEvaluate:     conversion.Pointer(fwtypes.JSONStringValue("{}")),
+			apiResp:         &appservices.Function{ID: functionID, Name: "onInsert", Source: source},
+			expectedTFModel: functionTFModel(fwtypes.JSONStringValue("{}"), false),
+		},
+		"withoutCanEvaluate": {
+			canEvaluate:     conversion.Pointer(fwtypes.JSONStringNull()),
+			apiResp:         &appservices.Function{ID: functionID, Name: "onInsert", Source: source},
+			expectedTFModel: functionTFModel(fwtypes.JSONStringNull(), false),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model, diags := appservices.NewTFFunction(groupID, appID, tc.canEvaluate, tc.apiResp)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tc.expectedTFModel, model)
+		})
+	}
+}
+
+func TestValueTFToSDKModel(t *testing.T) {
+	req, diags := appservices.NewValueReq(&appservices.TFValueModel{
+		Name:       types.StringValue("config"),
+		Value:      fwtypes.JSONStringValue(`{"retries": 3}`),
+		Private:    types.BoolValue(true),
+		FromSecret: types.BoolValue(false),
+	})
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, &appservices.Value{Name: "config", Value: map[string]any{"retries": float64(3)}, Private: true}, req)
+
+	_, diags = appservices.NewValueReq(&appservices.TFValueModel{Value: fwtypes.JSONStringValue("{")})
+	assert.True(t, diags.HasError())
+}
+
+func TestValueSDKToTFModel(t *testing.T) {
+	model, diags := appservices.NewTFValue(groupID, appID, &appservices.Value{
+		ID:         valueID,
+		Name:       "apiKey",
+		Value:      "apiKeySecret",
+		FromSecret: true,
+	})
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, &appservices.TFValueModel{
+		ProjectID:  types.StringValue(groupID),
+		AppID:      types.StringValue(appID),
+		ValueID:    types.StringValue(valueID),
+		Name:       types.StringValue("apiKey"),
+		Value:      fwtypes.JSONStringValue(`"apiKeySecret"`),
+		Private:    types.BoolValue(false),
+		FromSecret: types.BoolValue(true),
+	}, model)
+}
+
+func TestSecretSDKToTFModel(t *testing.T) {
+	model := appservices.NewTFSecret(groupID, appID, types.StringValue("s3cr3t"), &appservices.Secret{ID: secretID, Name: "apiKeySecret"})
+	assert.Equal(t, &appservices.TFSecretModel{
+		ProjectID: types.StringValue(groupID),
+		AppID:     types.StringValue(appID),
+		SecretID:  types.StringValue(secretID),
+		Name:      types.StringValue("apiKeySecret"),
+		Value:     types.StringValue("s3cr3t"),
+	}, model)
+}
+
+func functionTFModel(canEvaluate fwtypes.JSONString, private bool) *appservices.TFFunctionModel {
+	return &appservices.TFFunctionModel{
+		ProjectID:      types.StringValue(groupID),
+		AppID:          types.StringValue(appID),
+		FunctionID:     types.StringValue(functionID),
+		Name:           types.StringValue("onInsert"),
+		Source:         types.StringValue(source),
+		CanEvaluate:    canEvaluate,
+		Private:        types.BoolValue(private),
+		RunAsSystem:    types.BoolValue(false),
+		DisableArgLogs: types.BoolValue(false),
+	}
+}
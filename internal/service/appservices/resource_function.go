@@ -0,0 +1,231 @@
+package appservices
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const functionName = "app_services_function"
+
+var _ resource.ResourceWithConfigure = &functionRS{}
+var _ resource.ResourceWithImportState = &functionRS{}
+
+func FunctionResource() resource.Resource {
+	return &functionRS{
+		RSCommon: config.RSCommon{
+			ResourceName: functionName,
+		},
+	}
+}
+
+type functionRS struct {
+	config.RSCommon
+}
+
+type TFFunctionModel struct {
+	ProjectID      types.String       `tfsdk:"project_id"`
+	AppID          types.String       `tfsdk:"app_id"`
+	FunctionID     types.String       `tfsdk:"function_id"`
+	Name           types.String       `tfsdk:"name"`
+	Source         types.String       `tfsdk:"source"`
+	CanEvaluate    fwtypes.JSONString `tfsdk:"can_evaluate"`
+	Private        types.Bool         `tfsdk:"private"`
+	RunAsSystem    types.Bool         `tfsdk:"run_as_system"`
+	DisableArgLogs types.Bool         `tfsdk:"disable_arg_logs"`
+}
+
+func (r *functionRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = FunctionResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func FunctionResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"app_id":     appIDAttribute(),
+			"function_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the function. Can be used as the `function_id` of a `mongodbatlas_event_trigger`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the function. It must be unique within the App Services application.",
+			},
+			"source": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "JavaScript source code of the function. Using the [file](https://developer.hashicorp.com/terraform/language/functions/file) function is recommended when setting this attribute.",
+			},
+			"can_evaluate": schema.StringAttribute{
+				CustomType:          fwtypes.JSONStringType,
+				Optional:            true,
+				MarkdownDescription: "JSON expression that must evaluate to `true` for the function to run. Using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) is recommended when setting this attribute.",
+			},
+			"private": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Flag that indicates whether the function can only be called from other functions, rules and triggers. Defaults to `false`.",
+			},
+			"run_as_system": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Flag that indicates whether the function runs as the system user, bypassing rules. Defaults to `false`.",
+			},
+			"disable_arg_logs": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Flag that indicates whether the function arguments are omitted from the App Services logs. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (r *functionRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFFunctionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	functionReq, diags := NewFunctionReq(&plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	appID := plan.AppID.ValueString()
+	client := NewClient(conn)
+	created, _, err := client.CreateFunction(ctx, projectID, appID, functionReq)
+	if err != nil {
+		resp.Diagnostics.AddError("error creating resource", err.Error())
+		return
+	}
+	// The create response only contains the id and name of the function.
+	apiResp, _, err := client.GetFunction(ctx, projectID, appID, created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+	newFunctionModel, diags := NewTFFunction(projectID, appID, &plan.CanEvaluate, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newFunctionModel)...)
+}
+
+func (r *functionRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFFunctionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+	appID := state.AppID.ValueString()
+	apiResp, getResp, err := NewClient(conn).GetFunction(ctx, projectID, appID, state.FunctionID.ValueString())
+	if err != nil {
+		if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+	newFunctionModel, diags := NewTFFunction(projectID, appID, &state.CanEvaluate, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newFunctionModel)...)
+}
+
+func (r *functionRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFFunctionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	functionReq, diags := NewFunctionReq(&plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	appID := plan.AppID.ValueString()
+	functionID := plan.FunctionID.ValueString()
+	client := NewClient(conn)
+	if _, err := client.UpdateFunction(ctx, projectID, appID, functionID, functionReq); err != nil {
+		resp.Diagnostics.AddError("error updating resource", err.Error())
+		return
+	}
+	apiResp, _, err := client.GetFunction(ctx, projectID, appID, functionID)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+	newFunctionModel, diags := NewTFFunction(projectID, appID, &plan.CanEvaluate, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newFunctionModel)...)
+}
+
+func (r *functionRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFFunctionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	if _, err := NewClient(conn).DeleteFunction(ctx, state.ProjectID.ValueString(), state.AppID.ValueString(), state.FunctionID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("error deleting resource", err.Error())
+		return
+	}
+}
+
+func (r *functionRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, appID, functionID, err := splitImportID(req.ID, "function_id")
+	if err != nil {
+		resp.Diagnostics.AddError("error splitting app services function import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("function_id"), functionID)...)
+}
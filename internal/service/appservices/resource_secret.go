@@ -0,0 +1,166 @@
+package appservices
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const secretName = "app_services_secret"
+
+var _ resource.ResourceWithConfigure = &secretRS{}
+var _ resource.ResourceWithImportState = &secretRS{}
+
+func SecretResource() resource.Resource {
+	return &secretRS{
+		RSCommon: config.RSCommon{
+			ResourceName: secretName,
+		},
+	}
+}
+
+type secretRS struct {
+	config.RSCommon
+}
+
+type TFSecretModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	AppID     types.String `tfsdk:"app_id"`
+	SecretID  types.String `tfsdk:"secret_id"`
+	Name      types.String `tfsdk:"name"`
+	Value     types.String `tfsdk:"value"`
+}
+
+func (r *secretRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = SecretResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func SecretResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"app_id":     appIDAttribute(),
+			"secret_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the secret.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the secret. It must be unique within the App Services application.",
+			},
+			"value": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				MarkdownDescription: "Content of the secret. App Services never returns it, so changes made outside of Terraform are not detected." +
+					" Use a `mongodbatlas_app_services_value` with `from_secret` set to `true` to expose it to functions.",
+			},
+		},
+	}
+}
+
+func (r *secretRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFSecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	appID := plan.AppID.ValueString()
+	apiResp, _, err := NewClient(conn).CreateSecret(ctx, projectID, appID, NewSecretReq(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("error creating resource", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFSecret(projectID, appID, plan.Value, apiResp))...)
+}
+
+func (r *secretRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFSecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+	appID := state.AppID.ValueString()
+	apiResp, _, err := NewClient(conn).GetSecret(ctx, projectID, appID, state.SecretID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+	if apiResp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFSecret(projectID, appID, state.Value, apiResp))...)
+}
+
+func (r *secretRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFSecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	appID := plan.AppID.ValueString()
+	secretReq := NewSecretReq(&plan)
+	if _, err := NewClient(conn).UpdateSecret(ctx, projectID, appID, plan.SecretID.ValueString(), secretReq); err != nil {
+		resp.Diagnostics.AddError("error updating resource", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFSecret(projectID, appID, plan.Value, secretReq))...)
+}
+
+func (r *secretRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFSecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	if _, err := NewClient(conn).DeleteSecret(ctx, state.ProjectID.ValueString(), state.AppID.ValueString(), state.SecretID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("error deleting resource", err.Error())
+		return
+	}
+}
+
+// ImportState leaves the value empty as the API doesn't return it, it is set again in the next apply.
+func (r *secretRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, appID, secretID, err := splitImportID(req.ID, "secret_id")
+	if err != nil {
+		resp.Diagnostics.AddError("error splitting app services secret import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret_id"), secretID)...)
+}
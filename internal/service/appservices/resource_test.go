@@ -0,0 +1,182 @@
+package appservices_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/appservices"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const (
+	functionResourceName = "mongodbatlas_app_services_function.test"
+	valueResourceName    = "mongodbatlas_app_services_value.test"
+	secretResourceName   = "mongodbatlas_app_services_secret.test"
+	triggerResourceName  = "mongodbatlas_event_trigger.test"
+)
+
+func TestAccAppServices_functionValueSecretAndTrigger(t *testing.T) {
+	acc.SkipTestForCI(t) // needs a project configured for triggers
+
+	var (
+		projectID = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		appID     = os.Getenv("MONGODB_REALM_APP_ID")
+		name      = acc.RandomName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(projectID, appID, name, "return 1;", "s3cr3t"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(functionResourceName),
+					checkExists(valueResourceName),
+					checkExists(secretResourceName),
+					resource.TestCheckResourceAttr(functionResourceName, "name", name),
+					resource.TestCheckResourceAttr(functionResourceName, "private", "true"),
+					resource.TestCheckResourceAttr(functionResourceName, "run_as_system", "false"),
+					resource.TestCheckResourceAttrPair(triggerResourceName, "function_id", functionResourceName, "function_id"),
+					resource.TestCheckResourceAttr(valueResourceName, "from_secret", "true"),
+					resource.TestCheckResourceAttr(secretResourceName, "name", name+"_secret"),
+				),
+			},
+			{
+				Config: configBasic(projectID, appID, name, "return 2;", "n3w"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(functionResourceName),
+					resource.TestCheckResourceAttr(functionResourceName, "source", "exports = function() { return 2; };"),
+					resource.TestCheckResourceAttr(secretResourceName, "value", "n3w"),
+				),
+			},
+			{
+				ResourceName:      functionResourceName,
+				ImportStateIdFunc: importStateIDFunc(functionResourceName, "function_id"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      valueResourceName,
+				ImportStateIdFunc: importStateIDFunc(valueResourceName, "value_id"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            secretResourceName,
+				ImportStateIdFunc:       importStateIDFunc(secretResourceName, "secret_id"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
+
+func configBasic(projectID, appID, name, body, secretValue string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_app_services_function" "test" {
+			project_id = %[1]q
+			app_id     = %[2]q
+			name       = %[3]q
+			source     = "exports = function() { %[4]s };"
+			private    = true
+		}
+
+		resource "mongodbatlas_app_services_secret" "test" {
+			project_id = %[1]q
+			app_id     = %[2]q
+			name       = "%[3]s_secret"
+			value      = %[5]q
+		}
+
+		resource "mongodbatlas_app_services_value" "test" {
+			project_id  = %[1]q
+			app_id      = %[2]q
+			name        = "%[3]s_value"
+			value       = jsonencode(mongodbatlas_app_services_secret.test.name)
+			from_secret = true
+		}
+
+		resource "mongodbatlas_event_trigger" "test" {
+			project_id  = %[1]q
+			app_id      = %[2]q
+			name        = %[3]q
+			type        = "SCHEDULED"
+			function_id = mongodbatlas_app_services_function.test.function_id
+			disabled    = true
+			scheduled {
+				schedule = "*/2 * * * *"
+			}
+		}
+	`, projectID, appID, name, body, secretValue)
+}
+
+func checkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		found, err := getResource(rs)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s does not exist", resourceName)
+		}
+		return nil
+	}
+}
+
+func checkDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		found, err := getResource(rs)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("%s (%s) still exists", rs.Type, rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// getResource returns whether the App Services resource exists, it ignores other resource types.
+func getResource(rs *terraform.ResourceState) (bool, error) {
+	ctx := context.Background()
+	conn, err := acc.MongoDBClient.GetRealmClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	client := appservices.NewClient(conn)
+	projectID := rs.Primary.Attributes["project_id"]
+	appID := rs.Primary.Attributes["app_id"]
+	switch rs.Type {
+	case "mongodbatlas_app_services_function":
+		function, _, _ := client.GetFunction(ctx, projectID, appID, rs.Primary.Attributes["function_id"])
+		return function != nil, nil
+	case "mongodbatlas_app_services_value":
+		value, _, _ := client.GetValue(ctx, projectID, appID, rs.Primary.Attributes["value_id"])
+		return value != nil, nil
+	case "mongodbatlas_app_services_secret":
+		secret, _, err := client.GetSecret(ctx, projectID, appID, rs.Primary.Attributes["secret_id"])
+		return secret != nil, err
+	}
+	return false, nil
+}
+
+func importStateIDFunc(resourceName, idAttribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s--%s--%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["app_id"], rs.Primary.Attributes[idAttribute]), nil
+	}
+}
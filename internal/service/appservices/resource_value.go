@@ -0,0 +1,213 @@
+package appservices
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const valueName = "app_services_value"
+
+var _ resource.ResourceWithConfigure = &valueRS{}
+var _ resource.ResourceWithImportState = &valueRS{}
+
+func ValueResource() resource.Resource {
+	return &valueRS{
+		RSCommon: config.RSCommon{
+			ResourceName: valueName,
+		},
+	}
+}
+
+type valueRS struct {
+	config.RSCommon
+}
+
+type TFValueModel struct {
+	ProjectID  types.String       `tfsdk:"project_id"`
+	AppID      types.String       `tfsdk:"app_id"`
+	ValueID    types.String       `tfsdk:"value_id"`
+	Name       types.String       `tfsdk:"name"`
+	Value      fwtypes.JSONString `tfsdk:"value"`
+	Private    types.Bool         `tfsdk:"private"`
+	FromSecret types.Bool         `tfsdk:"from_secret"`
+}
+
+func (r *valueRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ValueResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func ValueResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"app_id":     appIDAttribute(),
+			"value_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the value.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the value. It must be unique within the App Services application.",
+			},
+			"value": schema.StringAttribute{
+				CustomType: fwtypes.JSONStringType,
+				Required:   true,
+				MarkdownDescription: "JSON value stored. When `from_secret` is `true` it must be the JSON encoded name of a secret, e.g. `jsonencode(mongodbatlas_app_services_secret.example.name)`." +
+					" Using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) is recommended when setting this attribute.",
+			},
+			"private": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Flag that indicates whether the value can only be accessed from functions, rules and triggers. Defaults to `false`.",
+			},
+			"from_secret": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Flag that indicates whether the value exposes the content of the secret named in `value`. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (r *valueRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFValueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	valueReq, diags := NewValueReq(&plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	appID := plan.AppID.ValueString()
+	apiResp, _, err := NewClient(conn).CreateValue(ctx, projectID, appID, valueReq)
+	if err != nil {
+		resp.Diagnostics.AddError("error creating resource", err.Error())
+		return
+	}
+	newValueModel, diags := NewTFValue(projectID, appID, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newValueModel)...)
+}
+
+func (r *valueRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFValueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+	appID := state.AppID.ValueString()
+	apiResp, getResp, err := NewClient(conn).GetValue(ctx, projectID, appID, state.ValueID.ValueString())
+	if err != nil {
+		if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+	newValueModel, diags := NewTFValue(projectID, appID, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newValueModel)...)
+}
+
+func (r *valueRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFValueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	valueReq, diags := NewValueReq(&plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	appID := plan.AppID.ValueString()
+	valueID := plan.ValueID.ValueString()
+	client := NewClient(conn)
+	if _, err := client.UpdateValue(ctx, projectID, appID, valueID, valueReq); err != nil {
+		resp.Diagnostics.AddError("error updating resource", err.Error())
+		return
+	}
+	apiResp, _, err := client.GetValue(ctx, projectID, appID, valueID)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+	newValueModel, diags := NewTFValue(projectID, appID, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newValueModel)...)
+}
+
+func (r *valueRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFValueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	if _, err := NewClient(conn).DeleteValue(ctx, state.ProjectID.ValueString(), state.AppID.ValueString(), state.ValueID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("error deleting resource", err.Error())
+		return
+	}
+}
+
+func (r *valueRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, appID, valueID, err := splitImportID(req.ID, "value_id")
+	if err != nil {
+		resp.Diagnostics.AddError("error splitting app services value import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value_id"), valueID)...)
+}
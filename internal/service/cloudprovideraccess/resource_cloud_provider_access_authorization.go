@@ -69,6 +69,18 @@ func ResourceAuthorization() *schema.Resource {
 					},
 				},
 			},
+			"gcp": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_account_for_atlas": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"feature_usages": {
 				Type:     schema.TypeList,
 				Elem:     featureUsagesSchema(),
@@ -187,9 +199,9 @@ func roleToSchemaAuthorization(role *admin.CloudProviderAccessRole) map[string]a
 		"authorized_date": conversion.TimeToString(role.GetAuthorizedDate()),
 	}
 
-	if role.ProviderName == "AZURE" {
+	if role.ProviderName == constant.AZURE {
 		out = map[string]any{
-			"role_id": role.GetId(),
+			"role_id": accessRoleID(role),
 			"azure": []any{map[string]any{
 				"atlas_azure_app_id":   role.GetAtlasAzureAppId(),
 				"service_principal_id": role.GetServicePrincipalId(),
@@ -199,6 +211,16 @@ func roleToSchemaAuthorization(role *admin.CloudProviderAccessRole) map[string]a
 		}
 	}
 
+	if role.ProviderName == constant.GCP {
+		out = map[string]any{
+			"role_id": accessRoleID(role),
+			"gcp": []any{map[string]any{
+				"service_account_for_atlas": role.GetGcpServiceAccountForAtlas(),
+			}},
+			"authorized_date": conversion.TimeToString(role.GetAuthorizedDate()),
+		}
+	}
+
 	features := make([]map[string]any, 0, len(role.GetFeatureUsages()))
 	for _, featureUsage := range role.GetFeatureUsages() {
 		features = append(features, featureToSchema(featureUsage))
@@ -264,7 +286,6 @@ func authorizeRole(ctx context.Context, client *admin.APIClient, d *schema.Resou
 		ProviderName: targetRole.ProviderName,
 	}
 
-	roleID := targetRole.GetRoleId()
 	if targetRole.ProviderName == constant.AWS {
 		roleAWS, ok := d.GetOk("aws")
 		if !ok {
@@ -278,14 +299,13 @@ func authorizeRole(ctx context.Context, client *admin.APIClient, d *schema.Resou
 		req.SetAtlasAzureAppId(targetRole.GetAtlasAzureAppId())
 		req.SetTenantId(targetRole.GetTenantId())
 		req.SetServicePrincipalId(targetRole.GetServicePrincipalId())
-		roleID = targetRole.GetId()
 	}
 
 	var role *admin.CloudProviderAccessRole
 	var err error
 
 	for range 3 {
-		role, _, err = client.CloudProviderAccessApi.AuthorizeCloudProviderAccessRole(ctx, projectID, roleID, req).Execute()
+		role, _, err = client.CloudProviderAccessApi.AuthorizeCloudProviderAccessRole(ctx, projectID, accessRoleID(targetRole), req).Execute()
 		if err != nil && strings.Contains(err.Error(), "CANNOT_ASSUME_ROLE") { // aws takes time to update , in case of single path
 			log.Printf("warning issue performing authorize: %s \n", err.Error())
 			log.Println("retrying")
@@ -304,12 +324,8 @@ func authorizeRole(ctx context.Context, client *admin.APIClient, d *schema.Resou
 
 	authSchema := roleToSchemaAuthorization(role)
 
-	resourceID := role.GetRoleId()
-	if role.ProviderName == constant.AZURE {
-		resourceID = role.GetId()
-	}
 	d.SetId(conversion.EncodeStateID(map[string]string{
-		"id":         resourceID,
+		"id":         accessRoleID(role),
 		"project_id": projectID,
 	}))
 
@@ -322,6 +338,21 @@ func authorizeRole(ctx context.Context, client *admin.APIClient, d *schema.Resou
 	return nil
 }
 
+// accessRoleID returns the ID used to authorize the role, Azure roles are identified by _id and GCP roles by roleId or _id depending on the endpoint.
+func accessRoleID(role *admin.CloudProviderAccessRole) string {
+	switch role.ProviderName {
+	case constant.AZURE:
+		return role.GetId()
+	case constant.GCP:
+		if role.GetRoleId() != "" {
+			return role.GetRoleId()
+		}
+		return role.GetId()
+	default:
+		return role.GetRoleId()
+	}
+}
+
 func featureUsagesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -38,6 +38,31 @@ func TestAccCloudProviderAccessAuthorizationAzure_basic(t *testing.T) {
 	)
 }
 
+func TestAccCloudProviderAccessAuthorizationGCP_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_cloud_provider_access_authorization.test"
+		projectID    = acc.ProjectIDExecution(t)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configAuthorizationGCP(projectID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttrPair(resourceName, "role_id", "mongodbatlas_cloud_provider_access_setup.test", "role_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "gcp.0.service_account_for_atlas", "mongodbatlas_cloud_provider_access_setup.test", "gcp_config.0.service_account_for_atlas"),
+					resource.TestCheckResourceAttrSet(resourceName, "authorized_date"),
+				),
+			},
+		},
+	})
+}
+
 func basicAuthorizationTestCase(tb testing.TB) *resource.TestCase {
 	tb.Helper()
 
@@ -189,6 +214,20 @@ func configAuthorizationAzure(projectID, atlasAzureAppID, servicePrincipalID, te
 	`, projectID, atlasAzureAppID, servicePrincipalID, tenantID)
 }
 
+func configAuthorizationGCP(projectID string) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_cloud_provider_access_setup" "test" {
+		project_id    = %[1]q
+		provider_name = "GCP"
+	}
+
+	resource "mongodbatlas_cloud_provider_access_authorization" "test" {
+		project_id = %[1]q
+		role_id    = mongodbatlas_cloud_provider_access_setup.test.role_id
+	}
+	`, projectID)
+}
+
 func checkDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "mongodbatlas_cloud_provider_access" {
@@ -48,7 +48,7 @@ func ResourceSetup() *schema.Resource {
 			"provider_name": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{constant.AWS, constant.AZURE}, false),
+				ValidateFunc: validation.StringInSlice([]string{constant.AWS, constant.AZURE, constant.GCP}, false),
 				ForceNew:     true,
 			},
 			"aws_config": {
@@ -87,6 +87,18 @@ func ResourceSetup() *schema.Resource {
 					},
 				},
 			},
+			"gcp_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_account_for_atlas": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"created_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -155,13 +167,9 @@ func resourceCloudProviderAccessSetupCreate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(fmt.Errorf(errorCloudProviderAccessCreate, err))
 	}
 
-	// once multiple providers enable here do a switch, select for provider type
 	roleSchema := roleToSchemaSetup(role)
 
-	resourceID := role.GetRoleId()
-	if role.ProviderName == constant.AZURE {
-		resourceID = role.GetId()
-	}
+	resourceID := roleSchema["role_id"].(string)
 
 	d.SetId(conversion.EncodeStateID(map[string]string{
 		"id":            resourceID,
@@ -216,6 +224,18 @@ func roleToSchemaSetup(role *admin.CloudProviderAccessRole) map[string]any {
 		return out
 	}
 
+	if role.ProviderName == constant.GCP {
+		return map[string]any{
+			"provider_name": role.GetProviderName(),
+			"gcp_config": []any{map[string]any{
+				"service_account_for_atlas": role.GetGcpServiceAccountForAtlas(),
+			}},
+			"aws_config":   []any{map[string]any{}},
+			"created_date": conversion.TimeToString(role.GetCreatedDate()),
+			"role_id":      accessRoleID(role),
+		}
+	}
+
 	out := map[string]any{
 		"provider_name": role.ProviderName,
 		"azure_config": []any{map[string]any{
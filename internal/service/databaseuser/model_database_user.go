@@ -3,9 +3,13 @@ package databaseuser
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"go.mongodb.org/atlas-sdk/v20241113004/admin"
@@ -87,6 +91,11 @@ func NewTfDatabaseUserModel(ctx context.Context, model *TfDatabaseUserModel, dbU
 		Scopes:           scopesSet,
 	}
 
+	if model != nil {
+		// validate_references is a provider-only setting not returned by the API
+		databaseUserModel.ValidateReferences = model.ValidateReferences
+	}
+
 	if model != nil && model.Password.ValueString() != "" {
 		// The Password is not retuned from the endpoint so we use the one provided in the model
 		databaseUserModel.Password = model.Password
@@ -200,3 +209,116 @@ func NewMongoDBAtlasRoles(roles []*TfRoleModel) *[]admin.DatabaseUserRole {
 	}
 	return &out
 }
+
+const (
+	scopeTypeCluster  = "CLUSTER"
+	scopeTypeDataLake = "DATA_LAKE"
+	adminDatabaseName = "admin"
+)
+
+// builtInRoles maps the built-in roles accepted by Atlas to whether they must be granted on the admin database.
+var builtInRoles = map[string]bool{
+	"read":                  false,
+	"readWrite":             false,
+	"dbAdmin":               false,
+	"dbOwner":               false,
+	"userAdmin":             false,
+	"atlasAdmin":            true,
+	"backup":                true,
+	"restore":               true,
+	"clusterAdmin":          true,
+	"clusterManager":        true,
+	"clusterMonitor":        true,
+	"hostManager":           true,
+	"enableSharding":        true,
+	"killOpSession":         true,
+	"directShardOperations": true,
+	"readAnyDatabase":       true,
+	"readWriteAnyDatabase":  true,
+	"dbAdminAnyDatabase":    true,
+	"userAdminAnyDatabase":  true,
+	"root":                  true,
+}
+
+// ReferenceInventory holds the names of the project entities that database user roles and scopes can reference.
+type ReferenceInventory struct {
+	CustomRoles map[string]bool
+	Clusters    map[string]bool
+	DataLakes   map[string]bool
+}
+
+// ValidateReferences checks roles against built-in and custom roles, and scopes against existing clusters and data lakes.
+func ValidateReferences(ctx context.Context, dbUserModel *TfDatabaseUserModel, inventory *ReferenceInventory) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, elem := range dbUserModel.Roles.Elements() {
+		var role TfRoleModel
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		if d := obj.As(ctx, &role, basetypes.ObjectAsOptions{}); d.HasError() {
+			diags.Append(d...)
+			continue
+		}
+		if role.RoleName.IsUnknown() || role.DatabaseName.IsUnknown() {
+			continue
+		}
+		rolePath := path.Root("roles").AtSetValue(elem)
+		roleName := role.RoleName.ValueString()
+		databaseName := role.DatabaseName.ValueString()
+		adminOnly, isBuiltIn := builtInRoles[roleName]
+		isCustom := inventory.CustomRoles[roleName]
+		switch {
+		case !isBuiltIn && !isCustom:
+			diags.AddAttributeError(rolePath.AtName("role_name"), "unknown role",
+				fmt.Sprintf("role %q is neither a built-in role nor a custom database role of the project, available custom roles: %s", roleName, joinNames(inventory.CustomRoles)))
+		case (isCustom || adminOnly) && databaseName != adminDatabaseName:
+			diags.AddAttributeError(rolePath.AtName("database_name"), "invalid database for role",
+				fmt.Sprintf("role %q must be granted on the %q database, got %q", roleName, adminDatabaseName, databaseName))
+		}
+	}
+	for _, elem := range dbUserModel.Scopes.Elements() {
+		var scope TfScopeModel
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		if d := obj.As(ctx, &scope, basetypes.ObjectAsOptions{}); d.HasError() {
+			diags.Append(d...)
+			continue
+		}
+		if scope.Name.IsUnknown() || scope.Type.IsUnknown() || scope.Type.IsNull() {
+			continue
+		}
+		scopePath := path.Root("scopes").AtSetValue(elem)
+		scopeName := scope.Name.ValueString()
+		switch scopeType := scope.Type.ValueString(); scopeType {
+		case scopeTypeCluster:
+			if !inventory.Clusters[scopeName] {
+				diags.AddAttributeError(scopePath.AtName("name"), "unknown cluster",
+					fmt.Sprintf("cluster %q does not exist in the project, existing clusters: %s", scopeName, joinNames(inventory.Clusters)))
+			}
+		case scopeTypeDataLake:
+			if !inventory.DataLakes[scopeName] {
+				diags.AddAttributeError(scopePath.AtName("name"), "unknown data lake",
+					fmt.Sprintf("data lake %q does not exist in the project, existing data lakes: %s", scopeName, joinNames(inventory.DataLakes)))
+			}
+		default:
+			diags.AddAttributeError(scopePath.AtName("type"), "invalid scope type",
+				fmt.Sprintf("scope type must be %s or %s, got %q", scopeTypeCluster, scopeTypeDataLake, scopeType))
+		}
+	}
+	return diags
+}
+
+func joinNames(names map[string]bool) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	list := make([]string, 0, len(names))
+	for name := range names {
+		list = append(list, name)
+	}
+	slices.Sort(list)
+	return strings.Join(list, ", ")
+}
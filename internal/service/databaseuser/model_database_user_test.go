@@ -344,6 +344,72 @@ func TestNewTFRolesModel(t *testing.T) {
 	}
 }
 
+func TestValidateReferences(t *testing.T) {
+	inventory := &databaseuser.ReferenceInventory{
+		CustomRoles: map[string]bool{"myCustomRole": true},
+		Clusters:    map[string]bool{"cluster0": true},
+		DataLakes:   map[string]bool{"lake0": true},
+	}
+	testCases := map[string]struct {
+		roles          []databaseuser.TfRoleModel
+		scopes         []databaseuser.TfScopeModel
+		expectedErrors []string
+	}{
+		"valid built-in and custom roles and scopes": {
+			roles: []databaseuser.TfRoleModel{
+				{RoleName: types.StringValue("readWrite"), DatabaseName: types.StringValue("sales")},
+				{RoleName: types.StringValue("readAnyDatabase"), DatabaseName: types.StringValue("admin")},
+				{RoleName: types.StringValue("myCustomRole"), DatabaseName: types.StringValue("admin")},
+			},
+			scopes: []databaseuser.TfScopeModel{
+				{Name: types.StringValue("cluster0"), Type: types.StringValue("CLUSTER")},
+				{Name: types.StringValue("lake0"), Type: types.StringValue("DATA_LAKE")},
+			},
+		},
+		"unknown role name": {
+			roles: []databaseuser.TfRoleModel{
+				{RoleName: types.StringValue("myCustomRol"), DatabaseName: types.StringValue("admin")},
+			},
+			expectedErrors: []string{"unknown role"},
+		},
+		"custom and admin-only roles on non-admin database": {
+			roles: []databaseuser.TfRoleModel{
+				{RoleName: types.StringValue("myCustomRole"), DatabaseName: types.StringValue("sales")},
+				{RoleName: types.StringValue("atlasAdmin"), DatabaseName: types.StringValue("sales")},
+			},
+			expectedErrors: []string{"invalid database for role", "invalid database for role"},
+		},
+		"missing cluster and data lake": {
+			scopes: []databaseuser.TfScopeModel{
+				{Name: types.StringValue("deleted"), Type: types.StringValue("CLUSTER")},
+				{Name: types.StringValue("deleted"), Type: types.StringValue("DATA_LAKE")},
+			},
+			expectedErrors: []string{"unknown cluster", "unknown data lake"},
+		},
+		"invalid scope type": {
+			scopes: []databaseuser.TfScopeModel{
+				{Name: types.StringValue("cluster0"), Type: types.StringValue("SERVERLESS")},
+			},
+			expectedErrors: []string{"invalid scope type"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			roles, diags := types.SetValueFrom(context.Background(), databaseuser.RoleObjectType, tc.roles)
+			require.False(t, diags.HasError())
+			scopes, diags := types.SetValueFrom(context.Background(), databaseuser.ScopeObjectType, tc.scopes)
+			require.False(t, diags.HasError())
+			model := &databaseuser.TfDatabaseUserModel{Roles: roles, Scopes: scopes}
+			diags = databaseuser.ValidateReferences(context.Background(), model, inventory)
+			summaries := []string{}
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.ElementsMatch(t, tc.expectedErrors, summaries)
+		})
+	}
+}
+
 func getDatabaseUserModel(roles, labels, scopes basetypes.SetValue, password types.String) *databaseuser.TfDatabaseUserModel {
 	encodedID := conversion.EncodeStateID(map[string]string{
 		"project_id":         projectID,
@@ -18,7 +18,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
 )
 
 const (
@@ -28,6 +30,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &databaseUserRS{}
 var _ resource.ResourceWithImportState = &databaseUserRS{}
+var _ resource.ResourceWithModifyPlan = &databaseUserRS{}
 
 type databaseUserRS struct {
 	config.RSCommon
@@ -42,18 +45,19 @@ func Resource() resource.Resource {
 }
 
 type TfDatabaseUserModel struct {
-	ID               types.String `tfsdk:"id"`
-	ProjectID        types.String `tfsdk:"project_id"`
-	AuthDatabaseName types.String `tfsdk:"auth_database_name"`
-	Username         types.String `tfsdk:"username"`
-	Password         types.String `tfsdk:"password"`
-	X509Type         types.String `tfsdk:"x509_type"`
-	OIDCAuthType     types.String `tfsdk:"oidc_auth_type"`
-	LDAPAuthType     types.String `tfsdk:"ldap_auth_type"`
-	AWSIAMType       types.String `tfsdk:"aws_iam_type"`
-	Roles            types.Set    `tfsdk:"roles"`
-	Labels           types.Set    `tfsdk:"labels"`
-	Scopes           types.Set    `tfsdk:"scopes"`
+	ID                 types.String `tfsdk:"id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	AuthDatabaseName   types.String `tfsdk:"auth_database_name"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	X509Type           types.String `tfsdk:"x509_type"`
+	OIDCAuthType       types.String `tfsdk:"oidc_auth_type"`
+	LDAPAuthType       types.String `tfsdk:"ldap_auth_type"`
+	AWSIAMType         types.String `tfsdk:"aws_iam_type"`
+	Roles              types.Set    `tfsdk:"roles"`
+	Labels             types.Set    `tfsdk:"labels"`
+	Scopes             types.Set    `tfsdk:"scopes"`
+	ValidateReferences types.Bool   `tfsdk:"validate_references"`
 }
 
 type TfRoleModel struct {
@@ -152,6 +156,9 @@ func (r *databaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringvalidator.OneOf("NONE", "USER", "ROLE"),
 				},
 			},
+			"validate_references": schema.BoolAttribute{
+				Optional: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"roles": schema.SetNestedBlock{
@@ -202,6 +209,26 @@ func (r *databaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *databaseUserRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan TfDatabaseUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.ValidateReferences.ValueBool() || plan.ProjectID.IsUnknown() || plan.Roles.IsUnknown() || plan.Scopes.IsUnknown() {
+		return
+	}
+	inventory, err := getReferenceInventory(ctx, r.Client.AtlasV2, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error getting project references to validate the database user", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(ValidateReferences(ctx, &plan, inventory)...)
+}
+
 func (r *databaseUserRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var databaseUserPlan *TfDatabaseUserModel
 
@@ -364,3 +391,35 @@ func SplitDatabaseUserImportID(id string) (projectID, username, authDatabaseName
 	authDatabaseName = parts[3]
 	return
 }
+
+func getReferenceInventory(ctx context.Context, connV2 *admin.APIClient, projectID string) (*ReferenceInventory, error) {
+	customRoles, _, err := connV2.CustomDatabaseRolesApi.ListCustomDatabaseRoles(ctx, projectID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.ClusterDescription20240805], *http.Response, error) {
+		return connV2.ClustersApi.ListClusters(ctx, projectID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+	dataLakes, _, err := connV2.DataFederationApi.ListFederatedDatabases(ctx, projectID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	inventory := &ReferenceInventory{
+		CustomRoles: make(map[string]bool, len(customRoles)),
+		Clusters:    make(map[string]bool, len(clusters)),
+		DataLakes:   make(map[string]bool, len(dataLakes)),
+	}
+	for i := range customRoles {
+		inventory.CustomRoles[customRoles[i].GetRoleName()] = true
+	}
+	for i := range clusters {
+		inventory.Clusters[clusters[i].GetName()] = true
+	}
+	for i := range dataLakes {
+		inventory.DataLakes[dataLakes[i].GetName()] = true
+	}
+	return inventory, nil
+}
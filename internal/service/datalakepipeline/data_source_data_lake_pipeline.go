@@ -6,8 +6,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+const (
+	errorDataLakePipelineRead    = "error reading MongoDB Atlas DataLake Pipeline (%s): %s"
+	errorDataLakePipelineSetting = "error setting `%s` for MongoDB Atlas DataLake Pipeline (%s): %s"
+	ErrorDataLakeSetting         = "error setting `%s` for MongoDB Atlas DataLake (%s): %s"
 )
 
 func DataSource() *schema.Resource {
@@ -178,10 +186,15 @@ func dataSourceSchemaSnapshots() *schema.Schema {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
-				"frequency_yype": {
+				"frequency_type": {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"frequency_yype": {
+					Type:       schema.TypeString,
+					Computed:   true,
+					Deprecated: fmt.Sprintf(constant.DeprecationParamWithReplacement, "`frequency_type`"),
+				},
 				"master_key": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -287,3 +300,104 @@ func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	return nil
 }
+
+func flattenSource(source *admin.IngestionSource) []map[string]any {
+	if source == nil {
+		return nil
+	}
+	return []map[string]any{
+		{
+			"type":            source.GetType(),
+			"cluster_name":    source.GetClusterName(),
+			"collection_name": source.GetCollectionName(),
+			"database_name":   source.GetDatabaseName(),
+			"project_id":      source.GetGroupId(),
+		},
+	}
+}
+
+func flattenSink(sink *admin.IngestionSink) []map[string]any {
+	if sink == nil {
+		return nil
+	}
+	return []map[string]any{
+		{
+			"type":             sink.GetType(),
+			"provider":         sink.GetMetadataProvider(),
+			"region":           sink.GetMetadataRegion(),
+			"partition_fields": flattenPartitionFields(sink.GetPartitionFields()),
+		},
+	}
+}
+
+func flattenIngestionSchedules(schedules []admin.DiskBackupApiPolicyItem) []map[string]any {
+	if len(schedules) == 0 {
+		return nil
+	}
+	out := make([]map[string]any, len(schedules))
+	for i, schedule := range schedules {
+		out[i] = map[string]any{
+			"id":                 schedule.GetId(),
+			"frequency_type":     schedule.GetFrequencyType(),
+			"frequency_interval": schedule.GetFrequencyInterval(),
+			"retention_unit":     schedule.GetRetentionUnit(),
+			"retention_value":    schedule.GetRetentionValue(),
+		}
+	}
+	return out
+}
+
+func flattenSnapshots(snapshots []admin.DiskBackupSnapshot) []map[string]any {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	out := make([]map[string]any, len(snapshots))
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		out[i] = map[string]any{
+			"id":               snapshot.GetId(),
+			"provider":         snapshot.GetCloudProvider(),
+			"created_at":       conversion.TimePtrToStringPtr(snapshot.CreatedAt),
+			"expires_at":       conversion.TimePtrToStringPtr(snapshot.ExpiresAt),
+			"frequency_type":   snapshot.GetFrequencyType(),
+			"frequency_yype":   snapshot.GetFrequencyType(),
+			"master_key":       snapshot.GetMasterKeyUUID(),
+			"mongod_version":   snapshot.GetMongodVersion(),
+			"replica_set_name": snapshot.GetReplicaSetName(),
+			"type":             snapshot.GetType(),
+			"snapshot_type":    snapshot.GetSnapshotType(),
+			"status":           snapshot.GetStatus(),
+			"size":             snapshot.GetStorageSizeBytes(),
+			"policies":         snapshot.GetPolicyItems(),
+		}
+	}
+	return out
+}
+
+func flattenTransformations(transformations []admin.FieldTransformation) []map[string]any {
+	if len(transformations) == 0 {
+		return nil
+	}
+	out := make([]map[string]any, len(transformations))
+	for i, transformation := range transformations {
+		out[i] = map[string]any{
+			"type":  transformation.GetType(),
+			"field": transformation.GetField(),
+		}
+	}
+	return out
+}
+
+func flattenPartitionFields(fields []admin.DataLakePipelinesPartitionField) []map[string]any {
+	if len(fields) == 0 {
+		return nil
+	}
+	out := make([]map[string]any, len(fields))
+	for i, field := range fields {
+		out[i] = map[string]any{
+			"field_name": field.GetFieldName(),
+			"order":      field.GetOrder(),
+		}
+	}
+	return out
+}
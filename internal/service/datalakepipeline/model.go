@@ -0,0 +1,255 @@
+package datalakepipeline
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+func NewAtlasReq(ctx context.Context, plan *TFDataLakePipelineModel) (*admin.DataLakeIngestionPipeline, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := &admin.DataLakeIngestionPipeline{
+		GroupId: plan.ProjectID.ValueStringPointer(),
+		Name:    plan.Name.ValueStringPointer(),
+	}
+
+	var sinks []TFSinkModel
+	diags.Append(plan.Sink.ElementsAs(ctx, &sinks, false)...)
+	if len(sinks) == 1 {
+		sink := sinks[0]
+		req.Sink = &admin.IngestionSink{
+			Type:             conversion.NilForUnknownOrEmptyString(sink.Type),
+			MetadataProvider: conversion.NilForUnknownOrEmptyString(sink.Provider),
+			MetadataRegion:   conversion.NilForUnknownOrEmptyString(sink.Region),
+		}
+		var partitionFields []TFPartitionFieldModel
+		diags.Append(sink.PartitionFields.ElementsAs(ctx, &partitionFields, false)...)
+		if len(partitionFields) > 0 {
+			fields := make([]admin.DataLakePipelinesPartitionField, len(partitionFields))
+			for i, field := range partitionFields {
+				fields[i] = admin.DataLakePipelinesPartitionField{
+					FieldName: field.FieldName.ValueString(),
+					Order:     int(field.Order.ValueInt64()),
+				}
+			}
+			req.Sink.PartitionFields = &fields
+		}
+	}
+
+	var sources []TFSourceModel
+	diags.Append(plan.Source.ElementsAs(ctx, &sources, false)...)
+	if len(sources) == 1 {
+		source := sources[0]
+		req.Source = &admin.IngestionSource{
+			Type:           conversion.NilForUnknownOrEmptyString(source.Type),
+			ClusterName:    conversion.NilForUnknownOrEmptyString(source.ClusterName),
+			CollectionName: conversion.NilForUnknownOrEmptyString(source.CollectionName),
+			DatabaseName:   conversion.NilForUnknownOrEmptyString(source.DatabaseName),
+			PolicyItemId:   conversion.NilForUnknownOrEmptyString(source.PolicyItemID),
+		}
+	}
+
+	var transformations []TFTransformationModel
+	diags.Append(plan.Transformations.ElementsAs(ctx, &transformations, false)...)
+	if len(transformations) > 0 {
+		fieldTransformations := make([]admin.FieldTransformation, 0, len(transformations))
+		for _, transformation := range transformations {
+			field := conversion.NilForUnknownOrEmptyString(transformation.Field)
+			transformationType := conversion.NilForUnknownOrEmptyString(transformation.Type)
+			if field != nil || transformationType != nil {
+				fieldTransformations = append(fieldTransformations, admin.FieldTransformation{Field: field, Type: transformationType})
+			}
+		}
+		req.Transformations = &fieldTransformations
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+	return req, diags
+}
+
+// NewTFDataLakePipeline builds the resource model, policy_item_id is taken from prior as the API doesn't return it.
+func NewTFDataLakePipeline(ctx context.Context, projectID string, prior *TFDataLakePipelineModel, pipeline *admin.DataLakeIngestionPipeline,
+	snapshots []admin.DiskBackupSnapshot, schedules []admin.DiskBackupApiPolicyItem) (*TFDataLakePipelineModel, diag.Diagnostics) {
+	var diags, localDiags diag.Diagnostics
+	name := pipeline.GetName()
+	model := &TFDataLakePipelineModel{
+		ID: types.StringValue(conversion.EncodeStateID(map[string]string{
+			"project_id": projectID,
+			"name":       name,
+		})),
+		ProjectID:       types.StringValue(projectID),
+		Name:            types.StringValue(name),
+		CreatedDate:     types.StringPointerValue(conversion.TimePtrToStringPtr(pipeline.CreatedDate)),
+		LastUpdatedDate: types.StringPointerValue(conversion.TimePtrToStringPtr(pipeline.LastUpdatedDate)),
+		State:           types.StringValue(pipeline.GetState()),
+		Paused:          types.BoolValue(pipeline.GetState() == StatePaused),
+	}
+
+	model.Sink, localDiags = newTFSink(ctx, pipeline.Sink)
+	diags.Append(localDiags...)
+	model.Source, localDiags = newTFSource(ctx, prior, pipeline.Source)
+	diags.Append(localDiags...)
+	model.Transformations, localDiags = newTFTransformations(ctx, pipeline.GetTransformations())
+	diags.Append(localDiags...)
+	model.Snapshots, localDiags = newTFSnapshots(ctx, snapshots)
+	diags.Append(localDiags...)
+	model.IngestionSchedules, localDiags = newTFIngestionSchedules(ctx, schedules)
+	diags.Append(localDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return model, diags
+}
+
+func newTFSink(ctx context.Context, sink *admin.IngestionSink) (types.List, diag.Diagnostics) {
+	if sink == nil {
+		return types.ListValueMust(SinkObjectType, nil), nil
+	}
+	var diags diag.Diagnostics
+	partitionFields := types.ListValueMust(PartitionFieldObjectType, nil)
+	if fields := sink.GetPartitionFields(); len(fields) > 0 {
+		tfFields := make([]TFPartitionFieldModel, len(fields))
+		for i, field := range fields {
+			tfFields[i] = TFPartitionFieldModel{
+				FieldName: types.StringValue(field.GetFieldName()),
+				Order:     types.Int64Value(int64(field.GetOrder())),
+			}
+		}
+		var localDiags diag.Diagnostics
+		partitionFields, localDiags = types.ListValueFrom(ctx, PartitionFieldObjectType, tfFields)
+		diags.Append(localDiags...)
+	}
+	list, localDiags := types.ListValueFrom(ctx, SinkObjectType, []TFSinkModel{{
+		Type:            conversion.StringNullIfEmpty(sink.GetType()),
+		Provider:        types.StringValue(sink.GetMetadataProvider()),
+		Region:          types.StringValue(sink.GetMetadataRegion()),
+		PartitionFields: partitionFields,
+	}})
+	diags.Append(localDiags...)
+	return list, diags
+}
+
+func newTFSource(ctx context.Context, prior *TFDataLakePipelineModel, source *admin.IngestionSource) (types.List, diag.Diagnostics) {
+	if source == nil {
+		return types.ListValueMust(SourceObjectType, nil), nil
+	}
+	var diags diag.Diagnostics
+	policyItemID := conversion.StringNullIfEmpty(source.GetPolicyItemId())
+	if prior != nil && policyItemID.IsNull() {
+		var priorSources []TFSourceModel
+		diags.Append(prior.Source.ElementsAs(ctx, &priorSources, false)...)
+		if len(priorSources) == 1 {
+			policyItemID = conversion.StringNullIfEmpty(priorSources[0].PolicyItemID.ValueString())
+		}
+	}
+	list, localDiags := types.ListValueFrom(ctx, SourceObjectType, []TFSourceModel{{
+		Type:           conversion.StringNullIfEmpty(source.GetType()),
+		ClusterName:    conversion.StringNullIfEmpty(source.GetClusterName()),
+		CollectionName: conversion.StringNullIfEmpty(source.GetCollectionName()),
+		DatabaseName:   conversion.StringNullIfEmpty(source.GetDatabaseName()),
+		PolicyItemID:   policyItemID,
+		ProjectID:      types.StringValue(source.GetGroupId()),
+	}})
+	diags.Append(localDiags...)
+	return list, diags
+}
+
+func newTFTransformations(ctx context.Context, transformations []admin.FieldTransformation) (types.List, diag.Diagnostics) {
+	if len(transformations) == 0 {
+		return types.ListValueMust(TransformationObjectType, nil), nil
+	}
+	tfTransformations := make([]TFTransformationModel, len(transformations))
+	for i, transformation := range transformations {
+		tfTransformations[i] = TFTransformationModel{
+			Field: conversion.StringNullIfEmpty(transformation.GetField()),
+			Type:  conversion.StringNullIfEmpty(transformation.GetType()),
+		}
+	}
+	return types.ListValueFrom(ctx, TransformationObjectType, tfTransformations)
+}
+
+func newTFSnapshots(ctx context.Context, snapshots []admin.DiskBackupSnapshot) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tfSnapshots := make([]TFSnapshotModel, len(snapshots))
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		policies, localDiags := types.ListValueFrom(ctx, types.StringType, snapshot.GetPolicyItems())
+		diags.Append(localDiags...)
+		tfSnapshots[i] = TFSnapshotModel{
+			ID:             types.StringValue(snapshot.GetId()),
+			Provider:       types.StringValue(snapshot.GetCloudProvider()),
+			CreatedAt:      types.StringPointerValue(conversion.TimePtrToStringPtr(snapshot.CreatedAt)),
+			ExpiresAt:      types.StringPointerValue(conversion.TimePtrToStringPtr(snapshot.ExpiresAt)),
+			FrequencyType:  types.StringValue(snapshot.GetFrequencyType()),
+			MasterKey:      types.StringValue(snapshot.GetMasterKeyUUID()),
+			MongodVersion:  types.StringValue(snapshot.GetMongodVersion()),
+			ReplicaSetName: types.StringValue(snapshot.GetReplicaSetName()),
+			SnapshotType:   types.StringValue(snapshot.GetSnapshotType()),
+			Type:           types.StringValue(snapshot.GetType()),
+			Status:         types.StringValue(snapshot.GetStatus()),
+			Size:           types.Int64Value(snapshot.GetStorageSizeBytes()),
+			CopyRegion:     types.StringNull(),
+			Policies:       policies,
+		}
+	}
+	set, localDiags := types.SetValueFrom(ctx, SnapshotObjectType, tfSnapshots)
+	diags.Append(localDiags...)
+	return set, diags
+}
+
+func newTFIngestionSchedules(ctx context.Context, schedules []admin.DiskBackupApiPolicyItem) (types.Set, diag.Diagnostics) {
+	tfSchedules := make([]TFIngestionScheduleModel, len(schedules))
+	for i, schedule := range schedules {
+		tfSchedules[i] = TFIngestionScheduleModel{
+			ID:                types.StringValue(schedule.GetId()),
+			FrequencyType:     types.StringValue(schedule.GetFrequencyType()),
+			RetentionUnit:     types.StringValue(schedule.GetRetentionUnit()),
+			RetentionValue:    types.Int64Value(int64(schedule.GetRetentionValue())),
+			FrequencyInterval: types.Int64Value(int64(schedule.GetFrequencyInterval())),
+		}
+	}
+	return types.SetValueFrom(ctx, IngestionScheduleObjectType, tfSchedules)
+}
+
+func NewTFPipelineRunTrigger(ctx context.Context, prior *TFPipelineRunTriggerModel, run *admin.IngestionPipelineRun) (*TFPipelineRunTriggerModel, diag.Diagnostics) {
+	stats, diags := newTFRunStats(ctx, run.Stats)
+	if diags.HasError() {
+		return nil, diags
+	}
+	runID := run.GetId()
+	return &TFPipelineRunTriggerModel{
+		ID: types.StringValue(conversion.EncodeStateID(map[string]string{
+			"project_id":      prior.ProjectID.ValueString(),
+			"pipeline_name":   prior.PipelineName.ValueString(),
+			"pipeline_run_id": runID,
+		})),
+		ProjectID:           prior.ProjectID,
+		PipelineName:        prior.PipelineName,
+		SnapshotID:          prior.SnapshotID,
+		PipelineRunID:       types.StringValue(runID),
+		CreatedDate:         types.StringPointerValue(conversion.TimePtrToStringPtr(run.CreatedDate)),
+		LastUpdatedDate:     types.StringPointerValue(conversion.TimePtrToStringPtr(run.LastUpdatedDate)),
+		State:               types.StringValue(run.GetState()),
+		DatasetName:         types.StringValue(run.GetDatasetName()),
+		Phase:               types.StringValue(run.GetPhase()),
+		PipelineID:          types.StringValue(run.GetPipelineId()),
+		BackupFrequencyType: types.StringValue(run.GetBackupFrequencyType()),
+		Stats:               stats,
+		Timeouts:            prior.Timeouts,
+	}, diags
+}
+
+func newTFRunStats(ctx context.Context, stats *admin.PipelineRunStats) (types.List, diag.Diagnostics) {
+	if stats == nil {
+		return types.ListValueMust(RunStatsObjectType, nil), nil
+	}
+	return types.ListValueFrom(ctx, RunStatsObjectType, []TFRunStatsModel{{
+		BytesExported: types.Int64Value(stats.GetBytesExported()),
+		NumDocs:       types.Int64Value(stats.GetNumDocs()),
+	}})
+}
@@ -0,0 +1,285 @@
+package datalakepipeline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/datalakepipeline"
+)
+
+const (
+	projectID    = "664619d870c247237f4b86a6"
+	pipelineName = "test-pipeline"
+	runID        = "6646255cd2d6fd9c0e3a1b2c"
+	snapshotID   = "6646255cd2d6fd9c0e3a1b2d"
+	policyItemID = "6646255cd2d6fd9c0e3a1b2e"
+	clusterName  = "test-cluster"
+)
+
+var (
+	createdDate = time.Date(2024, 5, 16, 14, 0, 0, 0, time.UTC)
+	stateID     = conversion.EncodeStateID(map[string]string{
+		"project_id": projectID,
+		"name":       pipelineName,
+	})
+)
+
+func TestNewAtlasReq(t *testing.T) {
+	ctx := context.Background()
+	plan := pipelineModel(ctx, nil)
+	req, diags := datalakepipeline.NewAtlasReq(ctx, plan)
+	require.False(t, diags.HasError())
+	expected := &admin.DataLakeIngestionPipeline{
+		GroupId: admin.PtrString(projectID),
+		Name:    admin.PtrString(pipelineName),
+		Sink: &admin.IngestionSink{
+			Type: admin.PtrString("DLS"),
+			PartitionFields: &[]admin.DataLakePipelinesPartitionField{
+				{FieldName: "access", Order: 0},
+			},
+		},
+		Source: &admin.IngestionSource{
+			Type:           admin.PtrString("ON_DEMAND_CPS"),
+			ClusterName:    admin.PtrString(clusterName),
+			CollectionName: admin.PtrString("listingsAndReviews"),
+			DatabaseName:   admin.PtrString("sample_airbnb"),
+			PolicyItemId:   admin.PtrString(policyItemID),
+		},
+		Transformations: &[]admin.FieldTransformation{
+			{Field: admin.PtrString("test"), Type: admin.PtrString("EXCLUDE")},
+		},
+	}
+	assert.Equal(t, expected, req)
+}
+
+func TestNewTFDataLakePipeline(t *testing.T) {
+	ctx := context.Background()
+	apiPipeline := &admin.DataLakeIngestionPipeline{
+		GroupId:     admin.PtrString(projectID),
+		Name:        admin.PtrString(pipelineName),
+		CreatedDate: admin.PtrTime(createdDate),
+		State:       admin.PtrString(datalakepipeline.StatePaused),
+		Sink: &admin.IngestionSink{
+			Type:             admin.PtrString("DLS"),
+			MetadataProvider: admin.PtrString("AWS"),
+			MetadataRegion:   admin.PtrString("US_EAST_1"),
+			PartitionFields: &[]admin.DataLakePipelinesPartitionField{
+				{FieldName: "access", Order: 0},
+			},
+		},
+		Source: &admin.IngestionSource{
+			Type:           admin.PtrString("ON_DEMAND_CPS"),
+			ClusterName:    admin.PtrString(clusterName),
+			CollectionName: admin.PtrString("listingsAndReviews"),
+			DatabaseName:   admin.PtrString("sample_airbnb"),
+			GroupId:        admin.PtrString(projectID),
+		},
+		Transformations: &[]admin.FieldTransformation{
+			{Field: admin.PtrString("test"), Type: admin.PtrString("EXCLUDE")},
+		},
+	}
+	snapshots := []admin.DiskBackupSnapshot{{
+		Id:            admin.PtrString(snapshotID),
+		CloudProvider: admin.PtrString("AWS"),
+		FrequencyType: admin.PtrString("daily"),
+		Status:        admin.PtrString("completed"),
+		PolicyItems:   &[]string{policyItemID},
+	}}
+	schedules := []admin.DiskBackupApiPolicyItem{{
+		Id:                admin.PtrString(policyItemID),
+		FrequencyType:     "daily",
+		FrequencyInterval: 1,
+		RetentionUnit:     "days",
+		RetentionValue:    7,
+	}}
+	prior := pipelineModel(ctx, nil)
+
+	pipeline, diags := datalakepipeline.NewTFDataLakePipeline(ctx, projectID, prior, apiPipeline, snapshots, schedules)
+	require.False(t, diags.HasError())
+	expected := pipelineModel(ctx, func(m *datalakepipeline.TFDataLakePipelineModel) {
+		m.ID = types.StringValue(stateID)
+		m.CreatedDate = types.StringValue(conversion.TimeToString(createdDate))
+		m.LastUpdatedDate = types.StringNull()
+		m.State = types.StringValue(datalakepipeline.StatePaused)
+		m.Paused = types.BoolValue(true)
+		m.Sink = sinkList(types.StringValue("AWS"), types.StringValue("US_EAST_1"))
+		m.Source = sourceList(types.StringValue(projectID))
+		m.Snapshots = types.SetValueMust(datalakepipeline.SnapshotObjectType, []attr.Value{
+			snapshotObject("frequency_type"),
+		})
+		m.IngestionSchedules = types.SetValueMust(datalakepipeline.IngestionScheduleObjectType, []attr.Value{
+			types.ObjectValueMust(datalakepipeline.IngestionScheduleObjectType.AttrTypes, map[string]attr.Value{
+				"id":                 types.StringValue(policyItemID),
+				"frequency_type":     types.StringValue("daily"),
+				"retention_unit":     types.StringValue("days"),
+				"retention_value":    types.Int64Value(7),
+				"frequency_interval": types.Int64Value(1),
+			}),
+		})
+	})
+	assert.Equal(t, expected, pipeline)
+}
+
+func TestNewTFDataLakePipelineFromV0(t *testing.T) {
+	ctx := context.Background()
+	snapshotV0Type := types.ObjectType{AttrTypes: map[string]attr.Type{}}
+	for name, attrType := range datalakepipeline.SnapshotObjectType.AttrTypes {
+		snapshotV0Type.AttrTypes[name] = attrType
+	}
+	delete(snapshotV0Type.AttrTypes, "frequency_type")
+	snapshotV0Type.AttrTypes["frequency_yype"] = types.StringType
+	stateV0 := &datalakepipeline.TFDataLakePipelineModelV0{
+		ID:                 types.StringValue(stateID),
+		ProjectID:          types.StringValue(projectID),
+		Name:               types.StringValue(pipelineName),
+		CreatedDate:        types.StringValue(conversion.TimeToString(createdDate)),
+		LastUpdatedDate:    types.StringValue(conversion.TimeToString(createdDate)),
+		State:              types.StringValue("ACTIVE"),
+		Snapshots:          types.SetValueMust(snapshotV0Type, []attr.Value{snapshotObjectWithType(snapshotV0Type, "frequency_yype")}),
+		IngestionSchedules: types.SetNull(datalakepipeline.IngestionScheduleObjectType),
+		Sink:               sinkList(types.StringValue("AWS"), types.StringValue("US_EAST_1")),
+		Source:             sourceList(types.StringValue(projectID)),
+		Transformations:    transformationsList(),
+	}
+
+	pipeline, diags := datalakepipeline.NewTFDataLakePipelineFromV0(ctx, stateV0)
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.BoolValue(false), pipeline.Paused)
+	assert.Equal(t, types.SetValueMust(datalakepipeline.SnapshotObjectType, []attr.Value{snapshotObject("frequency_type")}), pipeline.Snapshots)
+	assert.Equal(t, types.SetValueMust(datalakepipeline.IngestionScheduleObjectType, []attr.Value{}), pipeline.IngestionSchedules)
+	assert.Equal(t, stateV0.Sink, pipeline.Sink)
+	assert.Equal(t, stateV0.Source, pipeline.Source)
+	assert.Equal(t, stateV0.ID, pipeline.ID)
+}
+
+func TestNewTFPipelineRunTrigger(t *testing.T) {
+	ctx := context.Background()
+	prior := &datalakepipeline.TFPipelineRunTriggerModel{
+		ProjectID:    types.StringValue(projectID),
+		PipelineName: types.StringValue(pipelineName),
+		SnapshotID:   types.StringValue(snapshotID),
+	}
+	run := &admin.IngestionPipelineRun{
+		Id:                  admin.PtrString(runID),
+		CreatedDate:         admin.PtrTime(createdDate),
+		LastUpdatedDate:     admin.PtrTime(createdDate),
+		State:               admin.PtrString(datalakepipeline.RunStateDone),
+		Phase:               admin.PtrString("DONE"),
+		DatasetName:         admin.PtrString("v1$atlas$snapshot$test-cluster$sample_airbnb$listingsAndReviews$20240516T140000Z"),
+		PipelineId:          admin.PtrString("6646255cd2d6fd9c0e3a1b2f"),
+		SnapshotId:          admin.PtrString(snapshotID),
+		BackupFrequencyType: admin.PtrString("DAILY"),
+		Stats: &admin.PipelineRunStats{
+			BytesExported: admin.PtrInt64(1024),
+			NumDocs:       admin.PtrInt64(10),
+		},
+	}
+
+	model, diags := datalakepipeline.NewTFPipelineRunTrigger(ctx, prior, run)
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue(conversion.EncodeStateID(map[string]string{
+		"project_id":      projectID,
+		"pipeline_name":   pipelineName,
+		"pipeline_run_id": runID,
+	})), model.ID)
+	assert.Equal(t, types.StringValue(runID), model.PipelineRunID)
+	assert.Equal(t, types.StringValue(snapshotID), model.SnapshotID)
+	assert.Equal(t, types.StringValue(datalakepipeline.RunStateDone), model.State)
+	assert.Equal(t, types.StringValue(conversion.TimeToString(createdDate)), model.CreatedDate)
+	assert.Equal(t, types.ListValueMust(datalakepipeline.RunStatsObjectType, []attr.Value{
+		types.ObjectValueMust(datalakepipeline.RunStatsObjectType.AttrTypes, map[string]attr.Value{
+			"bytes_exported": types.Int64Value(1024),
+			"num_docs":       types.Int64Value(10),
+		}),
+	}), model.Stats)
+}
+
+func pipelineModel(ctx context.Context, modify func(*datalakepipeline.TFDataLakePipelineModel)) *datalakepipeline.TFDataLakePipelineModel {
+	m := &datalakepipeline.TFDataLakePipelineModel{
+		ID:                 types.StringUnknown(),
+		ProjectID:          types.StringValue(projectID),
+		Name:               types.StringValue(pipelineName),
+		CreatedDate:        types.StringUnknown(),
+		LastUpdatedDate:    types.StringUnknown(),
+		State:              types.StringUnknown(),
+		Paused:             types.BoolUnknown(),
+		Snapshots:          types.SetUnknown(datalakepipeline.SnapshotObjectType),
+		IngestionSchedules: types.SetUnknown(datalakepipeline.IngestionScheduleObjectType),
+		Sink:               sinkList(types.StringUnknown(), types.StringUnknown()),
+		Source:             sourceList(types.StringUnknown()),
+		Transformations:    transformationsList(),
+	}
+	if modify != nil {
+		modify(m)
+	}
+	return m
+}
+
+func sinkList(provider, region types.String) types.List {
+	return types.ListValueMust(datalakepipeline.SinkObjectType, []attr.Value{
+		types.ObjectValueMust(datalakepipeline.SinkObjectType.AttrTypes, map[string]attr.Value{
+			"type":     types.StringValue("DLS"),
+			"provider": provider,
+			"region":   region,
+			"partition_fields": types.ListValueMust(datalakepipeline.PartitionFieldObjectType, []attr.Value{
+				types.ObjectValueMust(datalakepipeline.PartitionFieldObjectType.AttrTypes, map[string]attr.Value{
+					"field_name": types.StringValue("access"),
+					"order":      types.Int64Value(0),
+				}),
+			}),
+		}),
+	})
+}
+
+func sourceList(sourceProjectID types.String) types.List {
+	return types.ListValueMust(datalakepipeline.SourceObjectType, []attr.Value{
+		types.ObjectValueMust(datalakepipeline.SourceObjectType.AttrTypes, map[string]attr.Value{
+			"type":            types.StringValue("ON_DEMAND_CPS"),
+			"cluster_name":    types.StringValue(clusterName),
+			"collection_name": types.StringValue("listingsAndReviews"),
+			"database_name":   types.StringValue("sample_airbnb"),
+			"policy_item_id":  types.StringValue(policyItemID),
+			"project_id":      sourceProjectID,
+		}),
+	})
+}
+
+func transformationsList() types.List {
+	return types.ListValueMust(datalakepipeline.TransformationObjectType, []attr.Value{
+		types.ObjectValueMust(datalakepipeline.TransformationObjectType.AttrTypes, map[string]attr.Value{
+			"field": types.StringValue("test"),
+			"type":  types.StringValue("EXCLUDE"),
+		}),
+	})
+}
+
+func snapshotObject(frequencyAttr string) attr.Value {
+	return snapshotObjectWithType(datalakepipeline.SnapshotObjectType, frequencyAttr)
+}
+
+func snapshotObjectWithType(objectType types.ObjectType, frequencyAttr string) attr.Value {
+	return types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+		"id":               types.StringValue(snapshotID),
+		"provider":         types.StringValue("AWS"),
+		"created_at":       types.StringNull(),
+		"expires_at":       types.StringNull(),
+		frequencyAttr:      types.StringValue("daily"),
+		"master_key":       types.StringValue(""),
+		"mongod_version":   types.StringValue(""),
+		"replica_set_name": types.StringValue(""),
+		"snapshot_type":    types.StringValue(""),
+		"type":             types.StringValue(""),
+		"status":           types.StringValue("completed"),
+		"size":             types.Int64Value(0),
+		"copy_region":      types.StringNull(),
+		"policies":         types.ListValueMust(types.StringType, []attr.Value{types.StringValue(policyItemID)}),
+	})
+}
@@ -0,0 +1,121 @@
+package datalakepipeline
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+const (
+	runTriggerName                  = "data_lake_pipeline_run_trigger"
+	defaultRunTimeout               = 3 * time.Hour
+	minTimeoutRun     time.Duration = 1 * time.Minute
+	retryTimeDelay    time.Duration = 10 * time.Second
+)
+
+var _ resource.ResourceWithConfigure = &runTriggerRS{}
+
+func RunTriggerResource() resource.Resource {
+	return &runTriggerRS{
+		RSCommon: config.RSCommon{
+			ResourceName: runTriggerName,
+		},
+	}
+}
+
+type runTriggerRS struct {
+	config.RSCommon
+}
+
+func (r *runTriggerRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = RunTriggerResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *runTriggerRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFPipelineRunTriggerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	pipelineName := plan.PipelineName.ValueString()
+	triggerReq := &admin.TriggerIngestionPipelineRequest{SnapshotId: plan.SnapshotID.ValueString()}
+	run, _, err := connV2.DataLakePipelinesApi.TriggerSnapshotIngestion(ctx, projectID, pipelineName, triggerReq).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error creating resource", err.Error())
+		return
+	}
+
+	// the run is saved in the state before waiting so it's tracked even if the wait fails or times out
+	runID := run.GetId()
+	newRunModel, diags := NewTFPipelineRunTrigger(ctx, &plan, run)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newRunModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultRunTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeConfig := retrystrategy.TimeConfig{Timeout: timeout, MinTimeout: minTimeoutRun, Delay: retryTimeDelay}
+	run, err = WaitRunStateTransition(ctx, projectID, pipelineName, runID, connV2.DataLakePipelinesApi, timeConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("error waiting for the pipeline run to finish", fmt.Sprintf("pipeline_run_id %s: %s", runID, err))
+		return
+	}
+
+	newRunModel, diags = NewTFPipelineRunTrigger(ctx, &plan, run)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newRunModel)...)
+}
+
+func (r *runTriggerRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFPipelineRunTriggerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	run, getResp, err := connV2.DataLakePipelinesApi.GetPipelineRun(ctx, state.ProjectID.ValueString(), state.PipelineName.ValueString(), state.PipelineRunID.ValueString()).Execute()
+	if err != nil {
+		if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+	newRunModel, diags := NewTFPipelineRunTrigger(ctx, &state, run)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newRunModel)...)
+}
+
+// Update is never called as all the configurable attributes require replacement.
+func (r *runTriggerRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("error updating resource", "a data lake pipeline run can't be updated, change snapshot_id to trigger a new run")
+}
+
+// Delete only removes the run from the state, the dataset it generated is kept until its retention policy expires.
+func (r *runTriggerRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,132 @@
+package datalakepipeline
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func RunTriggerResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		DeprecationMessage: deprecationMessage,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Terraform's unique identifier used internally for state management.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pipeline_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the Data Lake Pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal character string that identifies the snapshot to ingest. Changing it triggers a new run.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pipeline_run_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique 24-hexadecimal character string that identifies the Data Lake Pipeline run triggered by this resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp that indicates when the pipeline run was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp that indicates the last time that the pipeline run was updated.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "State of the pipeline run.",
+			},
+			"dataset_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Human-readable label that identifies the dataset that Atlas generates during this pipeline run.",
+			},
+			"phase": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Processing phase of the Data Lake Pipeline.",
+			},
+			"pipeline_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique 24-hexadecimal character string that identifies a Data Lake Pipeline.",
+			},
+			"backup_frequency_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Backup schedule interval of the Data Lake Pipeline.",
+			},
+			"stats": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Runtime statistics for this Data Lake Pipeline run.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"bytes_exported": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Total data size in bytes exported for this pipeline run.",
+						},
+						"num_docs": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of docs ingested for a this pipeline run.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+type TFPipelineRunTriggerModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	ProjectID           types.String   `tfsdk:"project_id"`
+	PipelineName        types.String   `tfsdk:"pipeline_name"`
+	SnapshotID          types.String   `tfsdk:"snapshot_id"`
+	PipelineRunID       types.String   `tfsdk:"pipeline_run_id"`
+	CreatedDate         types.String   `tfsdk:"created_date"`
+	LastUpdatedDate     types.String   `tfsdk:"last_updated_date"`
+	State               types.String   `tfsdk:"state"`
+	DatasetName         types.String   `tfsdk:"dataset_name"`
+	Phase               types.String   `tfsdk:"phase"`
+	PipelineID          types.String   `tfsdk:"pipeline_id"`
+	BackupFrequencyType types.String   `tfsdk:"backup_frequency_type"`
+	Stats               types.List     `tfsdk:"stats"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TFRunStatsModel struct {
+	BytesExported types.Int64 `tfsdk:"bytes_exported"`
+	NumDocs       types.Int64 `tfsdk:"num_docs"`
+}
+
+var RunStatsObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"bytes_exported": types.Int64Type,
+	"num_docs":       types.Int64Type,
+}}
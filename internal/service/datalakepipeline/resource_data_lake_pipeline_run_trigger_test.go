@@ -0,0 +1,60 @@
+package datalakepipeline_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+func TestAccDataLakePipelineRunTrigger_basic(t *testing.T) {
+	acc.SkipTestForCI(t) // needs a data lake pipeline with an ON_DEMAND_CPS source and a snapshot of its cluster
+
+	var (
+		resourceName   = "mongodbatlas_data_lake_pipeline_run_trigger.test"
+		dataSourceName = "data.mongodbatlas_data_lake_pipeline_run.test"
+		projectID      = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		pipelineName   = os.Getenv("MONGODB_ATLAS_DATA_LAKE_PIPELINE_NAME")
+		snapshotID     = os.Getenv("MONGODB_ATLAS_DATA_LAKE_PIPELINE_SNAPSHOT_ID")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckDataLakePipelineRunTrigger(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configRunTrigger(projectID, pipelineName, snapshotID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_name", pipelineName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_id", snapshotID),
+					resource.TestCheckResourceAttr(resourceName, "state", "DONE"),
+					resource.TestCheckResourceAttrSet(resourceName, "pipeline_run_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "dataset_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "stats.0.num_docs"),
+					resource.TestCheckResourceAttrPair(resourceName, "pipeline_run_id", dataSourceName, "pipeline_run_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_name", dataSourceName, "dataset_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "DONE"),
+				),
+			},
+		},
+	})
+}
+
+func configRunTrigger(projectID, pipelineName, snapshotID string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_data_lake_pipeline_run_trigger" "test" {
+			project_id    = %[1]q
+			pipeline_name = %[2]q
+			snapshot_id   = %[3]q
+		}
+
+		data "mongodbatlas_data_lake_pipeline_run" "test" {
+			project_id      = mongodbatlas_data_lake_pipeline_run_trigger.test.project_id
+			pipeline_name   = mongodbatlas_data_lake_pipeline_run_trigger.test.pipeline_name
+			pipeline_run_id = mongodbatlas_data_lake_pipeline_run_trigger.test.pipeline_run_id
+		}
+	`, projectID, pipelineName, snapshotID)
+}
@@ -36,6 +36,7 @@ func TestAccDataLakePipeline_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
 					resource.TestCheckResourceAttr(resourceName, "name", firstPipelineName),
 					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "paused", "false"),
 
 					resource.TestCheckResourceAttrSet(dataSourceName, "project_id"),
 					resource.TestCheckResourceAttr(dataSourceName, "name", firstPipelineName),
@@ -43,6 +44,22 @@ func TestAccDataLakePipeline_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(pluralDataSourceName, "results.#"),
 				),
 			},
+			{
+				Config: configPaused(orgID, projectName, firstClusterName, firstPipelineName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "paused", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "PAUSED"),
+				),
+			},
+			{
+				Config: configPaused(orgID, projectName, firstClusterName, firstPipelineName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "paused", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+				),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportStateIdFunc: importStateIDFunc(resourceName),
@@ -100,6 +117,14 @@ func checkExists(resourceName string) resource.TestCheckFunc {
 }
 
 func configBasic(orgID, projectName, clusterName, pipelineName string) string {
+	return configPipeline(orgID, projectName, clusterName, pipelineName, "")
+}
+
+func configPaused(orgID, projectName, clusterName, pipelineName string, paused bool) string {
+	return configPipeline(orgID, projectName, clusterName, pipelineName, fmt.Sprintf("paused = %t", paused))
+}
+
+func configPipeline(orgID, projectName, clusterName, pipelineName, extraAttrs string) string {
 	return fmt.Sprintf(`
 
 		resource "mongodbatlas_project" "project" {
@@ -129,6 +154,7 @@ func configBasic(orgID, projectName, clusterName, pipelineName string) string {
 		resource "mongodbatlas_data_lake_pipeline" "test" {
 			project_id       = mongodbatlas_project.project.id
 			name			 = %[4]q
+			%[5]s
 			sink {
 				type = "DLS"
 				partition_fields {
@@ -149,7 +175,7 @@ func configBasic(orgID, projectName, clusterName, pipelineName string) string {
 				type =  "EXCLUDE"
 			}
 		}
-	`, orgID, projectName, clusterName, pipelineName)
+	`, orgID, projectName, clusterName, pipelineName, extraAttrs)
 }
 
 func configBasicWithPluralDS(orgID, projectName, firstClusterName, secondClusterName, firstPipelineName, secondPipelineName string) string {
@@ -0,0 +1,380 @@
+package datalakepipeline
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		// Version 0 is the SDKv2 schema with the snapshots.frequency_yype typo.
+		Version:            1,
+		DeprecationMessage: deprecationMessage,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Terraform's unique identifier used internally for state management.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique ID for the project to create a data lake pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the Atlas Data Lake Pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp that indicates when the Data Lake Pipeline was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp that indicates the last time that the Data Lake Pipeline was updated.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "State of this Data Lake Pipeline.",
+			},
+			"paused": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Flag that indicates whether the Data Lake Pipeline is paused. Scheduled runs don't start while the pipeline is paused.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshots": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of backup snapshots that you can use to trigger an on demand pipeline run.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the snapshot.",
+						},
+						"provider": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that identifies the cloud provider that stores this snapshot.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time when MongoDB Atlas took the snapshot.",
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time when MongoDB Atlas deletes the snapshot.",
+						},
+						"frequency_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that identifies how often this snapshot triggers.",
+						},
+						"master_key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique string that identifies the Amazon Web Services (AWS) Key Management Service (KMS) Customer Master Key (CMK) used to encrypt the snapshot.",
+						},
+						"mongod_version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Version of the MongoDB host that this snapshot backs up.",
+						},
+						"replica_set_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that identifies the replica set from which MongoDB Atlas took this snapshot.",
+						},
+						"snapshot_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that identifies when this snapshot triggers.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that categorizes the cluster as a replica set or sharded cluster.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that indicates the stage of the backup process for this snapshot.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "List of backup snapshots that you can use to trigger an on demand pipeline run.",
+						},
+						"copy_region": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "List that identifies the regions to which MongoDB Atlas copies the snapshot.",
+						},
+						"policies": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "List that contains unique identifiers for the policy items.",
+						},
+					},
+				},
+			},
+			"ingestion_schedules": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of backup schedule policy items that you can use as a Data Lake Pipeline source.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies this backup policy item.",
+						},
+						"frequency_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that identifies the frequency type associated with the backup policy.",
+						},
+						"retention_unit": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unit of time in which MongoDB Atlas measures snapshot retention.",
+						},
+						"retention_value": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Duration in days, weeks, or months that MongoDB Atlas retains the snapshot.",
+						},
+						"frequency_interval": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number that indicates the frequency interval for a set of snapshots.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"sink": schema.ListNestedBlock{
+				MarkdownDescription: "Ingestion destination of a Data Lake Pipeline.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Type of ingestion destination of this Data Lake Pipeline.",
+						},
+						"provider": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Target cloud provider for this Data Lake Pipeline.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"region": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Target cloud provider region for this Data Lake Pipeline.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"partition_fields": schema.ListNestedBlock{
+							MarkdownDescription: "Ordered fields used to physically organize data in the destination.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"field_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Human-readable label that identifies the field name used to partition data.",
+									},
+									"order": schema.Int64Attribute{
+										Required:            true,
+										MarkdownDescription: "Sequence in which MongoDB Atlas slices the collection data to create partitions.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source": schema.ListNestedBlock{
+				MarkdownDescription: "Ingestion Source of a Data Lake Pipeline.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Type of ingestion source of this Data Lake Pipeline.",
+						},
+						"cluster_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Human-readable name that identifies the cluster.",
+						},
+						"collection_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Human-readable name that identifies the collection.",
+						},
+						"database_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Human-readable name that identifies the database.",
+						},
+						"policy_item_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Unique 24-hexadecimal character string that identifies a policy item.",
+						},
+						"project_id": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "The unique ID for the project that contains the source cluster.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"transformations": schema.ListNestedBlock{
+				MarkdownDescription: "Fields to be excluded for this Data Lake Pipeline.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Key in the document.",
+						},
+						"type": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Type of transformation applied during the export of the namespace in a Data Lake Pipeline.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TFDataLakePipelineModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Name               types.String `tfsdk:"name"`
+	CreatedDate        types.String `tfsdk:"created_date"`
+	LastUpdatedDate    types.String `tfsdk:"last_updated_date"`
+	State              types.String `tfsdk:"state"`
+	Paused             types.Bool   `tfsdk:"paused"`
+	Snapshots          types.Set    `tfsdk:"snapshots"`
+	IngestionSchedules types.Set    `tfsdk:"ingestion_schedules"`
+	Sink               types.List   `tfsdk:"sink"`
+	Source             types.List   `tfsdk:"source"`
+	Transformations    types.List   `tfsdk:"transformations"`
+}
+
+type TFSinkModel struct {
+	Type            types.String `tfsdk:"type"`
+	Provider        types.String `tfsdk:"provider"`
+	Region          types.String `tfsdk:"region"`
+	PartitionFields types.List   `tfsdk:"partition_fields"`
+}
+
+type TFPartitionFieldModel struct {
+	FieldName types.String `tfsdk:"field_name"`
+	Order     types.Int64  `tfsdk:"order"`
+}
+
+type TFSourceModel struct {
+	Type           types.String `tfsdk:"type"`
+	ClusterName    types.String `tfsdk:"cluster_name"`
+	CollectionName types.String `tfsdk:"collection_name"`
+	DatabaseName   types.String `tfsdk:"database_name"`
+	PolicyItemID   types.String `tfsdk:"policy_item_id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+}
+
+type TFTransformationModel struct {
+	Field types.String `tfsdk:"field"`
+	Type  types.String `tfsdk:"type"`
+}
+
+type TFSnapshotModel struct {
+	ID             types.String `tfsdk:"id"`
+	Provider       types.String `tfsdk:"provider"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	FrequencyType  types.String `tfsdk:"frequency_type"`
+	MasterKey      types.String `tfsdk:"master_key"`
+	MongodVersion  types.String `tfsdk:"mongod_version"`
+	ReplicaSetName types.String `tfsdk:"replica_set_name"`
+	SnapshotType   types.String `tfsdk:"snapshot_type"`
+	Type           types.String `tfsdk:"type"`
+	Status         types.String `tfsdk:"status"`
+	Size           types.Int64  `tfsdk:"size"`
+	CopyRegion     types.String `tfsdk:"copy_region"`
+	Policies       types.List   `tfsdk:"policies"`
+}
+
+type TFIngestionScheduleModel struct {
+	ID                types.String `tfsdk:"id"`
+	FrequencyType     types.String `tfsdk:"frequency_type"`
+	RetentionUnit     types.String `tfsdk:"retention_unit"`
+	RetentionValue    types.Int64  `tfsdk:"retention_value"`
+	FrequencyInterval types.Int64  `tfsdk:"frequency_interval"`
+}
+
+var PartitionFieldObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"field_name": types.StringType,
+	"order":      types.Int64Type,
+}}
+
+var SinkObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":             types.StringType,
+	"provider":         types.StringType,
+	"region":           types.StringType,
+	"partition_fields": types.ListType{ElemType: PartitionFieldObjectType},
+}}
+
+var SourceObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":            types.StringType,
+	"cluster_name":    types.StringType,
+	"collection_name": types.StringType,
+	"database_name":   types.StringType,
+	"policy_item_id":  types.StringType,
+	"project_id":      types.StringType,
+}}
+
+var TransformationObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"field": types.StringType,
+	"type":  types.StringType,
+}}
+
+var SnapshotObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":               types.StringType,
+	"provider":         types.StringType,
+	"created_at":       types.StringType,
+	"expires_at":       types.StringType,
+	"frequency_type":   types.StringType,
+	"master_key":       types.StringType,
+	"mongod_version":   types.StringType,
+	"replica_set_name": types.StringType,
+	"snapshot_type":    types.StringType,
+	"type":             types.StringType,
+	"status":           types.StringType,
+	"size":             types.Int64Type,
+	"copy_region":      types.StringType,
+	"policies":         types.ListType{ElemType: types.StringType},
+}}
+
+var IngestionScheduleObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":                 types.StringType,
+	"frequency_type":     types.StringType,
+	"retention_unit":     types.StringType,
+	"retention_value":    types.Int64Type,
+	"frequency_interval": types.Int64Type,
+}}
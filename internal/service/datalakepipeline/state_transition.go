@@ -0,0 +1,60 @@
+package datalakepipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
+)
+
+const (
+	StatePaused = "PAUSED"
+
+	RunStatePending        = "PENDING"
+	RunStateInProgress     = "IN_PROGRESS"
+	RunStateDone           = "DONE"
+	RunStateFailed         = "FAILED"
+	RunStateDatasetDeleted = "DATASET_DELETED"
+)
+
+// WaitRunStateTransition waits until the pipeline run is DONE, a FAILED run is returned as an error.
+func WaitRunStateTransition(ctx context.Context, projectID, pipelineName, pipelineRunID string, client admin.DataLakePipelinesApi,
+	timeConfig retrystrategy.TimeConfig) (*admin.IngestionPipelineRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{RunStatePending, RunStateInProgress},
+		Target:     []string{RunStateDone},
+		Refresh:    runRefreshFunc(ctx, projectID, pipelineName, pipelineRunID, client),
+		Timeout:    timeConfig.Timeout,
+		MinTimeout: timeConfig.MinTimeout,
+		Delay:      timeConfig.Delay,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if run, ok := result.(*admin.IngestionPipelineRun); ok && run != nil {
+		return run, nil
+	}
+	return nil, errors.New("did not obtain valid result when waiting for data lake pipeline run state transition")
+}
+
+func runRefreshFunc(ctx context.Context, projectID, pipelineName, pipelineRunID string, client admin.DataLakePipelinesApi) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		run, _, err := client.GetPipelineRun(ctx, projectID, pipelineName, pipelineRunID).Execute()
+		if err != nil {
+			return nil, "", err
+		}
+		state := run.GetState()
+		tflog.Debug(ctx, fmt.Sprintf("data lake pipeline run status: %s", state))
+		if state == RunStateFailed {
+			return nil, state, fmt.Errorf("data lake pipeline run %s failed in phase %s", pipelineRunID, run.GetPhase())
+		}
+		return run, state, nil
+	}
+}
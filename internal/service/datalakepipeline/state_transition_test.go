@@ -0,0 +1,92 @@
+package datalakepipeline_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+	"go.mongodb.org/atlas-sdk/v20241113004/mockadmin"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/datalakepipeline"
+)
+
+var testTimeoutConfig = retrystrategy.TimeConfig{
+	Timeout:    30 * time.Second,
+	MinTimeout: 100 * time.Millisecond,
+	Delay:      0,
+}
+
+func TestWaitRunStateTransition(t *testing.T) {
+	testCases := map[string]struct {
+		expectedState *string
+		mockResponses []runResponse
+		expectedError bool
+	}{
+		"doneAfterPendingAndInProgress": {
+			mockResponses: []runResponse{
+				{state: datalakepipeline.RunStatePending},
+				{state: datalakepipeline.RunStateInProgress},
+				{state: datalakepipeline.RunStateDone},
+			},
+			expectedState: admin.PtrString(datalakepipeline.RunStateDone),
+		},
+		"failedRun": {
+			mockResponses: []runResponse{
+				{state: datalakepipeline.RunStateInProgress},
+				{state: datalakepipeline.RunStateFailed},
+			},
+			expectedError: true,
+		},
+		"unexpectedState": {
+			mockResponses: []runResponse{
+				{state: datalakepipeline.RunStateDatasetDeleted},
+			},
+			expectedError: true,
+		},
+		"apiError": {
+			mockResponses: []runResponse{
+				{err: errors.New("Internal server error")},
+			},
+			expectedError: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewDataLakePipelinesApi(t)
+			m.EXPECT().GetPipelineRun(mock.Anything, projectID, pipelineName, runID).Return(admin.GetPipelineRunApiRequest{ApiService: m})
+			for _, resp := range tc.mockResponses {
+				m.EXPECT().GetPipelineRunExecute(mock.Anything).Return(resp.get(), nil, resp.err).Once()
+			}
+			run, err := datalakepipeline.WaitRunStateTransition(context.Background(), projectID, pipelineName, runID, m, testTimeoutConfig)
+			assert.Equal(t, tc.expectedError, err != nil)
+			if tc.expectedState != nil {
+				assert.Equal(t, *tc.expectedState, run.GetState())
+			} else {
+				assert.Nil(t, run)
+			}
+		})
+	}
+}
+
+type runResponse struct {
+	err   error
+	state string
+}
+
+func (r *runResponse) get() *admin.IngestionPipelineRun {
+	if r.err != nil {
+		return nil
+	}
+	return &admin.IngestionPipelineRun{
+		Id:         admin.PtrString(runID),
+		SnapshotId: admin.PtrString(snapshotID),
+		Phase:      admin.PtrString("INGESTION"),
+		State:      admin.PtrString(r.state),
+	}
+}
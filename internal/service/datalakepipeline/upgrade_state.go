@@ -0,0 +1,205 @@
+package datalakepipeline
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState is used to upgrade from schema v0 (SDKv2), where snapshots had the frequency_yype attribute, to v1 (TPF)
+func (r *dataLakePipelineRS) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := resourceSchemaV0()
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &priorSchema,
+			StateUpgrader: stateUpgraderFromV0,
+		},
+	}
+}
+
+func stateUpgraderFromV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var stateV0 TFDataLakePipelineModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	pipeline, diags := NewTFDataLakePipelineFromV0(ctx, &stateV0)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, pipeline)...)
+}
+
+// NewTFDataLakePipelineFromV0 renames snapshots.frequency_yype to frequency_type and derives paused from the pipeline state.
+func NewTFDataLakePipelineFromV0(ctx context.Context, stateV0 *TFDataLakePipelineModelV0) (*TFDataLakePipelineModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var snapshotsV0 []TFSnapshotModelV0
+	if diags.Append(stateV0.Snapshots.ElementsAs(ctx, &snapshotsV0, false)...); diags.HasError() {
+		return nil, diags
+	}
+	snapshots := make([]TFSnapshotModel, len(snapshotsV0))
+	for i, snapshot := range snapshotsV0 {
+		snapshots[i] = TFSnapshotModel{
+			ID:             snapshot.ID,
+			Provider:       snapshot.Provider,
+			CreatedAt:      snapshot.CreatedAt,
+			ExpiresAt:      snapshot.ExpiresAt,
+			FrequencyType:  snapshot.FrequencyYype,
+			MasterKey:      snapshot.MasterKey,
+			MongodVersion:  snapshot.MongodVersion,
+			ReplicaSetName: snapshot.ReplicaSetName,
+			SnapshotType:   snapshot.SnapshotType,
+			Type:           snapshot.Type,
+			Status:         snapshot.Status,
+			Size:           snapshot.Size,
+			CopyRegion:     snapshot.CopyRegion,
+			Policies:       snapshot.Policies,
+		}
+	}
+	snapshotSet, localDiags := types.SetValueFrom(ctx, SnapshotObjectType, snapshots)
+	if diags.Append(localDiags...); diags.HasError() {
+		return nil, diags
+	}
+	ingestionSchedules := stateV0.IngestionSchedules
+	if ingestionSchedules.IsNull() {
+		ingestionSchedules = types.SetValueMust(IngestionScheduleObjectType, []attr.Value{})
+	}
+	return &TFDataLakePipelineModel{
+		ID:                 stateV0.ID,
+		ProjectID:          stateV0.ProjectID,
+		Name:               stateV0.Name,
+		CreatedDate:        stateV0.CreatedDate,
+		LastUpdatedDate:    stateV0.LastUpdatedDate,
+		State:              stateV0.State,
+		Paused:             types.BoolValue(stateV0.State.ValueString() == StatePaused),
+		Snapshots:          snapshotSet,
+		IngestionSchedules: ingestionSchedules,
+		Sink:               stateV0.Sink,
+		Source:             stateV0.Source,
+		Transformations:    stateV0.Transformations,
+	}, diags
+}
+
+type TFDataLakePipelineModelV0 struct {
+	ID                 types.String `tfsdk:"id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Name               types.String `tfsdk:"name"`
+	CreatedDate        types.String `tfsdk:"created_date"`
+	LastUpdatedDate    types.String `tfsdk:"last_updated_date"`
+	State              types.String `tfsdk:"state"`
+	Snapshots          types.Set    `tfsdk:"snapshots"`
+	IngestionSchedules types.Set    `tfsdk:"ingestion_schedules"`
+	Sink               types.List   `tfsdk:"sink"`
+	Source             types.List   `tfsdk:"source"`
+	Transformations    types.List   `tfsdk:"transformations"`
+}
+
+type TFSnapshotModelV0 struct {
+	ID             types.String `tfsdk:"id"`
+	Provider       types.String `tfsdk:"provider"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	FrequencyYype  types.String `tfsdk:"frequency_yype"`
+	MasterKey      types.String `tfsdk:"master_key"`
+	MongodVersion  types.String `tfsdk:"mongod_version"`
+	ReplicaSetName types.String `tfsdk:"replica_set_name"`
+	SnapshotType   types.String `tfsdk:"snapshot_type"`
+	Type           types.String `tfsdk:"type"`
+	Status         types.String `tfsdk:"status"`
+	Size           types.Int64  `tfsdk:"size"`
+	CopyRegion     types.String `tfsdk:"copy_region"`
+	Policies       types.List   `tfsdk:"policies"`
+}
+
+// resourceSchemaV0 is the SDKv2 schema, it's only used to read the state to upgrade. Computed-only sets are attributes in SDKv2.
+func resourceSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                schema.StringAttribute{Computed: true},
+			"project_id":        schema.StringAttribute{Required: true},
+			"name":              schema.StringAttribute{Required: true},
+			"created_date":      schema.StringAttribute{Computed: true},
+			"last_updated_date": schema.StringAttribute{Computed: true},
+			"state":             schema.StringAttribute{Computed: true},
+			"snapshots": schema.SetNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":               schema.StringAttribute{Computed: true},
+						"provider":         schema.StringAttribute{Optional: true},
+						"created_at":       schema.StringAttribute{Computed: true},
+						"expires_at":       schema.StringAttribute{Computed: true},
+						"frequency_yype":   schema.StringAttribute{Computed: true},
+						"master_key":       schema.StringAttribute{Computed: true},
+						"mongod_version":   schema.StringAttribute{Computed: true},
+						"replica_set_name": schema.StringAttribute{Computed: true},
+						"snapshot_type":    schema.StringAttribute{Computed: true},
+						"type":             schema.StringAttribute{Computed: true},
+						"status":           schema.StringAttribute{Computed: true},
+						"size":             schema.Int64Attribute{Computed: true},
+						"copy_region":      schema.StringAttribute{Computed: true},
+						"policies":         schema.ListAttribute{ElementType: types.StringType, Optional: true},
+					},
+				},
+			},
+			"ingestion_schedules": schema.SetNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                 schema.StringAttribute{Computed: true},
+						"frequency_type":     schema.StringAttribute{Computed: true},
+						"retention_unit":     schema.StringAttribute{Computed: true},
+						"retention_value":    schema.Int64Attribute{Computed: true},
+						"frequency_interval": schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"sink": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type":     schema.StringAttribute{Optional: true},
+						"provider": schema.StringAttribute{Optional: true, Computed: true},
+						"region":   schema.StringAttribute{Optional: true, Computed: true},
+					},
+					Blocks: map[string]schema.Block{
+						"partition_fields": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"field_name": schema.StringAttribute{Required: true},
+									"order":      schema.Int64Attribute{Required: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type":            schema.StringAttribute{Optional: true},
+						"cluster_name":    schema.StringAttribute{Optional: true},
+						"collection_name": schema.StringAttribute{Optional: true},
+						"database_name":   schema.StringAttribute{Optional: true},
+						"policy_item_id":  schema.StringAttribute{Optional: true},
+						"project_id":      schema.StringAttribute{Optional: true, Computed: true},
+					},
+				},
+			},
+			"transformations": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{Optional: true},
+						"type":  schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,7 +2,9 @@ package eventtrigger
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,6 +12,11 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
 
+const (
+	errorEventTriggersRead    = "error reading MongoDB EventTriggers (%s)%s: %s"
+	errorEventTriggersSetting = "error setting `%s` for EventTriggers(%s)%s: %s"
+)
+
 func DataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceMongoDBAtlasEventTriggerRead,
@@ -212,3 +219,30 @@ func dataSourceMongoDBAtlasEventTriggerRead(ctx context.Context, d *schema.Resou
 
 	return nil
 }
+
+func flattenTriggerEventProcessorAWSEventBridge(eventProcessor map[string]any) []map[string]any {
+	results := make([]map[string]any, 0)
+	if eventProcessor != nil && eventProcessor["AWS_EVENTBRIDGE"] != nil {
+		event := eventProcessor["AWS_EVENTBRIDGE"].(map[string]any)
+		cfg := event["config"].(map[string]any)
+		mapEvent := map[string]any{
+			"aws_eventbridge": []map[string]any{
+				{
+					"config_account_id": cfg["account_id"].(string),
+					"config_region":     cfg["region"].(string),
+				},
+			},
+		}
+		results = append(results, mapEvent)
+	}
+
+	return results
+}
+
+func matchToString(value any) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		log.Printf("[ERROR] %v ", err)
+	}
+	return string(b)
+}
@@ -60,12 +60,14 @@ func testAccMongoDBAtlasEventTriggersDataSourceConfig(projectID, appID, operatio
 			type = %[4]q
 			function_id = %[5]q
 			disabled = %[6]t
-			unordered = %[7]t
-			config_operation_types = [%s]
-			config_database = %[8]q
-			config_collection = %[9]q
-			config_service_id = %[10]q
-			config_match = "{\"updateDescription.updatedFields\":{\"status\":\"blocked\"}}"
+			database {
+				operation_types = [%[8]s]
+				database        = %[9]q
+				collection      = %[10]q
+				service_id      = %[11]q
+				unordered       = %[7]t
+				match           = jsonencode({ "updateDescription.updatedFields" = { status = "blocked" } })
+			}
 		}
 
 		data "mongodbatlas_event_triggers" "test" {
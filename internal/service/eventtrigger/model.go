@@ -0,0 +1,249 @@
+package eventtrigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+	"go.mongodb.org/realm/realm"
+)
+
+// noFunctionID is returned by the API as the function_id of triggers using event_processors.
+const noFunctionID = "000000000000000000000000"
+
+var typeBlocks = map[string]string{
+	TypeDatabase:       "database",
+	TypeAuthentication: "authentication",
+	TypeScheduled:      "scheduled",
+}
+
+// ValidateTypeBlocks checks that only the block matching the trigger type is set, so misconfigured triggers fail at plan time.
+func ValidateTypeBlocks(trigger *TFEventTriggerModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !trigger.FunctionID.IsNull() && !trigger.EventProcessors.IsNull() && !trigger.EventProcessors.IsUnknown() && len(trigger.EventProcessors.Elements()) > 0 {
+		diags.AddAttributeError(path.Root("function_id"), "invalid event trigger configuration", "function_id can't be used with event_processors")
+	}
+	if trigger.Type.IsUnknown() || trigger.Type.IsNull() {
+		return diags
+	}
+	triggerType := trigger.Type.ValueString()
+	blocks := []struct {
+		value types.Object
+		name  string
+	}{
+		{name: "database", value: trigger.Database},
+		{name: "authentication", value: trigger.Authentication},
+		{name: "scheduled", value: trigger.Scheduled},
+	}
+	for _, block := range blocks {
+		name := block.name
+		switch {
+		case name == typeBlocks[triggerType] && block.value.IsNull():
+			diags.AddAttributeError(path.Root(name), "invalid event trigger configuration", fmt.Sprintf("%s block must be set if type is %s", name, triggerType))
+		case name != typeBlocks[triggerType] && !block.value.IsNull():
+			diags.AddAttributeError(path.Root(name), "invalid event trigger configuration", fmt.Sprintf("%s block can't be set if type is %s", name, triggerType))
+		}
+	}
+	return diags
+}
+
+func NewEventTriggerReq(ctx context.Context, plan *TFEventTriggerModel) (*realm.EventTriggerRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	triggerReq := &realm.EventTriggerRequest{
+		Name:       plan.Name.ValueString(),
+		Type:       plan.Type.ValueString(),
+		FunctionID: plan.FunctionID.ValueString(),
+		Disabled:   plan.Disabled.ValueBoolPointer(),
+		Config:     &realm.EventTriggerConfig{},
+	}
+	switch plan.Type.ValueString() {
+	case TypeDatabase:
+		var database TFDatabaseModel
+		if diags.Append(plan.Database.As(ctx, &database, basetypes.ObjectAsOptions{})...); diags.HasError() {
+			return nil, diags
+		}
+		triggerReq.Config.OperationTypes = conversion.TypesListToString(ctx, database.OperationTypes)
+		triggerReq.Config.Database = database.Database.ValueString()
+		triggerReq.Config.Collection = database.Collection.ValueString()
+		triggerReq.Config.ServiceID = database.ServiceID.ValueString()
+		triggerReq.Config.FullDocument = conversion.Pointer(database.FullDocument.ValueBool())
+		triggerReq.Config.FullDocumentBeforeChange = conversion.Pointer(database.FullDocumentBefore.ValueBool())
+		triggerReq.Config.Unordered = conversion.Pointer(database.Unordered.ValueBool())
+		var err error
+		if triggerReq.Config.Match, err = jsonObjectToMap(database.Match); err != nil {
+			diags.AddError("failed to unmarshal match", err.Error())
+		}
+		if triggerReq.Config.Project, err = jsonObjectToMap(database.Project); err != nil {
+			diags.AddError("failed to unmarshal project", err.Error())
+		}
+	case TypeAuthentication:
+		var authentication TFAuthenticationModel
+		if diags.Append(plan.Authentication.As(ctx, &authentication, basetypes.ObjectAsOptions{})...); diags.HasError() {
+			return nil, diags
+		}
+		triggerReq.Config.OperationType = authentication.OperationType.ValueString()
+		triggerReq.Config.Providers = conversion.TypesListToString(ctx, authentication.Providers)
+	case TypeScheduled:
+		var scheduled TFScheduledModel
+		if diags.Append(plan.Scheduled.As(ctx, &scheduled, basetypes.ObjectAsOptions{})...); diags.HasError() {
+			return nil, diags
+		}
+		triggerReq.Config.Schedule = scheduled.Schedule.ValueString()
+	}
+	var eventProcessors []TFEventProcessorsModel
+	if diags.Append(plan.EventProcessors.ElementsAs(ctx, &eventProcessors, false)...); diags.HasError() {
+		return nil, diags
+	}
+	if len(eventProcessors) > 0 {
+		var awsEventBridge []TFAWSEventBridgeModel
+		if diags.Append(eventProcessors[0].AWSEventBridge.ElementsAs(ctx, &awsEventBridge, false)...); diags.HasError() {
+			return nil, diags
+		}
+		if len(awsEventBridge) > 0 {
+			triggerReq.EventProcessors = map[string]any{
+				"AWS_EVENTBRIDGE": map[string]any{
+					"type": "AWS_EVENTBRIDGE",
+					"config": map[string]any{
+						"account_id": awsEventBridge[0].ConfigAccountID.ValueString(),
+						"region":     awsEventBridge[0].ConfigRegion.ValueString(),
+					},
+				},
+			}
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return triggerReq, diags
+}
+
+// NewTFEventTrigger keeps the match and project of the plan or state when the API returns them empty, as an empty expression is omitted by the API.
+func NewTFEventTrigger(ctx context.Context, projectID, appID string, prior *TFEventTriggerModel, apiResp *realm.EventTrigger) (*TFEventTriggerModel, diag.Diagnostics) {
+	if apiResp == nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("event trigger API response is nil", "")}
+	}
+	var diags diag.Diagnostics
+	functionID := types.StringNull()
+	if apiResp.FunctionID != "" && apiResp.FunctionID != noFunctionID {
+		functionID = types.StringValue(apiResp.FunctionID)
+	}
+	trigger := &TFEventTriggerModel{
+		ID: types.StringValue(conversion.EncodeStateID(map[string]string{
+			"project_id": projectID,
+			"app_id":     appID,
+			"trigger_id": apiResp.ID,
+		})),
+		ProjectID:      types.StringValue(projectID),
+		AppID:          types.StringValue(appID),
+		TriggerID:      types.StringValue(apiResp.ID),
+		Name:           types.StringValue(apiResp.Name),
+		Type:           types.StringValue(apiResp.Type),
+		FunctionID:     functionID,
+		FunctionName:   types.StringValue(apiResp.FunctionName),
+		Disabled:       types.BoolValue(conversion.SafeValue(apiResp.Disabled)),
+		Database:       types.ObjectNull(DatabaseObjectType.AttrTypes),
+		Authentication: types.ObjectNull(AuthenticationObjectType.AttrTypes),
+		Scheduled:      types.ObjectNull(ScheduledObjectType.AttrTypes),
+	}
+	cfg := &apiResp.Config
+	var localDiags diag.Diagnostics
+	switch apiResp.Type {
+	case TypeDatabase:
+		priorDatabase := &TFDatabaseModel{Match: fwtypes.JSONStringNull(), Project: fwtypes.JSONStringNull()}
+		if prior != nil && !prior.Database.IsNull() && !prior.Database.IsUnknown() {
+			diags.Append(prior.Database.As(ctx, priorDatabase, basetypes.ObjectAsOptions{})...)
+		}
+		operationTypes, d := types.ListValueFrom(ctx, types.StringType, cfg.OperationTypes)
+		diags.Append(d...)
+		collection := types.StringNull()
+		if cfg.Collection != "" {
+			collection = types.StringValue(cfg.Collection)
+		}
+		match, err := mapToJSONObject(cfg.Match, priorDatabase.Match)
+		if err != nil {
+			diags.AddError("failed to marshal match", err.Error())
+		}
+		project, err := mapToJSONObject(cfg.Project, priorDatabase.Project)
+		if err != nil {
+			diags.AddError("failed to marshal project", err.Error())
+		}
+		trigger.Database, localDiags = types.ObjectValueFrom(ctx, DatabaseObjectType.AttrTypes, TFDatabaseModel{
+			OperationTypes:     operationTypes,
+			Database:           types.StringValue(cfg.Database),
+			Collection:         collection,
+			ServiceID:          types.StringValue(cfg.ServiceID),
+			Match:              match,
+			Project:            project,
+			FullDocument:       types.BoolValue(conversion.SafeValue(cfg.FullDocument)),
+			FullDocumentBefore: types.BoolValue(conversion.SafeValue(cfg.FullDocumentBeforeChange)),
+			Unordered:          types.BoolValue(conversion.SafeValue(cfg.Unordered)),
+		})
+	case TypeAuthentication:
+		providers, d := types.ListValueFrom(ctx, types.StringType, cfg.Providers)
+		diags.Append(d...)
+		trigger.Authentication, localDiags = types.ObjectValueFrom(ctx, AuthenticationObjectType.AttrTypes, TFAuthenticationModel{
+			OperationType: types.StringValue(cfg.OperationType),
+			Providers:     providers,
+		})
+	case TypeScheduled:
+		trigger.Scheduled, localDiags = types.ObjectValueFrom(ctx, ScheduledObjectType.AttrTypes, TFScheduledModel{
+			Schedule:     types.StringValue(cfg.Schedule),
+			ScheduleType: types.StringValue(cfg.ScheduleType),
+		})
+	}
+	diags.Append(localDiags...)
+	trigger.EventProcessors, localDiags = newTFEventProcessors(ctx, apiResp.EventProcessors)
+	diags.Append(localDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return trigger, diags
+}
+
+func newTFEventProcessors(ctx context.Context, eventProcessors map[string]any) (types.List, diag.Diagnostics) {
+	processors := make([]TFEventProcessorsModel, 0)
+	for _, processor := range flattenTriggerEventProcessorAWSEventBridge(eventProcessors) {
+		awsEventBridge := processor["aws_eventbridge"].([]map[string]any)[0]
+		awsEventBridgeList, diags := types.ListValueFrom(ctx, AWSEventBridgeObjectType, []TFAWSEventBridgeModel{{
+			ConfigAccountID: types.StringValue(awsEventBridge["config_account_id"].(string)),
+			ConfigRegion:    types.StringValue(awsEventBridge["config_region"].(string)),
+		}})
+		if diags.HasError() {
+			return types.ListNull(EventProcessorsObjectType), diags
+		}
+		processors = append(processors, TFEventProcessorsModel{AWSEventBridge: awsEventBridgeList})
+	}
+	return types.ListValueFrom(ctx, EventProcessorsObjectType, processors)
+}
+
+// jsonObjectToMap returns an untyped nil for null values so the attribute is omitted from the request.
+func jsonObjectToMap(value fwtypes.JSONString) (any, error) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil, nil
+	}
+	var result map[string]any
+	if err := json.Unmarshal([]byte(value.ValueString()), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func mapToJSONObject(value any, prior fwtypes.JSONString) (fwtypes.JSONString, error) {
+	if valueMap, ok := value.(map[string]any); value == nil || ok && len(valueMap) == 0 {
+		if prior.IsUnknown() {
+			return fwtypes.JSONStringNull(), nil
+		}
+		return prior, nil
+	}
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return fwtypes.JSONStringNull(), err
+	}
+	return fwtypes.JSONStringValue(string(valueJSON)), nil
+}
@@ -0,0 +1,335 @@
+package eventtrigger_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/realm/realm"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/eventtrigger"
+)
+
+const (
+	groupID    = "664619d870c247237f4b86a6"
+	appID      = "6646255cd2d6fd9c0e3a1b2c"
+	triggerID  = "6646255cd2d6fd9c0e3a1b2d"
+	functionID = "6646255cd2d6fd9c0e3a1b2e"
+	serviceID  = "6646255cd2d6fd9c0e3a1b2f"
+	match      = `{"updateDescription.updatedFields":{"status":"blocked"}}`
+)
+
+var stateID = conversion.EncodeStateID(map[string]string{
+	"project_id": groupID,
+	"app_id":     appID,
+	"trigger_id": triggerID,
+})
+
+func TestValidateTypeBlocks(t *testing.T) {
+	testCases := map[string]struct {
+		trigger        *eventtrigger.TFEventTriggerModel
+		expectedErrors []string
+	}{
+		"valid": {
+			trigger: triggerModel(eventtrigger.TypeScheduled, func(m *eventtrigger.TFEventTriggerModel) {
+				m.Scheduled = scheduledObject("0 8 * * *", "")
+			}),
+		},
+		"missingTypeBlock": {
+			trigger:        triggerModel(eventtrigger.TypeDatabase, nil),
+			expectedErrors: []string{"database block must be set if type is DATABASE"},
+		},
+		"otherTypeBlock": {
+			trigger: triggerModel(eventtrigger.TypeScheduled, func(m *eventtrigger.TFEventTriggerModel) {
+				m.Scheduled = scheduledObject("0 8 * * *", "")
+				m.Authentication = types.ObjectValueMust(eventtrigger.AuthenticationObjectType.AttrTypes, map[string]attr.Value{
+					"operation_type": types.StringValue("LOGIN"),
+					"providers":      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("anon-user")}),
+				})
+			}),
+			expectedErrors: []string{"authentication block can't be set if type is SCHEDULED"},
+		},
+		"functionAndEventProcessors": {
+			trigger: triggerModel(eventtrigger.TypeScheduled, func(m *eventtrigger.TFEventTriggerModel) {
+				m.Scheduled = scheduledObject("0 8 * * *", "")
+				m.FunctionID = types.StringValue(functionID)
+				m.EventProcessors = eventProcessorsList("123456789012", "us-east-1")
+			}),
+			expectedErrors: []string{"function_id can't be used with event_processors"},
+		},
+		"unknownType": {
+			trigger: triggerModel(eventtrigger.TypeScheduled, func(m *eventtrigger.TFEventTriggerModel) {
+				m.Type = types.StringUnknown()
+			}),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := eventtrigger.ValidateTypeBlocks(tc.trigger)
+			require.Len(t, diags, len(tc.expectedErrors))
+			for i, expected := range tc.expectedErrors {
+				assert.Equal(t, expected, diags[i].Detail())
+			}
+		})
+	}
+}
+
+func TestEventTriggerTFToSDKModel(t *testing.T) {
+	testCases := map[string]struct {
+		tfModel     *eventtrigger.TFEventTriggerModel
+		expectedReq *realm.EventTriggerRequest
+	}{
+		"database": {
+			tfModel: triggerModel(eventtrigger.TypeDatabase, func(m *eventtrigger.TFEventTriggerModel) {
+				m.FunctionID = types.StringValue(functionID)
+				m.Database = databaseObject(fwtypes.JSONStringValue(match), types.StringNull())
+			}),
+			expectedReq: &realm.EventTriggerRequest{
+				Name:       "trigger",
+				Type:       eventtrigger.TypeDatabase,
+				FunctionID: functionID,
+				Disabled:   conversion.Pointer(false),
+				Config: &realm.EventTriggerConfig{
+					OperationTypes:           []string{"INSERT", "UPDATE"},
+					Database:                 "sample_airbnb",
+					ServiceID:                serviceID,
+					Match:                    map[string]any{"updateDescription.updatedFields": map[string]any{"status": "blocked"}},
+					FullDocument:             conversion.Pointer(true),
+					FullDocumentBeforeChange: conversion.Pointer(false),
+					Unordered:                conversion.Pointer(false),
+				},
+			},
+		},
+		"authenticationWithEventProcessors": {
+			tfModel: triggerModel(eventtrigger.TypeAuthentication, func(m *eventtrigger.TFEventTriggerModel) {
+				m.Authentication = types.ObjectValueMust(eventtrigger.AuthenticationObjectType.AttrTypes, map[string]attr.Value{
+					"operation_type": types.StringValue("LOGIN"),
+					"providers":      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("anon-user"), types.StringValue("api-key")}),
+				})
+				m.EventProcessors = eventProcessorsList("123456789012", "us-east-1")
+			}),
+			expectedReq: &realm.EventTriggerRequest{
+				Name:     "trigger",
+				Type:     eventtrigger.TypeAuthentication,
+				Disabled: conversion.Pointer(false),
+				Config: &realm.EventTriggerConfig{
+					OperationType: "LOGIN",
+					Providers:     []string{"anon-user", "api-key"},
+				},
+				EventProcessors: map[string]any{
+					"AWS_EVENTBRIDGE": map[string]any{
+						"type":   "AWS_EVENTBRIDGE",
+						"config": map[string]any{"account_id": "123456789012", "region": "us-east-1"},
+					},
+				},
+			},
+		},
+		"scheduled": {
+			tfModel: triggerModel(eventtrigger.TypeScheduled, func(m *eventtrigger.TFEventTriggerModel) {
+				m.FunctionID = types.StringValue(functionID)
+				m.Scheduled = scheduledObject("*/5 * * * *", "")
+			}),
+			expectedReq: &realm.EventTriggerRequest{
+				Name:       "trigger",
+				Type:       eventtrigger.TypeScheduled,
+				FunctionID: functionID,
+				Disabled:   conversion.Pointer(false),
+				Config:     &realm.EventTriggerConfig{Schedule: "*/5 * * * *"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req, diags := eventtrigger.NewEventTriggerReq(context.Background(), tc.tfModel)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tc.expectedReq, req)
+		})
+	}
+}
+
+func TestEventTriggerSDKToTFModel(t *testing.T) {
+	testCases := map[string]struct {
+		prior           *eventtrigger.TFEventTriggerModel
+		apiResp         *realm.EventTrigger
+		expectedTFModel *eventtrigger.TFEventTriggerModel
+	}{
+		"database": {
+			apiResp: &realm.EventTrigger{
+				ID:           triggerID,
+				Name:         "trigger",
+				Type:         eventtrigger.TypeDatabase,
+				FunctionID:   functionID,
+				FunctionName: "onInsert",
+				Disabled:     conversion.Pointer(false),
+				Config: realm.EventTriggerConfig{
+					OperationTypes: []string{"INSERT", "UPDATE"},
+					Database:       "sample_airbnb",
+					ServiceID:      serviceID,
+					Match:          map[string]any{"updateDescription.updatedFields": map[string]any{"status": "blocked"}},
+					FullDocument:   conversion.Pointer(true),
+				},
+			},
+			expectedTFModel: tfModelFromAPI(eventtrigger.TypeDatabase, func(m *eventtrigger.TFEventTriggerModel) {
+				m.Database = databaseObject(fwtypes.JSONStringValue(match), types.StringNull())
+			}),
+		},
+		"emptyMatchKeepsPrior": {
+			prior: triggerModel(eventtrigger.TypeDatabase, func(m *eventtrigger.TFEventTriggerModel) {
+				m.Database = databaseObject(fwtypes.JSONStringValue("{}"), types.StringNull())
+			}),
+			apiResp: &realm.EventTrigger{
+				ID:           triggerID,
+				Name:         "trigger",
+				Type:         eventtrigger.TypeDatabase,
+				FunctionID:   functionID,
+				FunctionName: "onInsert",
+				Config: realm.EventTriggerConfig{
+					OperationTypes: []string{"INSERT", "UPDATE"},
+					Database:       "sample_airbnb",
+					ServiceID:      serviceID,
+					Match:          map[string]any{},
+					FullDocument:   conversion.Pointer(true),
+				},
+			},
+			expectedTFModel: tfModelFromAPI(eventtrigger.TypeDatabase, func(m *eventtrigger.TFEventTriggerModel) {
+				m.Database = databaseObject(fwtypes.JSONStringValue("{}"), types.StringNull())
+			}),
+		},
+		"scheduledWithEventProcessors": {
+			apiResp: &realm.EventTrigger{
+				ID:       triggerID,
+				Name:     "trigger",
+				Type:     eventtrigger.TypeScheduled,
+				Disabled: conversion.Pointer(true),
+				// function_id of triggers with event processors
+				FunctionID: "000000000000000000000000",
+				Config:     realm.EventTriggerConfig{Schedule: "0 8 * * *", ScheduleType: "ADVANCED"},
+				EventProcessors: map[string]any{
+					"AWS_EVENTBRIDGE": map[string]any{
+						"type":   "AWS_EVENTBRIDGE",
+						"config": map[string]any{"account_id": "123456789012", "region": "us-east-1"},
+					},
+				},
+			},
+			expectedTFModel: tfModelFromAPI(eventtrigger.TypeScheduled, func(m *eventtrigger.TFEventTriggerModel) {
+				m.FunctionID = types.StringNull()
+				m.FunctionName = types.StringValue("")
+				m.Disabled = types.BoolValue(true)
+				m.Scheduled = scheduledObject("0 8 * * *", "ADVANCED")
+				m.EventProcessors = eventProcessorsList("123456789012", "us-east-1")
+			}),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model, diags := eventtrigger.NewTFEventTrigger(context.Background(), groupID, appID, tc.prior, tc.apiResp)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tc.expectedTFModel, model)
+		})
+	}
+}
+
+func TestEventTriggerFromV0(t *testing.T) {
+	stateV0 := &eventtrigger.TFEventTriggerModelV0{
+		ID:                       types.StringValue(stateID),
+		ProjectID:                types.StringValue(groupID),
+		AppID:                    types.StringValue(appID),
+		TriggerID:                types.StringValue(triggerID),
+		Name:                     types.StringValue("trigger"),
+		Type:                     types.StringValue(eventtrigger.TypeDatabase),
+		FunctionID:               types.StringValue(functionID),
+		FunctionName:             types.StringValue("onInsert"),
+		Disabled:                 types.BoolValue(false),
+		ConfigOperationTypes:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("INSERT"), types.StringValue("UPDATE")}),
+		ConfigOperationType:      types.StringValue(""),
+		ConfigProviders:          types.ListValueMust(types.StringType, []attr.Value{}),
+		ConfigDatabase:           types.StringValue("sample_airbnb"),
+		ConfigCollection:         types.StringValue(""),
+		ConfigServiceID:          types.StringValue(serviceID),
+		ConfigMatch:              types.StringValue(match),
+		ConfigProject:            types.StringValue("null"),
+		ConfigFullDocument:       types.BoolValue(true),
+		ConfigFullDocumentBefore: types.BoolValue(false),
+		ConfigSchedule:           types.StringValue(""),
+		ConfigScheduleType:       types.StringValue(""),
+		EventProcessors:          types.ListValueMust(eventtrigger.EventProcessorsObjectType, []attr.Value{}),
+		Unordered:                types.BoolNull(),
+	}
+	model, diags := eventtrigger.NewTFEventTriggerFromV0(context.Background(), stateV0)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, tfModelFromAPI(eventtrigger.TypeDatabase, func(m *eventtrigger.TFEventTriggerModel) {
+		m.Database = databaseObject(fwtypes.JSONStringValue(match), types.StringNull())
+	}), model)
+}
+
+// triggerModel returns a plan or config without the blocks of the trigger type, they are set with update.
+func triggerModel(triggerType string, update func(*eventtrigger.TFEventTriggerModel)) *eventtrigger.TFEventTriggerModel {
+	m := &eventtrigger.TFEventTriggerModel{
+		ProjectID:       types.StringValue(groupID),
+		AppID:           types.StringValue(appID),
+		Name:            types.StringValue("trigger"),
+		Type:            types.StringValue(triggerType),
+		FunctionID:      types.StringNull(),
+		Disabled:        types.BoolValue(false),
+		Database:        types.ObjectNull(eventtrigger.DatabaseObjectType.AttrTypes),
+		Authentication:  types.ObjectNull(eventtrigger.AuthenticationObjectType.AttrTypes),
+		Scheduled:       types.ObjectNull(eventtrigger.ScheduledObjectType.AttrTypes),
+		EventProcessors: types.ListValueMust(eventtrigger.EventProcessorsObjectType, []attr.Value{}),
+	}
+	if update != nil {
+		update(m)
+	}
+	return m
+}
+
+func tfModelFromAPI(triggerType string, update func(*eventtrigger.TFEventTriggerModel)) *eventtrigger.TFEventTriggerModel {
+	return triggerModel(triggerType, func(m *eventtrigger.TFEventTriggerModel) {
+		m.ID = types.StringValue(stateID)
+		m.TriggerID = types.StringValue(triggerID)
+		m.FunctionID = types.StringValue(functionID)
+		m.FunctionName = types.StringValue("onInsert")
+		update(m)
+	})
+}
+
+func databaseObject(matchValue fwtypes.JSONString, collection types.String) types.Object {
+	return types.ObjectValueMust(eventtrigger.DatabaseObjectType.AttrTypes, map[string]attr.Value{
+		"operation_types":      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("INSERT"), types.StringValue("UPDATE")}),
+		"database":             types.StringValue("sample_airbnb"),
+		"collection":           collection,
+		"service_id":           types.StringValue(serviceID),
+		"match":                matchValue,
+		"project":              fwtypes.JSONStringNull(),
+		"full_document":        types.BoolValue(true),
+		"full_document_before": types.BoolValue(false),
+		"unordered":            types.BoolValue(false),
+	})
+}
+
+func scheduledObject(schedule, scheduleType string) types.Object {
+	scheduleTypeValue := types.StringUnknown()
+	if scheduleType != "" {
+		scheduleTypeValue = types.StringValue(scheduleType)
+	}
+	return types.ObjectValueMust(eventtrigger.ScheduledObjectType.AttrTypes, map[string]attr.Value{
+		"schedule":      types.StringValue(schedule),
+		"schedule_type": scheduleTypeValue,
+	})
+}
+
+func eventProcessorsList(accountID, region string) types.List {
+	awsEventBridge := types.ListValueMust(eventtrigger.AWSEventBridgeObjectType, []attr.Value{
+		types.ObjectValueMust(eventtrigger.AWSEventBridgeObjectType.AttrTypes, map[string]attr.Value{
+			"config_account_id": types.StringValue(accountID),
+			"config_region":     types.StringValue(region),
+		}),
+	})
+	return types.ListValueMust(eventtrigger.EventProcessorsObjectType, []attr.Value{
+		types.ObjectValueMust(eventtrigger.EventProcessorsObjectType.AttrTypes, map[string]attr.Value{"aws_eventbridge": awsEventBridge}),
+	})
+}
@@ -2,558 +2,188 @@ package eventtrigger
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-	"log"
 	"net/http"
-	"reflect"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
-	"github.com/spf13/cast"
-	"go.mongodb.org/realm/realm"
 )
 
 const (
-	errorEventTriggersCreate  = "error creating MongoDB EventTriggers (%s): %s"
-	errorEventTriggersUpdate  = "error updating MongoDB EventTriggers (%s)%s: %s"
-	errorEventTriggersRead    = "error reading MongoDB EventTriggers (%s)%s: %s"
-	errorEventTriggersDelete  = "error deleting MongoDB EventTriggers (%s)%s: %s"
-	errorEventTriggersSetting = "error setting `%s` for EventTriggers(%s)%s: %s"
+	eventTriggerName = "event_trigger"
+	errorRealmClient = "error getting the realm client"
 )
 
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceMongoDBAtlasEventTriggersCreate,
-		ReadContext:   resourceMongoDBAtlasEventTriggersRead,
-		UpdateContext: resourceMongoDBAtlasEventTriggersUpdate,
-		DeleteContext: resourceMongoDBAtlasEventTriggersDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceMongoDBAtlasEventTriggerImportState,
-		},
-		Schema: map[string]*schema.Schema{
-			"project_id": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"app_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"DATABASE", "AUTHENTICATION", "SCHEDULED"}, false),
-			},
-			"function_id": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{"event_processors"},
-			},
-			"disabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
-			},
-			"config_operation_types": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{"INSERT", "UPDATE", "REPLACE", "DELETE"}, false),
-				},
-			},
-			"config_operation_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.StringInSlice([]string{"LOGIN", "CREATE", "DELETE"}, false),
-			},
-			"config_providers": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{"anon-user", "local-userpass", "api-key", "custom-token", "custom-function", "oauth2-facebook", "oauth2-google", "oauth2-apple"}, false),
-				},
-			},
-			"config_database": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"config_collection": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"config_service_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"config_match": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				DiffSuppressFunc: func(k, oldAttr, newAttr string, d *schema.ResourceData) bool {
-					var j, j2 any
-					if err := json.Unmarshal([]byte(oldAttr), &j); err != nil {
-						log.Printf("[ERROR] json.Unmarshal %v", err)
-						return false
-					}
-					if err := json.Unmarshal([]byte(newAttr), &j2); err != nil {
-						log.Printf("[ERROR] json.Unmarshal %v", err)
-						return false
-					}
-					if !reflect.DeepEqual(&j, &j2) {
-						return false
-					}
-
-					return true
-				},
-			},
-			"config_project": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				DiffSuppressFunc: func(k, oldAttr, newAttr string, d *schema.ResourceData) bool {
-					var j, j2 any
-					if err := json.Unmarshal([]byte(oldAttr), &j); err != nil {
-						log.Printf("[ERROR] json.Unmarshal %v", err)
-						return false
-					}
-					if err := json.Unmarshal([]byte(newAttr), &j2); err != nil {
-						log.Printf("[ERROR] json.Unmarshal %v", err)
-						return false
-					}
-					if !reflect.DeepEqual(&j, &j2) {
-						return false
-					}
+var _ resource.ResourceWithConfigure = &eventTriggerRS{}
+var _ resource.ResourceWithImportState = &eventTriggerRS{}
+var _ resource.ResourceWithValidateConfig = &eventTriggerRS{}
+var _ resource.ResourceWithUpgradeState = &eventTriggerRS{}
 
-					return true
-				},
-			},
-			"config_full_document": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
-			},
-			"config_full_document_before": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
-			},
-			"config_schedule": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"config_schedule_type": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"event_processors": {
-				Type:          schema.TypeList,
-				MaxItems:      1,
-				Optional:      true,
-				ConflictsWith: []string{"function_id"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"aws_eventbridge": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"config_account_id": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"config_region": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			"function_name": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"trigger_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"unordered": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
-			},
+func Resource() resource.Resource {
+	return &eventTriggerRS{
+		RSCommon: config.RSCommon{
+			ResourceName: eventTriggerName,
 		},
 	}
 }
 
-func resourceMongoDBAtlasEventTriggersCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	conn, err := meta.(*config.MongoDBClient).GetRealmClient(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	projectID := d.Get("project_id").(string)
-	appID := d.Get("app_id").(string)
-	typeTrigger := d.Get("type").(string)
-	eventTriggerReq := &realm.EventTriggerRequest{
-		Name:       d.Get("name").(string),
-		Type:       typeTrigger,
-		FunctionID: d.Get("function_id").(string),
-	}
-
-	if v, ok := d.GetOk("disabled"); ok {
-		eventTriggerReq.Disabled = conversion.Pointer(v.(bool))
-	}
-
-	eventTriggerConfig := &realm.EventTriggerConfig{}
-
-	cots, okCots := d.GetOk("config_operation_types")
-	cot, okCot := d.GetOk("config_operation_type")
-	pro, okP := d.GetOk("config_providers")
-	data, okD := d.GetOk("config_database")
-	coll, okC := d.GetOk("config_collection")
-	si, okSI := d.GetOk("config_service_id")
-	sche, oksch := d.GetOk("config_schedule")
-
-	if typeTrigger == "DATABASE" {
-		if !okCots || !okD || !okSI {
-			return diag.FromErr(fmt.Errorf("`config_operation_types`, `config_database`,`config_service_id` must be provided if type is DATABASE"))
-		}
-	}
-	if typeTrigger == "AUTHENTICATION" {
-		if !okCot || !okP {
-			return diag.FromErr(fmt.Errorf("`config_operation_type`, `config_providers` must be provided if type is AUTHENTICATION"))
-		}
-	}
-	if typeTrigger == "SCHEDULED" {
-		if !oksch {
-			return diag.FromErr(fmt.Errorf("`config_schedule` must be provided if type is SCHEDULED"))
-		}
-	}
-
-	if okCots {
-		eventTriggerConfig.OperationTypes = cast.ToStringSlice(cots)
-	}
-	if okCot {
-		eventTriggerConfig.OperationType = cot.(string)
-	}
-	if okP {
-		eventTriggerConfig.Providers = cast.ToStringSlice(pro)
-	}
-	if okD {
-		eventTriggerConfig.Database = data.(string)
-	}
-	if okC {
-		eventTriggerConfig.Collection = coll.(string)
-	}
-	if okSI {
-		eventTriggerConfig.ServiceID = si.(string)
-	}
-	if v, ok := d.GetOk("config_match"); ok {
-		eventTriggerConfig.Match = cast.ToStringMap(v)
-	}
-	if v, ok := d.GetOk("config_project"); ok {
-		eventTriggerConfig.Project = cast.ToStringMap(v)
-	}
-	if v, ok := d.GetOk("config_full_document"); ok {
-		eventTriggerConfig.FullDocument = conversion.Pointer(v.(bool))
-	}
-	if v, ok := d.GetOk("config_full_document_before"); ok {
-		eventTriggerConfig.FullDocumentBeforeChange = conversion.Pointer(v.(bool))
-	}
-	if oksch {
-		eventTriggerConfig.Schedule = sche.(string)
-	}
-
-	if v, ok := d.GetOk("event_processors"); ok {
-		eventTriggerReq.EventProcessors = expandTriggerEventProcessorAWSEventBridge(v.([]any))
-	}
-
-	if v, ok := d.GetOk("unordered"); ok {
-		eventTriggerConfig.Unordered = conversion.Pointer(v.(bool))
-	}
+type eventTriggerRS struct {
+	config.RSCommon
+}
 
-	eventTriggerReq.Config = eventTriggerConfig
+func (r *eventTriggerRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
 
-	eventResp, _, err := conn.EventTriggers.Create(context.Background(), projectID, appID, eventTriggerReq)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersCreate, projectID, err))
+func (r *eventTriggerRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var trigger TFEventTriggerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &trigger)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id": projectID,
-		"app_id":     appID,
-		"trigger_id": eventResp.ID,
-	}))
-
-	return resourceMongoDBAtlasEventTriggersRead(ctx, d, meta)
+	resp.Diagnostics.Append(ValidateTypeBlocks(&trigger)...)
 }
 
-func resourceMongoDBAtlasEventTriggersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	conn, err := meta.(*config.MongoDBClient).GetRealmClient(ctx)
-	if err != nil {
-		return diag.FromErr(err)
+func (r *eventTriggerRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFEventTriggerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	appID := ids["app_id"]
-	triggerID := ids["trigger_id"]
-
-	resp, recodes, err := conn.EventTriggers.Get(ctx, projectID, appID, triggerID)
+	conn, err := r.Client.GetRealmClient(ctx)
 	if err != nil {
-		if recodes != nil && recodes.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(fmt.Errorf(errorEventTriggersRead, projectID, appID, err))
-	}
-
-	if err = d.Set("trigger_id", resp.ID); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "trigger_id", projectID, appID, err))
-	}
-	if err = d.Set("name", resp.Name); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "name", projectID, appID, err))
-	}
-	if err = d.Set("type", resp.Type); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "type", projectID, appID, err))
-	}
-	functionID := resp.FunctionID
-	if resp.FunctionID == "000000000000000000000000" {
-		functionID = ""
-	}
-	if err = d.Set("function_id", functionID); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "function_id", projectID, appID, err))
-	}
-	if err = d.Set("function_name", resp.FunctionName); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "function_name", projectID, appID, err))
-	}
-	if err = d.Set("disabled", resp.Disabled); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "disabled", projectID, appID, err))
-	}
-	if err = d.Set("config_operation_types", resp.Config.OperationTypes); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_operation_types", projectID, appID, err))
-	}
-	if err = d.Set("config_operation_type", resp.Config.OperationType); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_operation_type", projectID, appID, err))
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
 	}
-	if err = d.Set("config_providers", resp.Config.Providers); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_providers", projectID, appID, err))
+	triggerReq, diags := NewEventTriggerReq(ctx, &plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
-	if err = d.Set("config_database", resp.Config.Database); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_database", projectID, appID, err))
-	}
-	if err = d.Set("config_collection", resp.Config.Collection); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_collection", projectID, appID, err))
-	}
-	if err = d.Set("config_service_id", resp.Config.ServiceID); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_service_id", projectID, appID, err))
-	}
-	if err = d.Set("config_match", matchToString(resp.Config.Match)); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_match", projectID, appID, err))
-	}
-	if err = d.Set("config_project", matchToString(resp.Config.Project)); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_project", projectID, appID, err))
-	}
-	if err = d.Set("config_full_document", resp.Config.FullDocument); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_full_document", projectID, appID, err))
-	}
-
-	if err = d.Set("config_full_document_before", resp.Config.FullDocumentBeforeChange); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_full_document", projectID, appID, err))
-	}
-
-	if err = d.Set("config_schedule", resp.Config.Schedule); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_schedule", projectID, appID, err))
-	}
-	if err = d.Set("config_schedule_type", resp.Config.ScheduleType); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "config_schedule_type", projectID, appID, err))
-	}
-	if err = d.Set("unordered", resp.Config.Unordered); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "unordered", projectID, appID, err))
-	}
-	if err = d.Set("event_processors", flattenTriggerEventProcessorAWSEventBridge(resp.EventProcessors)); err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "event_processors", projectID, appID, err))
-	}
-
-	return nil
-}
-
-func resourceMongoDBAtlasEventTriggersUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	conn, err := meta.(*config.MongoDBClient).GetRealmClient(ctx)
+	projectID := plan.ProjectID.ValueString()
+	appID := plan.AppID.ValueString()
+	created, _, err := conn.EventTriggers.Create(ctx, projectID, appID, triggerReq)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	appID := ids["app_id"]
-	triggerID := ids["trigger_id"]
-	typeTrigger := d.Get("type").(string)
-
-	eventReq := &realm.EventTriggerRequest{
-		Name:       d.Get("name").(string),
-		Type:       typeTrigger,
-		FunctionID: d.Get("function_id").(string),
-		Disabled:   conversion.Pointer(d.Get("disabled").(bool)),
+		resp.Diagnostics.AddError("error creating resource", err.Error())
+		return
 	}
-	eventTriggerConfig := &realm.EventTriggerConfig{}
-
-	if typeTrigger == "DATABASE" {
-		eventTriggerConfig.OperationTypes = cast.ToStringSlice(d.Get("config_operation_types"))
-		eventTriggerConfig.Database = d.Get("config_database").(string)
-		eventTriggerConfig.Collection = d.Get("config_collection").(string)
-		eventTriggerConfig.ServiceID = d.Get("config_service_id").(string)
-		eventTriggerConfig.Match = cast.ToStringMap(d.Get("config_match").(string))
-		eventTriggerConfig.Project = cast.ToStringMap(d.Get("config_project").(string))
-		eventTriggerConfig.FullDocument = conversion.Pointer(d.Get("config_full_document").(bool))
-		eventTriggerConfig.FullDocumentBeforeChange = conversion.Pointer(d.Get("config_full_document_before").(bool))
-		eventTriggerConfig.Unordered = conversion.Pointer(d.Get("unordered").(bool))
+	// The create response doesn't contain the function name nor the schedule type.
+	apiResp, _, err := conn.EventTriggers.Get(ctx, projectID, appID, created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
 	}
-	if typeTrigger == "AUTHENTICATION" {
-		eventTriggerConfig.OperationType = d.Get("config_operation_type").(string)
-		eventTriggerConfig.Providers = cast.ToStringSlice(d.Get("config_providers"))
+	newTriggerModel, diags := NewTFEventTrigger(ctx, projectID, appID, &plan, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTriggerModel)...)
+}
 
-	if typeTrigger == "SCHEDULED" {
-		eventTriggerConfig.Schedule = d.Get("config_schedule").(string)
+func (r *eventTriggerRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFEventTriggerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	eventReq.EventProcessors = expandTriggerEventProcessorAWSEventBridge(d.Get("event_processors").([]any))
-
-	eventReq.Config = eventTriggerConfig
-
-	_, _, err = conn.EventTriggers.Update(ctx, projectID, appID, triggerID, eventReq)
+	conn, err := r.Client.GetRealmClient(ctx)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersUpdate, projectID, appID, err))
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
 	}
-
-	return nil
-}
-
-func resourceMongoDBAtlasEventTriggersDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	// Get the client connection.
-	conn, err := meta.(*config.MongoDBClient).GetRealmClient(ctx)
+	projectID := state.ProjectID.ValueString()
+	appID := state.AppID.ValueString()
+	apiResp, getResp, err := conn.EventTriggers.Get(ctx, projectID, appID, state.TriggerID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
 	}
-	ids := conversion.DecodeStateID(d.Id())
-
-	projectID := ids["project_id"]
-	appID := ids["app_id"]
-	triggerID := ids["trigger_id"]
-
-	_, err = conn.EventTriggers.Delete(ctx, projectID, appID, triggerID)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorEventTriggersDelete, projectID, appID, err))
+	newTriggerModel, diags := NewTFEventTrigger(ctx, projectID, appID, &state, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
-
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTriggerModel)...)
 }
 
-func expandTriggerEventProcessorAWSEventBridge(p []any) map[string]any {
-	if len(p) == 0 {
-		return nil
+func (r *eventTriggerRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFEventTriggerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	aws := p[0].(map[string]any)
-	event := aws["aws_eventbridge"].([]any)
-	if len(event) == 0 {
-		return nil
+	conn, err := r.Client.GetRealmClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
+	}
+	triggerReq, diags := NewEventTriggerReq(ctx, &plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	appID := plan.AppID.ValueString()
+	triggerID := plan.TriggerID.ValueString()
+	if _, _, err := conn.EventTriggers.Update(ctx, projectID, appID, triggerID, triggerReq); err != nil {
+		resp.Diagnostics.AddError("error updating resource", err.Error())
+		return
+	}
+	apiResp, _, err := conn.EventTriggers.Get(ctx, projectID, appID, triggerID)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
 	}
-	eventObj := event[0].(map[string]any)
-
-	return map[string]any{
-		"AWS_EVENTBRIDGE": map[string]any{
-			"type": "AWS_EVENTBRIDGE",
-			"config": map[string]any{
-				"account_id": eventObj["config_account_id"].(string),
-				"region":     eventObj["config_region"].(string),
-			},
-		},
+	newTriggerModel, diags := NewTFEventTrigger(ctx, projectID, appID, &plan, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTriggerModel)...)
 }
 
-func flattenTriggerEventProcessorAWSEventBridge(eventProcessor map[string]any) []map[string]any {
-	results := make([]map[string]any, 0)
-	if eventProcessor != nil && eventProcessor["AWS_EVENTBRIDGE"] != nil {
-		event := eventProcessor["AWS_EVENTBRIDGE"].(map[string]any)
-		cfg := event["config"].(map[string]any)
-		mapEvent := map[string]any{
-			"aws_eventbridge": []map[string]any{
-				{
-					"config_account_id": cfg["account_id"].(string),
-					"config_region":     cfg["region"].(string),
-				},
-			},
-		}
-		results = append(results, mapEvent)
+func (r *eventTriggerRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFEventTriggerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	return results
-}
-
-func resourceMongoDBAtlasEventTriggerImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	conn, err := meta.(*config.MongoDBClient).GetRealmClient(ctx)
+	conn, err := r.Client.GetRealmClient(ctx)
 	if err != nil {
-		return nil, err
+		resp.Diagnostics.AddError(errorRealmClient, err.Error())
+		return
 	}
-
-	parts := strings.Split(d.Id(), "--")
-	if len(parts) != 3 {
-		return nil, errors.New("import format error: to import a MongoDB Event Trigger, use the format {project_id}--{app_id}--{trigger_id} ")
+	if _, err := conn.EventTriggers.Delete(ctx, state.ProjectID.ValueString(), state.AppID.ValueString(), state.TriggerID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("error deleting resource", err.Error())
+		return
 	}
+}
 
-	projectID := parts[0]
-	appID := parts[1]
-	triggerID := parts[2]
-
-	_, _, err = conn.EventTriggers.Get(ctx, projectID, appID, triggerID)
+func (r *eventTriggerRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, appID, triggerID, err := splitEventTriggerImportID(req.ID)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't import event trigger %s in project %s, error: %s", triggerID, projectID, err)
+		resp.Diagnostics.AddError("error splitting event trigger import ID", err.Error())
+		return
 	}
-
-	if err := d.Set("project_id", projectID); err != nil {
-		return nil, fmt.Errorf(errorEventTriggersSetting, "project_id", projectID, appID, err)
-	}
-	if err := d.Set("app_id", appID); err != nil {
-		return nil, fmt.Errorf(errorEventTriggersSetting, "app_id", projectID, appID, err)
-	}
-
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id": projectID,
-		"app_id":     appID,
-		"trigger_id": triggerID,
-	}))
-
-	return []*schema.ResourceData{d}, nil
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("trigger_id"), triggerID)...)
 }
 
-func matchToString(value any) string {
-	b, err := json.Marshal(value)
-	if err != nil {
-		log.Printf("[ERROR] %v ", err)
+func splitEventTriggerImportID(id string) (projectID, appID, triggerID string, err error) {
+	parts := strings.Split(id, "--")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		err = errors.New("import format error: to import a MongoDB Event Trigger, use the format {project_id}--{app_id}--{trigger_id}")
+		return
 	}
-	return string(b)
+	return parts[0], parts[1], parts[2], nil
 }
@@ -0,0 +1,74 @@
+package eventtrigger_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/mig"
+	"go.mongodb.org/realm/realm"
+)
+
+// TestMigEventTrigger_flatConfigToBlocks checks that the state upgrader from the flat config_* attributes doesn't produce plan changes.
+func TestMigEventTrigger_flatConfigToBlocks(t *testing.T) {
+	acc.SkipTestForCI(t) // needs a project configured for triggers
+
+	var (
+		resourceName = "mongodbatlas_event_trigger.test"
+		projectID    = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		appID        = os.Getenv("MONGODB_REALM_APP_ID")
+	)
+	event := realm.EventTriggerRequest{
+		Name:       acc.RandomName(),
+		Type:       "DATABASE",
+		FunctionID: os.Getenv("MONGODB_REALM_FUNCTION_ID"),
+		Disabled:   conversion.Pointer(true),
+		Config: &realm.EventTriggerConfig{
+			OperationTypes: []string{"INSERT", "UPDATE"},
+			Database:       "sample_airbnb",
+			Collection:     "listingsAndReviews",
+			ServiceID:      os.Getenv("MONGODB_REALM_SERVICE_ID"),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.PreCheckBasic(t) },
+		CheckDestroy: checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: mig.ExternalProviders(),
+				Config:            configDatabaseTriggerFlat(projectID, appID, `"INSERT", "UPDATE"`, &event),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_database", event.Config.Database),
+				),
+			},
+			mig.TestStepCheckEmptyPlan(configDatabaseTrigger(projectID, appID, `"INSERT", "UPDATE"`, &event, false, false)),
+		},
+	})
+}
+
+// configDatabaseTriggerFlat uses the config_* attributes of the versions before the typed blocks, with the same trigger as configDatabaseTrigger.
+func configDatabaseTriggerFlat(projectID, appID, operationTypes string, eventTrigger *realm.EventTriggerRequest) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_event_trigger" "test" {
+			project_id = %[1]q
+			app_id = %[2]q
+			name = %[3]q
+			type = %[4]q
+			function_id = %[5]q
+			disabled = %[6]t
+			config_operation_types = [%[7]s]
+			config_database = %[8]q
+			config_collection = %[9]q
+			config_service_id = %[10]q
+			config_full_document = false
+			config_full_document_before = false
+			config_match = jsonencode({ "updateDescription.updatedFields" = { status = "blocked" } })
+		}
+	`, projectID, appID, eventTrigger.Name, eventTrigger.Type, eventTrigger.FunctionID, *eventTrigger.Disabled, operationTypes,
+		eventTrigger.Config.Database, eventTrigger.Config.Collection, eventTrigger.Config.ServiceID)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -111,8 +112,8 @@ func TestAccEventTrigger_databaseNoCollection(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					checkExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
-					resource.TestCheckResourceAttr(resourceName, "config_database", event.Config.Database),
-					resource.TestCheckResourceAttr(resourceName, "config_collection", ""),
+					resource.TestCheckResourceAttr(resourceName, "database.database", event.Config.Database),
+					resource.TestCheckNoResourceAttr(resourceName, "database.collection"),
 				),
 			},
 			{
@@ -481,6 +482,51 @@ func TestAccEventTrigger_functionBasic(t *testing.T) {
 	})
 }
 
+func TestAccEventTrigger_invalidConfig(t *testing.T) {
+	var (
+		projectID = "664619d870c247237f4b86a6"
+		appID     = "6646255cd2d6fd9c0e3a1b2c"
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      configInvalidTrigger(projectID, appID, "SCHEDULED", ""),
+				ExpectError: regexp.MustCompile("scheduled block must be set if type is SCHEDULED"),
+			},
+			{
+				Config: configInvalidTrigger(projectID, appID, "SCHEDULED", `
+					authentication {
+						operation_type = "LOGIN"
+						providers      = ["anon-user"]
+					}
+					scheduled {
+						schedule = "0 8 * * *"
+					}`),
+				ExpectError: regexp.MustCompile("authentication block can't be set if type is SCHEDULED"),
+			},
+			{
+				Config: configInvalidTrigger(projectID, appID, "SCHEDULED", `
+					scheduled {
+						schedule = "0 25 * * *"
+					}`),
+				ExpectError: regexp.MustCompile("valid cron expression"),
+			},
+			{
+				Config: configInvalidTrigger(projectID, appID, "DATABASE", `
+					database {
+						operation_types = ["INSERT"]
+						database        = "sample_airbnb"
+						service_id      = "6646255cd2d6fd9c0e3a1b2d"
+						match           = jsonencode(["status"])
+					}`),
+				ExpectError: regexp.MustCompile("valid JSON object"),
+			},
+		},
+	})
+}
+
 func checkExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ctx := context.Background()
@@ -555,20 +601,22 @@ func configDatabaseTrigger(projectID, appID, operationTypes string, eventTrigger
 			type = %[4]q
 			function_id = %[5]q
 			disabled = %[6]t
-			config_operation_types = [%s]
-			config_database = %[8]q
-			config_collection = %[9]q
-			config_service_id = %[10]q
-			config_full_document = %[11]t
-			config_full_document_before = %[12]t
-			config_match = <<-EOF
-			{
-			  "updateDescription.updatedFields": {
-				"status": "blocked"
-			  }
+			database {
+				operation_types      = [%[7]s]
+				database             = %[8]q
+				collection           = %[9]q
+				service_id           = %[10]q
+				full_document        = %[11]t
+				full_document_before = %[12]t
+				match = <<-EOF
+				{
+				  "updateDescription.updatedFields": {
+					"status": "blocked"
+				  }
+				}
+				EOF
 			}
-			EOF		
-}
+		}
 	`, projectID, appID, eventTrigger.Name, eventTrigger.Type, eventTrigger.FunctionID, *eventTrigger.Disabled, operationTypes,
 		eventTrigger.Config.Database, eventTrigger.Config.Collection,
 		eventTrigger.Config.ServiceID, fullDoc, fullDocBefore)
@@ -583,10 +631,12 @@ func configDatabaseNoCollectionTrigger(projectID, appID, operationTypes string,
 			type = %[4]q
 			function_id = %[5]q
 			disabled = %[6]t
-			config_operation_types = [%[7]s]
-			config_database = %[8]q
-			config_service_id = %[9]q
-			config_full_document = false
+			database {
+				operation_types = [%[7]s]
+				database        = %[8]q
+				service_id      = %[9]q
+				full_document   = false
+			}
 		}
 	`, projectID, appID, eventTrigger.Name, eventTrigger.Type, eventTrigger.FunctionID, *eventTrigger.Disabled, operationTypes,
 		eventTrigger.Config.Database, eventTrigger.Config.ServiceID)
@@ -600,11 +650,13 @@ func configDatabaseEPTrigger(projectID, appID, operationTypes, awsAccID, awsRegi
 			name = %[3]q
 			type = %[4]q
 			disabled = %[5]t
-			config_operation_types = [%[6]s]
-			config_database = %[7]q
-			config_collection = %[8]q
-			config_service_id = %[9]q
-			config_match = "{\"updateDescription.updatedFields\":{\"status\":\"blocked\"}}"
+			database {
+				operation_types = [%[6]s]
+				database        = %[7]q
+				collection      = %[8]q
+				service_id      = %[9]q
+				match           = jsonencode({ "updateDescription.updatedFields" = { status = "blocked" } })
+			}
 			event_processors{
 				aws_eventbridge{
 					config_account_id = %[10]q
@@ -627,8 +679,10 @@ func configAuthenticationTrigger(projectID, appID, providers string, eventTrigge
 			type = %[4]q
 			function_id = %[5]q
 			disabled = %[6]t
-			config_operation_type = %[7]q
-			config_providers = [%[8]s]
+			authentication {
+				operation_type = %[7]q
+				providers      = [%[8]s]
+			}
 		}
 	`, projectID, appID, eventTrigger.Name, eventTrigger.Type, eventTrigger.FunctionID, *eventTrigger.Disabled,
 		eventTrigger.Config.OperationType, providers)
@@ -642,8 +696,10 @@ func configAuthenticationEPTrigger(projectID, appID, providers, awsAccID, awsReg
 			name = %[3]q
 			type = %[4]q
 			disabled = %[5]t
-			config_operation_type = %[6]q
-			config_providers = [%[7]s]
+			authentication {
+				operation_type = %[6]q
+				providers      = [%[7]s]
+			}
 			event_processors{
 				aws_eventbridge{
 					config_account_id = %[8]q
@@ -664,7 +720,9 @@ func configScheduleTrigger(projectID, appID string, eventTrigger *realm.EventTri
 			type = %[4]q
 			function_id = %[5]q
 			disabled = %[6]t
-			config_schedule = %[7]q
+			scheduled {
+				schedule = %[7]q
+			}
 		}
 	`, projectID, appID, eventTrigger.Name, eventTrigger.Type, eventTrigger.FunctionID, *eventTrigger.Disabled,
 		eventTrigger.Config.Schedule)
@@ -678,7 +736,9 @@ func configScheduleEPTrigger(projectID, appID, awsAccID, awsRegion string, event
 			name = %[3]q
 			type = %[4]q
 			disabled = %[5]t
-			config_schedule = %[6]q
+			scheduled {
+				schedule = %[6]q
+			}
 			event_processors{
 				aws_eventbridge{
 					config_account_id = %[7]q
@@ -689,3 +749,16 @@ func configScheduleEPTrigger(projectID, appID, awsAccID, awsRegion string, event
 	`, projectID, appID, eventTrigger.Name, eventTrigger.Type, *eventTrigger.Disabled, eventTrigger.Config.Schedule,
 		awsAccID, awsRegion)
 }
+
+func configInvalidTrigger(projectID, appID, triggerType, blocks string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_event_trigger" "test" {
+			project_id  = %[1]q
+			app_id      = %[2]q
+			name        = "invalid"
+			type        = %[3]q
+			function_id = "6646255cd2d6fd9c0e3a1b2e"
+			%[4]s
+		}
+	`, projectID, appID, triggerType, blocks)
+}
@@ -0,0 +1,306 @@
+package eventtrigger
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+const (
+	TypeDatabase       = "DATABASE"
+	TypeAuthentication = "AUTHENTICATION"
+	TypeScheduled      = "SCHEDULED"
+)
+
+var (
+	databaseOperationTypes = []string{"INSERT", "UPDATE", "REPLACE", "DELETE"}
+	authOperationTypes     = []string{"LOGIN", "CREATE", "DELETE"}
+	authProviders          = []string{"anon-user", "local-userpass", "api-key", "custom-token", "custom-function", "oauth2-facebook", "oauth2-google", "oauth2-apple"}
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		// Version 0 is the SDKv2 schema with flat config_* attributes.
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Terraform's unique identifier used internally for state management.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique ID for the project to create the trigger.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ObjectID of your application.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the trigger.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the trigger.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of the trigger. Possible values are `DATABASE`, `AUTHENTICATION` and `SCHEDULED`. The block with the same name in lowercase must be set with the configuration of the trigger.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(TypeDatabase, TypeAuthentication, TypeScheduled),
+				},
+			},
+			"function_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the function associated with the trigger. Can't be used with `event_processors`.",
+			},
+			"function_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the function associated with the trigger.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Flag that indicates whether the trigger is disabled. Defaults to `false`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"database": schema.SingleNestedBlock{
+				MarkdownDescription: "Configuration of a `DATABASE` trigger.",
+				Attributes: map[string]schema.Attribute{
+					"operation_types": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Required:            true,
+						MarkdownDescription: "The database event operation types to listen for. Possible values are `INSERT`, `UPDATE`, `REPLACE` and `DELETE`.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(databaseOperationTypes...)),
+						},
+					},
+					"database": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The name of the MongoDB database to watch.",
+					},
+					"collection": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The name of the MongoDB collection that the trigger watches for change events. All the collections of the database are watched if not set.",
+					},
+					"service_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The ID of the MongoDB Service associated with the trigger.",
+					},
+					"match": schema.StringAttribute{
+						CustomType:          fwtypes.JSONStringType,
+						Optional:            true,
+						MarkdownDescription: "A JSON object of a [$match](https://www.mongodb.com/docs/manual/reference/operator/aggregation/match) expression to filter the change events. Using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) is recommended when setting this attribute.",
+						Validators: []validator.String{
+							validate.StringIsJSONObject(),
+						},
+					},
+					"project": schema.StringAttribute{
+						CustomType:          fwtypes.JSONStringType,
+						Optional:            true,
+						MarkdownDescription: "A JSON object of a [$project](https://www.mongodb.com/docs/manual/reference/operator/aggregation/project) expression to limit the fields of the change events. Using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) is recommended when setting this attribute.",
+						Validators: []validator.String{
+							validate.StringIsJSONObject(),
+						},
+					},
+					"full_document": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "If true, `UPDATE` change events include the latest committed version of the modified document in the `fullDocument` field.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"full_document_before": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "If true, change events include a copy of the modified document from immediately before the change was applied in the `fullDocumentBeforeChange` field.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"unordered": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "If true, event ordering is disabled and the trigger can process events in parallel.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"authentication": schema.SingleNestedBlock{
+				MarkdownDescription: "Configuration of an `AUTHENTICATION` trigger.",
+				Attributes: map[string]schema.Attribute{
+					"operation_type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The authentication operation type to listen for. Possible values are `LOGIN`, `CREATE` and `DELETE`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(authOperationTypes...),
+						},
+					},
+					"providers": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Required:            true,
+						MarkdownDescription: "A list of one or more authentication provider IDs to listen for. Possible values are `anon-user`, `local-userpass`, `api-key`, `custom-token`, `custom-function`, `oauth2-facebook`, `oauth2-google` and `oauth2-apple`.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(authProviders...)),
+						},
+					},
+				},
+			},
+			"scheduled": schema.SingleNestedBlock{
+				MarkdownDescription: "Configuration of a `SCHEDULED` trigger.",
+				Attributes: map[string]schema.Attribute{
+					"schedule": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "A [cron expression](https://www.mongodb.com/docs/atlas/app-services/triggers/scheduled-triggers/#cron-expressions) with 5 fields that defines when the trigger executes.",
+						Validators: []validator.String{
+							validate.ValidCron(),
+						},
+					},
+					"schedule_type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The type of the schedule.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"event_processors": schema.ListNestedBlock{
+				MarkdownDescription: "An object where each field name is an event processor ID and each value is an object that configures its corresponding event processor. Can't be used with `function_id`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_eventbridge": schema.ListNestedBlock{
+							MarkdownDescription: "Configuration of the AWS EventBridge event processor.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"config_account_id": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "AWS Account ID.",
+									},
+									"config_region": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "Region of AWS Account.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TFEventTriggerModel struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	AppID           types.String `tfsdk:"app_id"`
+	TriggerID       types.String `tfsdk:"trigger_id"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	FunctionID      types.String `tfsdk:"function_id"`
+	FunctionName    types.String `tfsdk:"function_name"`
+	Disabled        types.Bool   `tfsdk:"disabled"`
+	Database        types.Object `tfsdk:"database"`
+	Authentication  types.Object `tfsdk:"authentication"`
+	Scheduled       types.Object `tfsdk:"scheduled"`
+	EventProcessors types.List   `tfsdk:"event_processors"`
+}
+
+type TFDatabaseModel struct {
+	OperationTypes     types.List         `tfsdk:"operation_types"`
+	Database           types.String       `tfsdk:"database"`
+	Collection         types.String       `tfsdk:"collection"`
+	ServiceID          types.String       `tfsdk:"service_id"`
+	Match              fwtypes.JSONString `tfsdk:"match"`
+	Project            fwtypes.JSONString `tfsdk:"project"`
+	FullDocument       types.Bool         `tfsdk:"full_document"`
+	FullDocumentBefore types.Bool         `tfsdk:"full_document_before"`
+	Unordered          types.Bool         `tfsdk:"unordered"`
+}
+
+var DatabaseObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"operation_types":      types.ListType{ElemType: types.StringType},
+	"database":             types.StringType,
+	"collection":           types.StringType,
+	"service_id":           types.StringType,
+	"match":                fwtypes.JSONStringType,
+	"project":              fwtypes.JSONStringType,
+	"full_document":        types.BoolType,
+	"full_document_before": types.BoolType,
+	"unordered":            types.BoolType,
+}}
+
+type TFAuthenticationModel struct {
+	OperationType types.String `tfsdk:"operation_type"`
+	Providers     types.List   `tfsdk:"providers"`
+}
+
+var AuthenticationObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"operation_type": types.StringType,
+	"providers":      types.ListType{ElemType: types.StringType},
+}}
+
+type TFScheduledModel struct {
+	Schedule     types.String `tfsdk:"schedule"`
+	ScheduleType types.String `tfsdk:"schedule_type"`
+}
+
+var ScheduledObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"schedule":      types.StringType,
+	"schedule_type": types.StringType,
+}}
+
+type TFEventProcessorsModel struct {
+	AWSEventBridge types.List `tfsdk:"aws_eventbridge"`
+}
+
+var EventProcessorsObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"aws_eventbridge": types.ListType{ElemType: AWSEventBridgeObjectType},
+}}
+
+type TFAWSEventBridgeModel struct {
+	ConfigAccountID types.String `tfsdk:"config_account_id"`
+	ConfigRegion    types.String `tfsdk:"config_region"`
+}
+
+var AWSEventBridgeObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"config_account_id": types.StringType,
+	"config_region":     types.StringType,
+}}
@@ -0,0 +1,174 @@
+package eventtrigger
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+)
+
+// UpgradeState is used to upgrade from the flat config_* attributes of schema v0 (SDKv2) to the typed blocks of v1 (TPF)
+func (r *eventTriggerRS) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := resourceSchemaV0()
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &priorSchema,
+			StateUpgrader: stateUpgraderFromV0,
+		},
+	}
+}
+
+func stateUpgraderFromV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var stateV0 TFEventTriggerModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	trigger, diags := NewTFEventTriggerFromV0(ctx, &stateV0)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, trigger)...)
+}
+
+// NewTFEventTriggerFromV0 moves the config_* attributes to the block of the trigger type, the attributes of other trigger types are dropped.
+func NewTFEventTriggerFromV0(ctx context.Context, stateV0 *TFEventTriggerModelV0) (*TFEventTriggerModel, diag.Diagnostics) {
+	var diags, localDiags diag.Diagnostics
+	functionID := stateV0.FunctionID
+	if functionID.ValueString() == "" {
+		functionID = types.StringNull()
+	}
+	trigger := &TFEventTriggerModel{
+		ID:              stateV0.ID,
+		ProjectID:       stateV0.ProjectID,
+		AppID:           stateV0.AppID,
+		TriggerID:       stateV0.TriggerID,
+		Name:            stateV0.Name,
+		Type:            stateV0.Type,
+		FunctionID:      functionID,
+		FunctionName:    stateV0.FunctionName,
+		Disabled:        types.BoolValue(stateV0.Disabled.ValueBool()),
+		Database:        types.ObjectNull(DatabaseObjectType.AttrTypes),
+		Authentication:  types.ObjectNull(AuthenticationObjectType.AttrTypes),
+		Scheduled:       types.ObjectNull(ScheduledObjectType.AttrTypes),
+		EventProcessors: stateV0.EventProcessors,
+	}
+	switch stateV0.Type.ValueString() {
+	case TypeDatabase:
+		collection := stateV0.ConfigCollection
+		if collection.ValueString() == "" {
+			collection = types.StringNull()
+		}
+		trigger.Database, localDiags = types.ObjectValueFrom(ctx, DatabaseObjectType.AttrTypes, TFDatabaseModel{
+			OperationTypes:     stateV0.ConfigOperationTypes,
+			Database:           stateV0.ConfigDatabase,
+			Collection:         collection,
+			ServiceID:          stateV0.ConfigServiceID,
+			Match:              jsonObjectFromV0(stateV0.ConfigMatch),
+			Project:            jsonObjectFromV0(stateV0.ConfigProject),
+			FullDocument:       types.BoolValue(stateV0.ConfigFullDocument.ValueBool()),
+			FullDocumentBefore: types.BoolValue(stateV0.ConfigFullDocumentBefore.ValueBool()),
+			Unordered:          types.BoolValue(stateV0.Unordered.ValueBool()),
+		})
+	case TypeAuthentication:
+		trigger.Authentication, localDiags = types.ObjectValueFrom(ctx, AuthenticationObjectType.AttrTypes, TFAuthenticationModel{
+			OperationType: stateV0.ConfigOperationType,
+			Providers:     stateV0.ConfigProviders,
+		})
+	case TypeScheduled:
+		trigger.Scheduled, localDiags = types.ObjectValueFrom(ctx, ScheduledObjectType.AttrTypes, TFScheduledModel{
+			Schedule:     stateV0.ConfigSchedule,
+			ScheduleType: stateV0.ConfigScheduleType,
+		})
+	}
+	diags.Append(localDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return trigger, diags
+}
+
+// jsonObjectFromV0 converts the config_match and config_project of v0, where the API not returning them was stored as "null".
+func jsonObjectFromV0(value types.String) fwtypes.JSONString {
+	switch value.ValueString() {
+	case "", "null", "{}":
+		return fwtypes.JSONStringNull()
+	}
+	return fwtypes.JSONStringValue(value.ValueString())
+}
+
+type TFEventTriggerModelV0 struct {
+	ID                       types.String `tfsdk:"id"`
+	ProjectID                types.String `tfsdk:"project_id"`
+	AppID                    types.String `tfsdk:"app_id"`
+	Name                     types.String `tfsdk:"name"`
+	Type                     types.String `tfsdk:"type"`
+	FunctionID               types.String `tfsdk:"function_id"`
+	FunctionName             types.String `tfsdk:"function_name"`
+	Disabled                 types.Bool   `tfsdk:"disabled"`
+	ConfigOperationTypes     types.List   `tfsdk:"config_operation_types"`
+	ConfigOperationType      types.String `tfsdk:"config_operation_type"`
+	ConfigProviders          types.List   `tfsdk:"config_providers"`
+	ConfigDatabase           types.String `tfsdk:"config_database"`
+	ConfigCollection         types.String `tfsdk:"config_collection"`
+	ConfigServiceID          types.String `tfsdk:"config_service_id"`
+	ConfigMatch              types.String `tfsdk:"config_match"`
+	ConfigProject            types.String `tfsdk:"config_project"`
+	ConfigFullDocument       types.Bool   `tfsdk:"config_full_document"`
+	ConfigFullDocumentBefore types.Bool   `tfsdk:"config_full_document_before"`
+	ConfigSchedule           types.String `tfsdk:"config_schedule"`
+	ConfigScheduleType       types.String `tfsdk:"config_schedule_type"`
+	EventProcessors          types.List   `tfsdk:"event_processors"`
+	TriggerID                types.String `tfsdk:"trigger_id"`
+	Unordered                types.Bool   `tfsdk:"unordered"`
+}
+
+// resourceSchemaV0 is the SDKv2 schema, it's only used to read the state to upgrade.
+func resourceSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                          schema.StringAttribute{Computed: true},
+			"project_id":                  schema.StringAttribute{Required: true},
+			"app_id":                      schema.StringAttribute{Required: true},
+			"name":                        schema.StringAttribute{Required: true},
+			"type":                        schema.StringAttribute{Required: true},
+			"function_id":                 schema.StringAttribute{Optional: true},
+			"function_name":               schema.StringAttribute{Computed: true},
+			"disabled":                    schema.BoolAttribute{Optional: true, Computed: true},
+			"config_operation_types":      schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"config_operation_type":       schema.StringAttribute{Optional: true, Computed: true},
+			"config_providers":            schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"config_database":             schema.StringAttribute{Optional: true, Computed: true},
+			"config_collection":           schema.StringAttribute{Optional: true, Computed: true},
+			"config_service_id":           schema.StringAttribute{Optional: true, Computed: true},
+			"config_match":                schema.StringAttribute{Optional: true, Computed: true},
+			"config_project":              schema.StringAttribute{Optional: true, Computed: true},
+			"config_full_document":        schema.BoolAttribute{Optional: true, Computed: true},
+			"config_full_document_before": schema.BoolAttribute{Optional: true, Computed: true},
+			"config_schedule":             schema.StringAttribute{Optional: true, Computed: true},
+			"config_schedule_type":        schema.StringAttribute{Computed: true},
+			"trigger_id":                  schema.StringAttribute{Computed: true},
+			"unordered":                   schema.BoolAttribute{Optional: true, Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"event_processors": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_eventbridge": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"config_account_id": schema.StringAttribute{Optional: true},
+									"config_region":     schema.StringAttribute{Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -71,6 +71,8 @@ func DataSource() *schema.Resource {
 								},
 							},
 						},
+						"azure": dataSourceAzureCloudProviderConfigSchema(),
+						"gcp":   dataSourceGCPCloudProviderConfigSchema(),
 					},
 				},
 			},
@@ -268,6 +270,70 @@ func schemaFederatedDatabaseInstanceStoresDataSource() *schema.Schema {
 						Type: schema.TypeString,
 					},
 				},
+				"azure": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"service_url": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"prefix": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"delimiter": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"replacement_delimiter": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"public": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"gcs": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"prefix": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"delimiter": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"public": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
 				"read_preference": {
 					Type:     schema.TypeList,
 					Computed: true,
@@ -313,6 +379,52 @@ func schemaFederatedDatabaseInstanceStoresDataSource() *schema.Schema {
 	}
 }
 
+func dataSourceAzureCloudProviderConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"atlas_app_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"service_principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGCPCloudProviderConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"gcp_service_account": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func dataSourceMongoDBAtlasFederatedDatabaseInstanceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	connV2 := meta.(*config.MongoDBClient).AtlasV2
 
@@ -83,6 +83,8 @@ func PluralDataSource() *schema.Resource {
 											},
 										},
 									},
+									"azure": dataSourceAzureCloudProviderConfigSchema(),
+									"gcp":   dataSourceGCPCloudProviderConfigSchema(),
 								},
 							},
 						},
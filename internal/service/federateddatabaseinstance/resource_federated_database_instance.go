@@ -1,6 +1,7 @@
 package federateddatabaseinstance
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/schemafunc"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
 
@@ -21,8 +24,14 @@ const (
 	errorFederatedDatabaseInstanceDelete  = "error deleting MongoDB Atlas Federated Database Instace (%s): %s"
 	errorFederatedDatabaseInstanceUpdate  = "error updating MongoDB Atlas Federated Database Instace (%s): %s"
 	errorFederatedDatabaseInstanceSetting = "error setting `%s` for MongoDB Atlas Federated Database Instace (%s): %s"
+	errorFederatedDatabaseInstanceStore   = "error in storage_stores `%s`: %s"
+	providerAzure                         = "azure"
+	providerGCS                           = "gcs"
 )
 
+// cloudProviderConfigProviders are the cloud providers of cloud_provider_config, the roles are created with mongodbatlas_cloud_provider_access_setup.
+var cloudProviderConfigProviders = []string{"cloud_provider_config.0.aws", "cloud_provider_config.0.azure", "cloud_provider_config.0.gcp"}
+
 func Resource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCreate,
@@ -32,6 +41,7 @@ func Resource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImport,
 		},
+		CustomizeDiff: resourceCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -61,9 +71,10 @@ func Resource() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"aws": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Required: true,
+							Type:         schema.TypeList,
+							MaxItems:     1,
+							Optional:     true,
+							ExactlyOneOf: cloudProviderConfigProviders,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"role_id": {
@@ -89,6 +100,50 @@ func Resource() *schema.Resource {
 								},
 							},
 						},
+						"azure": {
+							Type:         schema.TypeList,
+							MaxItems:     1,
+							Optional:     true,
+							ExactlyOneOf: cloudProviderConfigProviders,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"atlas_app_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service_principal_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tenant_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"gcp": {
+							Type:         schema.TypeList,
+							MaxItems:     1,
+							Optional:     true,
+							ExactlyOneOf: cloudProviderConfigProviders,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"gcp_service_account": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -121,6 +176,7 @@ func schemaFederatedDatabaseInstanceDatabases() *schema.Schema {
 		Type:     schema.TypeSet,
 		Computed: true,
 		Optional: true,
+		Set:      hashByName,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
@@ -132,6 +188,7 @@ func schemaFederatedDatabaseInstanceDatabases() *schema.Schema {
 					Type:     schema.TypeSet,
 					Computed: true,
 					Optional: true,
+					Set:      hashByName,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"name": {
@@ -141,7 +198,9 @@ func schemaFederatedDatabaseInstanceDatabases() *schema.Schema {
 							},
 							"data_sources": {
 								Type:     schema.TypeSet,
+								Computed: true,
 								Optional: true,
+								Set:      hashDataSource,
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"store_name": {
@@ -212,19 +271,22 @@ func schemaFederatedDatabaseInstanceDatabases() *schema.Schema {
 					Type:     schema.TypeSet,
 					Computed: true,
 					Optional: true,
+					Set:      hashByName,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"name": {
 								Type:     schema.TypeString,
-								Computed: true,
+								Required: true,
 							},
 							"source": {
 								Type:     schema.TypeString,
-								Computed: true,
+								Required: true,
 							},
 							"pipeline": {
-								Type:     schema.TypeString,
-								Computed: true,
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validate.StringIsAggregationPipeline(),
+								DiffSuppressFunc: diffSuppressJSON,
 							},
 						},
 					},
@@ -319,6 +381,72 @@ func schemaFederatedDatabaseInstanceStores() *schema.Schema {
 						Type: schema.TypeString,
 					},
 				},
+				"azure": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"service_url": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"prefix": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"delimiter": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"replacement_delimiter": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"public": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"gcs": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"prefix": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"delimiter": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"public": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
 				"read_preference": {
 					Type:     schema.TypeList,
 					MaxItems: 1,
@@ -426,6 +554,14 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		}
 	}
 
+	if storage, ok := dataFederationInstance.GetStorageOk(); ok {
+		if databases, ok := storage.GetDatabasesOk(); ok {
+			if err := d.Set("storage_databases", flattenDataFederationDatabase(*databases)); err != nil {
+				return diag.FromErr(fmt.Errorf(errorFederatedDatabaseInstanceSetting, "storage_databases", name, err))
+			}
+		}
+	}
+
 	if err := d.Set("state", dataFederationInstance.GetState()); err != nil {
 		return diag.FromErr(fmt.Errorf(errorFederatedDatabaseInstanceSetting, "state", name, err))
 	}
@@ -587,10 +723,35 @@ func newStores(d *schema.ResourceData) *[]admin.DataLakeStoreSettings {
 			AdditionalStorageClasses: newAdditionalStorageClasses(storeFromConfMap["additional_storage_classes"].([]any)),
 			ReadPreference:           newReadPreference(storeFromConfMap),
 		}
+		setProviderStoreSettings(&stores[i], storeFromConfMap)
 	}
 	return &stores
 }
 
+// setProviderStoreSettings sets the settings of the azure and gcs blocks, resourceCustomizeDiff checks they are only used with the store provider of the same name.
+func setProviderStoreSettings(store *admin.DataLakeStoreSettings, storeFromConfMap map[string]any) {
+	azure, _ := storeFromConfMap["azure"].([]any)
+	gcs, _ := storeFromConfMap["gcs"].([]any)
+	switch {
+	case len(azure) == 1 && azure[0] != nil:
+		azureMap := azure[0].(map[string]any)
+		store.ServiceURL = conversion.StringPtr(azureMap["service_url"].(string))
+		store.ContainerName = conversion.StringPtr(azureMap["container_name"].(string))
+		store.Region = conversion.StringPtr(azureMap["region"].(string))
+		store.Prefix = conversion.StringPtr(azureMap["prefix"].(string))
+		store.Delimiter = conversion.StringPtr(azureMap["delimiter"].(string))
+		store.ReplacementDelimiter = conversion.StringPtr(azureMap["replacement_delimiter"].(string))
+		store.Public = conversion.Pointer(azureMap["public"].(bool))
+	case len(gcs) == 1 && gcs[0] != nil:
+		gcsMap := gcs[0].(map[string]any)
+		store.Bucket = conversion.StringPtr(gcsMap["bucket"].(string))
+		store.Region = conversion.StringPtr(gcsMap["region"].(string))
+		store.Prefix = conversion.StringPtr(gcsMap["prefix"].(string))
+		store.Delimiter = conversion.StringPtr(gcsMap["delimiter"].(string))
+		store.Public = conversion.Pointer(gcsMap["public"].(bool))
+	}
+}
+
 func newAdditionalStorageClasses(additionalStorageClassesFromConfig []any) *[]string {
 	if len(additionalStorageClassesFromConfig) == 0 {
 		return new([]string)
@@ -649,6 +810,7 @@ func newDataFederationDatabase(d *schema.ResourceData) *[]admin.DataLakeDatabase
 			Name:                   conversion.StringPtr(storageDBFromConfMap["name"].(string)),
 			MaxWildcardCollections: conversion.IntPtr(storageDBFromConfMap["max_wildcard_collections"].(int)),
 			Collections:            newDataFederationCollections(storageDBFromConfMap),
+			Views:                  newDataFederationViews(storageDBFromConfMap),
 		}
 	}
 	return &dbs
@@ -669,6 +831,23 @@ func newDataFederationCollections(storageDBFromConfMap map[string]any) *[]admin.
 	return &collections
 }
 
+func newDataFederationViews(storageDBFromConfMap map[string]any) *[]admin.DataLakeApiBase {
+	viewsFromConf := storageDBFromConfMap["views"].(*schema.Set).List()
+	if len(viewsFromConf) == 0 {
+		return new([]admin.DataLakeApiBase)
+	}
+	views := make([]admin.DataLakeApiBase, len(viewsFromConf))
+	for i, viewFromConf := range viewsFromConf {
+		viewFromConfMap := viewFromConf.(map[string]any)
+		views[i] = admin.DataLakeApiBase{
+			Name:     conversion.StringPtr(viewFromConfMap["name"].(string)),
+			Source:   conversion.StringPtr(viewFromConfMap["source"].(string)),
+			Pipeline: conversion.StringPtr(viewFromConfMap["pipeline"].(string)),
+		}
+	}
+	return &views
+}
+
 func newDataFederationDataSource(collectionFromConf map[string]any) *[]admin.DataLakeDatabaseDataSourceSettings {
 	dataSourcesFromConf := collectionFromConf["data_sources"].(*schema.Set).List()
 	if len(dataSourcesFromConf) == 0 {
@@ -706,9 +885,11 @@ func newUrls(urlsFromConfig []any) *[]string {
 }
 
 func newCloudProviderConfig(d *schema.ResourceData) *admin.DataLakeCloudProviderConfig {
-	if cloudProvider, ok := d.Get("cloud_provider_config").([]any); ok && len(cloudProvider) == 1 {
+	if cloudProvider, ok := d.Get("cloud_provider_config").([]any); ok && len(cloudProvider) == 1 && cloudProvider[0] != nil {
 		return &admin.DataLakeCloudProviderConfig{
-			Aws: newAWSConfig(cloudProvider),
+			Aws:   newAWSConfig(cloudProvider),
+			Azure: newAzureConfig(cloudProvider),
+			Gcp:   newGCPConfig(cloudProvider),
 		}
 	}
 
@@ -724,6 +905,22 @@ func newAWSConfig(cloudProvider []any) *admin.DataLakeAWSCloudProviderConfig {
 	return nil
 }
 
+func newAzureConfig(cloudProvider []any) *admin.DataFederationAzureCloudProviderConfig {
+	if azure, ok := cloudProvider[0].(map[string]any)["azure"].([]any); ok && len(azure) == 1 {
+		return admin.NewDataFederationAzureCloudProviderConfig(azure[0].(map[string]any)["role_id"].(string))
+	}
+
+	return nil
+}
+
+func newGCPConfig(cloudProvider []any) *admin.DataFederationGCPCloudProviderConfig {
+	if gcp, ok := cloudProvider[0].(map[string]any)["gcp"].([]any); ok && len(gcp) == 1 {
+		return admin.NewDataFederationGCPCloudProviderConfig(gcp[0].(map[string]any)["role_id"].(string))
+	}
+
+	return nil
+}
+
 func newDataProcessRegion(d *schema.ResourceData) *admin.DataLakeDataProcessRegion {
 	if dataProcessRegion, ok := d.Get("data_process_region").([]any); ok && len(dataProcessRegion) == 1 {
 		return &admin.DataLakeDataProcessRegion{
@@ -740,38 +937,43 @@ func flattenCloudProviderConfig(d *schema.ResourceData, cloudProviderConfig *adm
 		return nil
 	}
 
-	aws := cloudProviderConfig.GetAws()
+	cloudProviderConfigOut := map[string]any{}
 
-	awsOut := []map[string]any{
-		{
+	if aws, ok := cloudProviderConfig.GetAwsOk(); ok {
+		awsOut := map[string]any{
 			"role_id":              aws.GetRoleId(),
 			"iam_assumed_role_arn": aws.GetIamAssumedRoleARN(),
 			"iam_user_arn":         aws.GetIamUserARN(),
 			"external_id":          aws.GetExternalId(),
-		},
+		}
+		// test_s3_bucket is not part of the API response
+		if testS3Bucket, ok := d.Get("cloud_provider_config.0.aws.0.test_s3_bucket").(string); ok {
+			awsOut["test_s3_bucket"] = testS3Bucket
+		}
+		cloudProviderConfigOut["aws"] = []map[string]any{awsOut}
 	}
 
-	currentCloudProviderConfig, ok := d.Get("cloud_provider_config").([]any)
-	if !ok || len(currentCloudProviderConfig) == 0 {
-		return []map[string]any{
+	if azure, ok := cloudProviderConfig.GetAzureOk(); ok {
+		cloudProviderConfigOut["azure"] = []map[string]any{
 			{
-				"aws": &awsOut,
+				"role_id":              azure.GetRoleId(),
+				"atlas_app_id":         azure.GetAtlasAppId(),
+				"service_principal_id": azure.GetServicePrincipalId(),
+				"tenant_id":            azure.GetTenantId(),
 			},
 		}
 	}
-	// test_s3_bucket is not part of the API response
-	if currentAWS, ok := currentCloudProviderConfig[0].(map[string]any)["aws"].([]any); ok {
-		if testS3Bucket, ok := currentAWS[0].(map[string]any)["test_s3_bucket"].(string); ok {
-			awsOut[0]["test_s3_bucket"] = testS3Bucket
-			return []map[string]any{
-				{
-					"aws": &awsOut,
-				},
-			}
+
+	if gcp, ok := cloudProviderConfig.GetGcpOk(); ok {
+		cloudProviderConfigOut["gcp"] = []map[string]any{
+			{
+				"role_id":             gcp.GetRoleId(),
+				"gcp_service_account": gcp.GetGcpServiceAccount(),
+			},
 		}
 	}
 
-	return awsOut
+	return []map[string]any{cloudProviderConfigOut}
 }
 
 func flattenDataProcessRegion(processRegion *admin.DataLakeDataProcessRegion) []map[string]any {
@@ -855,6 +1057,41 @@ func flattenDataFederationStores(stores []admin.DataLakeStoreSettings) []map[str
 	store := make([]map[string]any, 0)
 
 	for i := range stores {
+		// the settings of azure and gcs stores are only set in their typed block so they match the configuration
+		switch stores[i].GetProvider() {
+		case providerAzure:
+			store = append(store, map[string]any{
+				"name":     stores[i].GetName(),
+				"provider": stores[i].GetProvider(),
+				"azure": []map[string]any{
+					{
+						"service_url":           stores[i].GetServiceURL(),
+						"container_name":        stores[i].GetContainerName(),
+						"region":                stores[i].GetRegion(),
+						"prefix":                stores[i].GetPrefix(),
+						"delimiter":             stores[i].GetDelimiter(),
+						"replacement_delimiter": stores[i].GetReplacementDelimiter(),
+						"public":                stores[i].GetPublic(),
+					},
+				},
+			})
+			continue
+		case providerGCS:
+			store = append(store, map[string]any{
+				"name":     stores[i].GetName(),
+				"provider": stores[i].GetProvider(),
+				"gcs": []map[string]any{
+					{
+						"bucket":    stores[i].GetBucket(),
+						"region":    stores[i].GetRegion(),
+						"prefix":    stores[i].GetPrefix(),
+						"delimiter": stores[i].GetDelimiter(),
+						"public":    stores[i].GetPublic(),
+					},
+				},
+			})
+			continue
+		}
 		store = append(store, map[string]any{
 			"name":                       stores[i].GetName(),
 			"provider":                   stores[i].GetProvider(),
@@ -916,6 +1153,90 @@ func flattenReadPreferenceTags(tags []admin.DataLakeAtlasStoreReadPreferenceTag)
 	return tfTags
 }
 
+// resourceCustomizeDiff checks at plan time that the azure and gcs blocks of storage_stores match the store provider
+// and that every data source of storage_databases references a store declared in storage_stores.
+func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("storage_stores") {
+		return nil
+	}
+	stores := d.Get("storage_stores").(*schema.Set).List()
+	if err := validateStoreProviderBlocks(stores); err != nil {
+		return err
+	}
+	if !d.NewValueKnown("storage_databases") {
+		return nil
+	}
+	storeNames := make(map[string]bool)
+	for _, store := range stores {
+		storeName := store.(map[string]any)["name"].(string)
+		if storeName == "" { // unknown until apply
+			return nil
+		}
+		storeNames[storeName] = true
+	}
+	return validateDataSourceStoreNames(d.Get("storage_databases").(*schema.Set).List(), storeNames)
+}
+
+// validateStoreProviderBlocks returns an error for the first store with an azure or gcs block that doesn't match its provider.
+func validateStoreProviderBlocks(stores []any) error {
+	for _, store := range stores {
+		storeMap := store.(map[string]any)
+		azure, _ := storeMap["azure"].([]any)
+		gcs, _ := storeMap["gcs"].([]any)
+		provider := storeMap["provider"].(string)
+		if provider == "" { // unknown until apply
+			continue
+		}
+		switch {
+		case len(azure) == 1 && provider != providerAzure:
+			return fmt.Errorf(errorFederatedDatabaseInstanceStore, storeMap["name"], fmt.Sprintf("azure can only be used with provider %s", providerAzure))
+		case len(gcs) == 1 && provider != providerGCS:
+			return fmt.Errorf(errorFederatedDatabaseInstanceStore, storeMap["name"], fmt.Sprintf("gcs can only be used with provider %s", providerGCS))
+		}
+	}
+	return nil
+}
+
+// validateDataSourceStoreNames returns an error for the first data source whose store_name isn't in storeNames.
+func validateDataSourceStoreNames(databases []any, storeNames map[string]bool) error {
+	for _, database := range databases {
+		databaseMap := database.(map[string]any)
+		for _, collection := range databaseMap["collections"].(*schema.Set).List() {
+			collectionMap := collection.(map[string]any)
+			for _, dataSource := range collectionMap["data_sources"].(*schema.Set).List() {
+				storeName := dataSource.(map[string]any)["store_name"].(string)
+				if storeName != "" && !storeNames[storeName] {
+					return fmt.Errorf("storage_databases `%s` collection `%s` references store_name `%s` that is not declared in storage_stores",
+						databaseMap["name"], collectionMap["name"], storeName)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hashByName keys storage_databases, collections and views by name so changes in the API response order or in computed attributes don't cause diffs.
+func hashByName(v any) int {
+	return schema.HashString(v.(map[string]any)["name"].(string))
+}
+
+// hashDataSource keys data_sources by the attributes that identify where the data is read from.
+func hashDataSource(v any) int {
+	var buf bytes.Buffer
+	m := v.(map[string]any)
+	for _, key := range []string{"store_name", "dataset_name", "path", "database", "database_regex", "collection", "collection_regex"} {
+		buf.WriteString(fmt.Sprintf("%s;", m[key]))
+	}
+	if urls, ok := m["urls"].([]any); ok {
+		buf.WriteString(fmt.Sprintf("%v", urls))
+	}
+	return schema.HashString(buf.String())
+}
+
+func diffSuppressJSON(k, old, newStr string, d *schema.ResourceData) bool {
+	return schemafunc.EqualJSON(old, newStr, "view pipeline")
+}
+
 func splitDataFederatedInstanceImportID(id string) (projectID, name, s3Bucket string, err error) {
 	var parts = strings.Split(id, "--")
 
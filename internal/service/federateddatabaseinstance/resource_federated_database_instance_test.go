@@ -3,6 +3,7 @@ package federateddatabaseinstance_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -52,8 +53,16 @@ func TestAccFederatedDatabaseInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "storage_stores.0.read_preference.0.tag_sets.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "storage_stores.0.read_preference.0.tag_sets.0.tags.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "storage_databases.0.collections.0.data_sources.0.database_regex", ".sample_airbnb"),
+					resource.TestCheckResourceAttr(resourceName, "storage_databases.0.views.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_databases.0.views.0.name", "VirtualView0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_databases.0.views.0.source", "VirtualCollection0"),
 				),
 			},
+			{
+				// storage_databases is read from the API, the defaults it fills in must not cause a diff
+				Config:   configFirstStepsUpdate(name, projectName, orgID),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:      resourceName,
 				ImportStateIdFunc: importStateIDFunc(resourceName),
@@ -101,6 +110,152 @@ func TestAccFederatedDatabaseInstance_s3bucket(t *testing.T) {
 	})
 }
 
+func TestAccFederatedDatabaseInstance_azure(t *testing.T) {
+	acc.SkipTestForCI(t) // needs an Azure storage account the Atlas service principal has access to
+
+	var (
+		resourceName       = "mongodbatlas_federated_database_instance.test"
+		dataSourceName     = "data.mongodbatlas_federated_database_instance.test"
+		projectID          = acc.ProjectIDExecution(t)
+		name               = acc.RandomName()
+		atlasAzureAppID    = os.Getenv("AZURE_ATLAS_APP_ID")
+		servicePrincipalID = os.Getenv("AZURE_SERVICE_PRINCIPAL_ID")
+		tenantID           = os.Getenv("AZURE_TENANT_ID")
+		serviceURL         = os.Getenv("AZURE_BLOB_STORAGE_URL")
+		containerName      = os.Getenv("AZURE_BLOB_STORAGE_CONTAINER_NAME")
+	)
+
+	valueChecks := map[string]string{
+		"name": name,
+		"cloud_provider_config.0.azure.0.tenant_id": tenantID,
+		"storage_stores.0.provider":                 "azure",
+		"storage_stores.0.azure.0.service_url":      serviceURL,
+		"storage_stores.0.azure.0.container_name":   containerName,
+		"storage_stores.0.azure.0.region":           "US_EAST_2",
+	}
+	setChecks := []string{"cloud_provider_config.0.azure.0.role_id", "cloud_provider_config.0.azure.0.atlas_app_id", "cloud_provider_config.0.azure.0.service_principal_id"}
+	checks := acc.AddAttrChecks(resourceName, nil, valueChecks)
+	checks = acc.AddAttrSetChecks(resourceName, checks, setChecks...)
+	checks = acc.AddAttrChecks(dataSourceName, checks, valueChecks)
+	checks = acc.AddAttrSetChecks(dataSourceName, checks, setChecks...)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckCloudProviderAccessAzure(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyFederatedDatabaseInstance,
+		Steps: []resource.TestStep{
+			{
+				Config: configWithAzure(projectID, name, atlasAzureAppID, servicePrincipalID, tenantID, serviceURL, containerName),
+				Check:  resource.ComposeAggregateTestCheckFunc(checks...),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: importStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFederatedDatabaseInstance_gcs(t *testing.T) {
+	acc.SkipTestForCI(t) // needs a GCS bucket the Atlas service account of the project has access to
+
+	var (
+		resourceName   = "mongodbatlas_federated_database_instance.test"
+		dataSourceName = "data.mongodbatlas_federated_database_instance.test"
+		projectID      = acc.ProjectIDExecution(t)
+		name           = acc.RandomName()
+		bucket         = os.Getenv("GCP_GCS_BUCKET")
+	)
+
+	valueChecks := map[string]string{
+		"name":                             name,
+		"storage_stores.0.provider":        "gcs",
+		"storage_stores.0.gcs.0.bucket":    bucket,
+		"storage_stores.0.gcs.0.region":    "US_EAST_4",
+		"storage_stores.0.gcs.0.public":    "false",
+		"storage_stores.0.gcs.0.delimiter": "/",
+	}
+	setChecks := []string{"cloud_provider_config.0.gcp.0.role_id", "cloud_provider_config.0.gcp.0.gcp_service_account"}
+	checks := acc.AddAttrChecks(resourceName, nil, valueChecks)
+	checks = acc.AddAttrSetChecks(resourceName, checks, setChecks...)
+	checks = acc.AddAttrChecks(dataSourceName, checks, valueChecks)
+	checks = acc.AddAttrSetChecks(dataSourceName, checks, setChecks...)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckGCSBucket(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyFederatedDatabaseInstance,
+		Steps: []resource.TestStep{
+			{
+				Config: configWithGCS(projectID, name, bucket),
+				Check:  resource.ComposeAggregateTestCheckFunc(checks...),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: importStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFederatedDatabaseInstance_invalidProviderBlock(t *testing.T) {
+	const (
+		projectID = "111111111111111111111111"
+		name      = "test"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configInvalidProviderBlock(projectID, name, "s3", `
+      azure {
+         service_url    = "https://example.blob.core.windows.net"
+         container_name = "container"
+         region         = "US_EAST_2"
+      }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("azure can only be used with provider azure"),
+			},
+			{
+				Config: configInvalidProviderBlock(projectID, name, "azure", `
+      gcs {
+         bucket = "bucket"
+         region = "US_EAST_4"
+      }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("gcs can only be used with provider gcs"),
+			},
+		},
+	})
+}
+
+func TestAccFederatedDatabaseInstance_invalidStorageDatabases(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+		name      = acc.RandomName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      configInvalidStorageDatabases(projectID, name, "UndeclaredStore", `[{"$match": {"status": "A"}}]`),
+				ExpectError: regexp.MustCompile("references store_name `UndeclaredStore` that is not declared in storage_stores"),
+			},
+			{
+				Config:      configInvalidStorageDatabases(projectID, name, "ClusterTest", `[{"match": {"status": "A"}}]`),
+				ExpectError: regexp.MustCompile("must be a valid aggregation pipeline"),
+			},
+		},
+	})
+}
+
 func TestAccFederatedDatabaseInstance_atlasCluster(t *testing.T) {
 	var (
 		specs = []acc.ReplicationSpecRequest{
@@ -520,6 +675,11 @@ resource "mongodbatlas_federated_database_instance" "test" {
 					store_name =  "ClusterTest"
 			}
 	}
+	views {
+		name     = "VirtualView0"
+		source   = "VirtualCollection0"
+		pipeline = jsonencode([{ "$match" = { "room_type" = "Private room" } }])
+	}
    }
 
    storage_stores {
@@ -562,3 +722,166 @@ resource "mongodbatlas_federated_database_instance" "test" {
 }
 	`, federatedInstanceName, projectName, orgID)
 }
+
+func configWithAzure(projectID, name, atlasAzureAppID, servicePrincipalID, tenantID, serviceURL, containerName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_cloud_provider_access_setup" "test" {
+   project_id    = %[1]q
+   provider_name = "AZURE"
+   azure_config {
+      atlas_azure_app_id   = %[3]q
+      service_principal_id = %[4]q
+      tenant_id            = %[5]q
+   }
+}
+
+resource "mongodbatlas_cloud_provider_access_authorization" "test" {
+   project_id = %[1]q
+   role_id    = mongodbatlas_cloud_provider_access_setup.test.role_id
+   azure {
+      atlas_azure_app_id   = %[3]q
+      service_principal_id = %[4]q
+      tenant_id            = %[5]q
+   }
+}
+
+resource "mongodbatlas_federated_database_instance" "test" {
+   project_id = %[1]q
+   name       = %[2]q
+
+   cloud_provider_config {
+      azure {
+         role_id = mongodbatlas_cloud_provider_access_authorization.test.role_id
+      }
+   }
+
+   storage_databases {
+      name = "VirtualDatabase0"
+      collections {
+         name = "VirtualCollection0"
+         data_sources {
+            store_name = "AzureStore"
+            path       = "/{fileName string}.json"
+         }
+      }
+   }
+
+   storage_stores {
+      name     = "AzureStore"
+      provider = "azure"
+      azure {
+         service_url    = %[6]q
+         container_name = %[7]q
+         region         = "US_EAST_2"
+         delimiter      = "/"
+         public         = false
+      }
+   }
+}
+
+data "mongodbatlas_federated_database_instance" "test" {
+   project_id = mongodbatlas_federated_database_instance.test.project_id
+   name       = mongodbatlas_federated_database_instance.test.name
+}
+	`, projectID, name, atlasAzureAppID, servicePrincipalID, tenantID, serviceURL, containerName)
+}
+
+func configWithGCS(projectID, name, bucket string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_cloud_provider_access_setup" "test" {
+   project_id    = %[1]q
+   provider_name = "GCP"
+}
+
+resource "mongodbatlas_cloud_provider_access_authorization" "test" {
+   project_id = %[1]q
+   role_id    = mongodbatlas_cloud_provider_access_setup.test.role_id
+}
+
+resource "mongodbatlas_federated_database_instance" "test" {
+   project_id = %[1]q
+   name       = %[2]q
+
+   cloud_provider_config {
+      gcp {
+         role_id = mongodbatlas_cloud_provider_access_authorization.test.role_id
+      }
+   }
+
+   storage_databases {
+      name = "VirtualDatabase0"
+      collections {
+         name = "VirtualCollection0"
+         data_sources {
+            store_name = "GCSStore"
+            path       = "/{fileName string}.json"
+         }
+      }
+   }
+
+   storage_stores {
+      name     = "GCSStore"
+      provider = "gcs"
+      gcs {
+         bucket    = %[3]q
+         region    = "US_EAST_4"
+         delimiter = "/"
+         public    = false
+      }
+   }
+}
+
+data "mongodbatlas_federated_database_instance" "test" {
+   project_id = mongodbatlas_federated_database_instance.test.project_id
+   name       = mongodbatlas_federated_database_instance.test.name
+}
+	`, projectID, name, bucket)
+}
+
+func configInvalidProviderBlock(projectID, name, provider, providerBlock string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_federated_database_instance" "test" {
+   project_id = %[1]q
+   name       = %[2]q
+
+   storage_stores {
+      name     = "Store"
+      provider = %[3]q
+%[4]s
+   }
+}
+	`, projectID, name, provider, providerBlock)
+}
+
+func configInvalidStorageDatabases(projectID, name, storeName, pipeline string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_federated_database_instance" "test" {
+   project_id = %[1]q
+   name       = %[2]q
+
+   storage_databases {
+      name = "VirtualDatabase0"
+      collections {
+         name = "VirtualCollection0"
+         data_sources {
+            collection = "listingsAndReviews"
+            database   = "sample_airbnb"
+            store_name = %[3]q
+         }
+      }
+      views {
+         name     = "VirtualView0"
+         source   = "VirtualCollection0"
+         pipeline = %[4]q
+      }
+   }
+
+   storage_stores {
+      name         = "ClusterTest"
+      cluster_name = "ClusterTest"
+      project_id   = %[1]q
+      provider     = "atlas"
+   }
+}
+	`, projectID, name, storeName, pipeline)
+}
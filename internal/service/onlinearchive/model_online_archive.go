@@ -0,0 +1,134 @@
+package onlinearchive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	criteriaTypeDate    = "DATE"
+	criteriaTypeCustom  = "CUSTOM"
+	scheduleTypeDaily   = "DAILY"
+	scheduleTypeWeekly  = "WEEKLY"
+	scheduleTypeMonthly = "MONTHLY"
+)
+
+// resourceCustomizeDiff checks at plan time the combinations of criteria, schedule and partition_fields that the API would reject.
+func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("criteria") || !d.NewValueKnown("schedule") || !d.NewValueKnown("partition_fields") {
+		return nil
+	}
+	criteria := firstElem(d.Get("criteria"))
+	if err := ValidateCriteria(criteria); err != nil {
+		return err
+	}
+	if err := ValidateSchedule(firstElem(d.Get("schedule"))); err != nil {
+		return err
+	}
+	partitionFields, _ := d.Get("partition_fields").([]any)
+	return ValidatePartitionFields(criteria, partitionFields)
+}
+
+// ValidateCriteria checks that DATE criteria only use the date attributes and CUSTOM criteria only use query.
+func ValidateCriteria(criteria map[string]any) error {
+	if criteria == nil {
+		return nil
+	}
+	dateField, _ := criteria["date_field"].(string)
+	expireAfterDays, _ := criteria["expire_after_days"].(int)
+	query, _ := criteria["query"].(string)
+	switch criteria["type"] {
+	case criteriaTypeDate:
+		if dateField == "" {
+			return fmt.Errorf("criteria.date_field is required when criteria.type is %s", criteriaTypeDate)
+		}
+		if expireAfterDays < 1 {
+			return fmt.Errorf("criteria.expire_after_days must be at least 1 when criteria.type is %s", criteriaTypeDate)
+		}
+		if query != "" {
+			return fmt.Errorf("criteria.query can only be used when criteria.type is %s", criteriaTypeCustom)
+		}
+	case criteriaTypeCustom:
+		if query == "" {
+			return fmt.Errorf("criteria.query is required when criteria.type is %s", criteriaTypeCustom)
+		}
+		if dateField != "" || expireAfterDays != 0 {
+			return fmt.Errorf("criteria.date_field and criteria.expire_after_days can only be used when criteria.type is %s", criteriaTypeDate)
+		}
+	}
+	return nil
+}
+
+// ValidateSchedule checks that day_of_week is only used with WEEKLY schedules and day_of_month with MONTHLY schedules.
+func ValidateSchedule(schedule map[string]any) error {
+	if schedule == nil {
+		return nil
+	}
+	dayOfWeek, _ := schedule["day_of_week"].(int)
+	dayOfMonth, _ := schedule["day_of_month"].(int)
+	switch schedule["type"] {
+	case scheduleTypeDaily:
+		if dayOfWeek != 0 || dayOfMonth != 0 {
+			return fmt.Errorf("schedule.day_of_week and schedule.day_of_month can't be used when schedule.type is %s", scheduleTypeDaily)
+		}
+	case scheduleTypeWeekly:
+		if dayOfWeek == 0 {
+			return fmt.Errorf("schedule.day_of_week is required when schedule.type is %s", scheduleTypeWeekly)
+		}
+		if dayOfMonth != 0 {
+			return fmt.Errorf("schedule.day_of_month can't be used when schedule.type is %s", scheduleTypeWeekly)
+		}
+	case scheduleTypeMonthly:
+		if dayOfMonth == 0 {
+			return fmt.Errorf("schedule.day_of_month is required when schedule.type is %s", scheduleTypeMonthly)
+		}
+		if dayOfWeek != 0 {
+			return fmt.Errorf("schedule.day_of_week can't be used when schedule.type is %s", scheduleTypeMonthly)
+		}
+	}
+	return nil
+}
+
+// ValidatePartitionFields checks that the orders are 0, 1, ... without repeated fields, and that the date field of DATE criteria is the first partition field.
+func ValidatePartitionFields(criteria map[string]any, partitionFields []any) error {
+	if len(partitionFields) == 0 {
+		return nil
+	}
+	fieldNames := make(map[string]bool, len(partitionFields))
+	fieldByOrder := make(map[int]string, len(partitionFields))
+	for _, partitionField := range partitionFields {
+		item, _ := partitionField.(map[string]any)
+		fieldName, _ := item["field_name"].(string)
+		order, _ := item["order"].(int)
+		if fieldNames[fieldName] {
+			return fmt.Errorf("partition_fields field_name %q is repeated", fieldName)
+		}
+		if _, found := fieldByOrder[order]; found {
+			return fmt.Errorf("partition_fields order %d is repeated", order)
+		}
+		fieldNames[fieldName] = true
+		fieldByOrder[order] = fieldName
+	}
+	for order := range partitionFields {
+		if _, found := fieldByOrder[order]; !found {
+			return fmt.Errorf("partition_fields orders must be consecutive starting at 0, order %d is missing", order)
+		}
+	}
+	if criteria != nil && criteria["type"] == criteriaTypeDate {
+		if dateField, _ := criteria["date_field"].(string); fieldByOrder[0] != dateField {
+			return fmt.Errorf("criteria.date_field %q must be the partition_fields with order 0 when criteria.type is %s, found %q", dateField, criteriaTypeDate, fieldByOrder[0])
+		}
+	}
+	return nil
+}
+
+func firstElem(value any) map[string]any {
+	list, ok := value.([]any)
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	elem, _ := list[0].(map[string]any)
+	return elem
+}
@@ -0,0 +1,153 @@
+package onlinearchive_test
+
+import (
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/onlinearchive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCriteria(t *testing.T) {
+	testCases := map[string]struct {
+		criteria      map[string]any
+		expectedError string
+	}{
+		"date criteria": {
+			criteria: map[string]any{"type": "DATE", "date_field": "last_review", "date_format": "ISODATE", "expire_after_days": 2},
+		},
+		"date criteria without date_field": {
+			criteria:      map[string]any{"type": "DATE", "expire_after_days": 2},
+			expectedError: "criteria.date_field is required when criteria.type is DATE",
+		},
+		"date criteria without expire_after_days": {
+			criteria:      map[string]any{"type": "DATE", "date_field": "last_review"},
+			expectedError: "criteria.expire_after_days must be at least 1 when criteria.type is DATE",
+		},
+		"date criteria with query": {
+			criteria:      map[string]any{"type": "DATE", "date_field": "last_review", "expire_after_days": 2, "query": `{"status": "A"}`},
+			expectedError: "criteria.query can only be used when criteria.type is CUSTOM",
+		},
+		"custom criteria": {
+			criteria: map[string]any{"type": "CUSTOM", "query": `{"status": "A"}`, "date_format": "ISODATE"},
+		},
+		"custom criteria without query": {
+			criteria:      map[string]any{"type": "CUSTOM"},
+			expectedError: "criteria.query is required when criteria.type is CUSTOM",
+		},
+		"custom criteria with date_field": {
+			criteria:      map[string]any{"type": "CUSTOM", "query": `{"status": "A"}`, "date_field": "last_review"},
+			expectedError: "criteria.date_field and criteria.expire_after_days can only be used when criteria.type is DATE",
+		},
+		"no criteria": {},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assertError(t, tc.expectedError, onlinearchive.ValidateCriteria(tc.criteria))
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	testCases := map[string]struct {
+		schedule      map[string]any
+		expectedError string
+	}{
+		"daily": {
+			schedule: map[string]any{"type": "DAILY", "start_hour": 1, "end_hour": 3},
+		},
+		"daily with day_of_week": {
+			schedule:      map[string]any{"type": "DAILY", "day_of_week": 1},
+			expectedError: "schedule.day_of_week and schedule.day_of_month can't be used when schedule.type is DAILY",
+		},
+		"weekly": {
+			schedule: map[string]any{"type": "WEEKLY", "day_of_week": 1},
+		},
+		"weekly without day_of_week": {
+			schedule:      map[string]any{"type": "WEEKLY"},
+			expectedError: "schedule.day_of_week is required when schedule.type is WEEKLY",
+		},
+		"weekly with day_of_month": {
+			schedule:      map[string]any{"type": "WEEKLY", "day_of_week": 1, "day_of_month": 1},
+			expectedError: "schedule.day_of_month can't be used when schedule.type is WEEKLY",
+		},
+		"monthly": {
+			schedule: map[string]any{"type": "MONTHLY", "day_of_month": 1},
+		},
+		"monthly without day_of_month": {
+			schedule:      map[string]any{"type": "MONTHLY", "day_of_week": 0},
+			expectedError: "schedule.day_of_month is required when schedule.type is MONTHLY",
+		},
+		"monthly with day_of_week": {
+			schedule:      map[string]any{"type": "MONTHLY", "day_of_month": 1, "day_of_week": 1},
+			expectedError: "schedule.day_of_week can't be used when schedule.type is MONTHLY",
+		},
+		"no schedule": {},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assertError(t, tc.expectedError, onlinearchive.ValidateSchedule(tc.schedule))
+		})
+	}
+}
+
+func TestValidatePartitionFields(t *testing.T) {
+	var (
+		dateCriteria   = map[string]any{"type": "DATE", "date_field": "last_review"}
+		customCriteria = map[string]any{"type": "CUSTOM", "query": `{"status": "A"}`}
+	)
+	testCases := map[string]struct {
+		criteria        map[string]any
+		expectedError   string
+		partitionFields []any
+	}{
+		"date field first": {
+			criteria:        dateCriteria,
+			partitionFields: []any{partitionField("last_review", 0), partitionField("name", 1)},
+		},
+		"date field not first": {
+			criteria:        dateCriteria,
+			partitionFields: []any{partitionField("name", 0), partitionField("last_review", 1)},
+			expectedError:   `criteria.date_field "last_review" must be the partition_fields with order 0 when criteria.type is DATE, found "name"`,
+		},
+		"custom criteria any field first": {
+			criteria:        customCriteria,
+			partitionFields: []any{partitionField("name", 0), partitionField("last_review", 1)},
+		},
+		"repeated order": {
+			criteria:        customCriteria,
+			partitionFields: []any{partitionField("name", 0), partitionField("last_review", 0)},
+			expectedError:   "partition_fields order 0 is repeated",
+		},
+		"repeated field_name": {
+			criteria:        customCriteria,
+			partitionFields: []any{partitionField("name", 0), partitionField("name", 1)},
+			expectedError:   `partition_fields field_name "name" is repeated`,
+		},
+		"missing order": {
+			criteria:        customCriteria,
+			partitionFields: []any{partitionField("name", 0), partitionField("last_review", 2)},
+			expectedError:   "partition_fields orders must be consecutive starting at 0, order 1 is missing",
+		},
+		"no partition fields": {
+			criteria: dateCriteria,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assertError(t, tc.expectedError, onlinearchive.ValidatePartitionFields(tc.criteria, tc.partitionFields))
+		})
+	}
+}
+
+func partitionField(fieldName string, order int) map[string]any {
+	return map[string]any{"field_name": fieldName, "order": order}
+}
+
+func assertError(t *testing.T, expectedError string, err error) {
+	t.Helper()
+	if expectedError == "" {
+		assert.NoError(t, err)
+		return
+	}
+	assert.EqualError(t, err, expectedError)
+}
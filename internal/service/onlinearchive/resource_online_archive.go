@@ -34,6 +34,7 @@ func Resource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImport,
 		},
+		CustomizeDiff: resourceCustomizeDiff,
 	}
 }
 
@@ -75,7 +76,7 @@ func resourceSchema() map[string]*schema.Schema {
 					"type": {
 						Type:         schema.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringInSlice([]string{"DATE", "CUSTOM"}, false),
+						ValidateFunc: validation.StringInSlice([]string{criteriaTypeDate, criteriaTypeCustom}, false),
 					},
 					"date_field": {
 						Type:     schema.TypeString,
@@ -92,8 +93,9 @@ func resourceSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 					"query": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsJSON,
 					},
 				},
 			},
@@ -143,31 +145,37 @@ func resourceSchema() map[string]*schema.Schema {
 					"type": {
 						Type:         schema.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringInSlice([]string{"DAILY", "MONTHLY", "WEEKLY"}, false),
+						ValidateFunc: validation.StringInSlice([]string{scheduleTypeDaily, scheduleTypeMonthly, scheduleTypeWeekly}, false),
 					},
 					"end_hour": {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 23),
 					},
 					"end_minute": {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 59),
 					},
 					"start_hour": {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 23),
 					},
 					"start_minute": {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 59),
 					},
 					"day_of_month": {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 31),
 					},
 					"day_of_week": {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 7),
 					},
 				},
 			},
@@ -609,7 +617,7 @@ func mapCriteria(d *schema.ResourceData) admin.Criteria {
 		Type: admin.PtrString(criteria["type"].(string)),
 	}
 
-	if criteriaInput.Type != nil && *criteriaInput.Type == "DATE" {
+	if criteriaInput.Type != nil && *criteriaInput.Type == criteriaTypeDate {
 		if dateField := criteria["date_field"].(string); dateField != "" {
 			criteriaInput.DateField = admin.PtrString(dateField)
 		}
@@ -622,7 +630,7 @@ func mapCriteria(d *schema.ResourceData) admin.Criteria {
 		}
 	}
 
-	if criteriaInput.Type != nil && *criteriaInput.Type == "CUSTOM" {
+	if criteriaInput.Type != nil && *criteriaInput.Type == criteriaTypeCustom {
 		if query := criteria["query"].(string); query != "" {
 			criteriaInput.Query = admin.PtrString(query)
 		}
@@ -239,6 +239,28 @@ func TestAccBackupRSOnlineArchiveInvalidProcessRegion(t *testing.T) {
 	})
 }
 
+func TestAccBackupRSOnlineArchiveInvalidConfig(t *testing.T) {
+	var (
+		projectID   = acc.ProjectIDExecution(t)
+		clusterName = acc.RandomClusterName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      configInvalid(projectID, clusterName, `type = "MONTHLY"`, "last_review"),
+				ExpectError: regexp.MustCompile("schedule.day_of_month is required when schedule.type is MONTHLY"),
+			},
+			{
+				Config:      configInvalid(projectID, clusterName, `type = "DAILY"`, "name"),
+				ExpectError: regexp.MustCompile(`criteria.date_field "last_review" must be the partition_fields with order 0`),
+			},
+		},
+	})
+}
+
 // populateWithSampleData adds Sample Data to the cluster otherwise online archive won't work
 func populateWithSampleData(resourceName, projectID, clusterName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -574,3 +596,33 @@ func testAccBackupRSOnlineArchiveConfigWithMonthlySchedule(clusterTerraformStr,
 	}
 	`, clusterTerraformStr, startHour, clusterResourceName)
 }
+
+func configInvalid(projectID, clusterName, scheduleAttrs, firstPartitionField string) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_online_archive" "users_archive" {
+		project_id = %[1]q
+		cluster_name = %[2]q
+		coll_name = "listingsAndReviews"
+		db_name = "sample_airbnb"
+
+		criteria {
+			type = "DATE"
+			date_field = "last_review"
+			expire_after_days = 2
+		}
+
+		schedule {
+			%[3]s
+			end_hour = 1
+			end_minute = 1
+			start_hour = 0
+			start_minute = 1
+		}
+
+		partition_fields {
+			field_name = %[4]q
+			order = 0
+		}
+	}
+	`, projectID, clusterName, scheduleAttrs, firstPartitionField)
+}
@@ -0,0 +1,184 @@
+package orguser
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+const (
+	StatusPendingInvitation = "PENDING_INVITATION"
+	StatusActive            = "ACTIVE"
+)
+
+// NewTFModelFromUser returns the model of a user who is a member of the organization.
+// Project roles are only included for the projects in trackedProjects, or not at all if trackedProjects is nil.
+func NewTFModelFromUser(ctx context.Context, orgID, invitationID string, user *admin.CloudAppUser, trackedProjects map[string][]string) (*TFModel, diag.Diagnostics) {
+	orgRoles := []string{}
+	for _, role := range user.GetRoles() {
+		if role.GetOrgId() == orgID {
+			orgRoles = append(orgRoles, role.GetRoleName())
+		}
+	}
+	roles, diags := types.SetValueFrom(ctx, types.StringType, orgRoles)
+	if diags.HasError() {
+		return nil, diags
+	}
+	projectRoleAssignments, diags := newTFProjectRoleAssignments(ctx, NewUserProjectRolesMap(user), trackedProjects)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &TFModel{
+		OrgID:                  types.StringValue(orgID),
+		Username:               types.StringValue(user.GetUsername()),
+		UserID:                 types.StringValue(user.GetId()),
+		InvitationID:           conversion.StringNullIfEmpty(invitationID),
+		Status:                 types.StringValue(StatusActive),
+		Roles:                  roles,
+		ProjectRoleAssignments: projectRoleAssignments,
+	}, nil
+}
+
+// NewTFModelFromInvitation returns the model of a user who has not accepted the invitation to the organization yet.
+// Project roles are only included for the projects in trackedProjects, or not at all if trackedProjects is nil.
+func NewTFModelFromInvitation(ctx context.Context, orgID string, invitation *admin.OrganizationInvitation, trackedProjects map[string][]string) (*TFModel, diag.Diagnostics) {
+	roles, diags := types.SetValueFrom(ctx, types.StringType, invitation.GetRoles())
+	if diags.HasError() {
+		return nil, diags
+	}
+	projectRoles := map[string][]string{}
+	for _, groupRole := range invitation.GetGroupRoleAssignments() {
+		projectRoles[groupRole.GetGroupId()] = append(projectRoles[groupRole.GetGroupId()], groupRole.GetGroupRole())
+	}
+	projectRoleAssignments, diags := newTFProjectRoleAssignments(ctx, projectRoles, trackedProjects)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &TFModel{
+		OrgID:                  types.StringValue(orgID),
+		Username:               types.StringValue(invitation.GetUsername()),
+		UserID:                 types.StringNull(),
+		InvitationID:           types.StringValue(invitation.GetId()),
+		Status:                 types.StringValue(StatusPendingInvitation),
+		Roles:                  roles,
+		ProjectRoleAssignments: projectRoleAssignments,
+	}, nil
+}
+
+// NewUserProjectRolesMap returns the roles of each project the user belongs to.
+func NewUserProjectRolesMap(user *admin.CloudAppUser) map[string][]string {
+	projectRoles := map[string][]string{}
+	for _, role := range user.GetRoles() {
+		if role.GetGroupId() != "" {
+			projectRoles[role.GetGroupId()] = append(projectRoles[role.GetGroupId()], role.GetRoleName())
+		}
+	}
+	return projectRoles
+}
+
+func newTFProjectRoleAssignments(ctx context.Context, projectRoles, trackedProjects map[string][]string) (types.Set, diag.Diagnostics) {
+	if trackedProjects == nil {
+		return types.SetNull(ProjectRoleAssignmentObjectType), nil
+	}
+	assignments := []TFProjectRoleAssignmentModel{}
+	for projectID, roleNames := range projectRoles {
+		if _, ok := trackedProjects[projectID]; !ok {
+			continue
+		}
+		roleNamesSet, diags := types.SetValueFrom(ctx, types.StringType, roleNames)
+		if diags.HasError() {
+			return types.SetNull(ProjectRoleAssignmentObjectType), diags
+		}
+		assignments = append(assignments, TFProjectRoleAssignmentModel{
+			ProjectID: types.StringValue(projectID),
+			RoleNames: roleNamesSet,
+		})
+	}
+	return types.SetValueFrom(ctx, ProjectRoleAssignmentObjectType, assignments)
+}
+
+// NewProjectRolesMap returns the roles of each project in the project_role_assignments attribute, or nil if it's not set.
+func NewProjectRolesMap(ctx context.Context, projectRoleAssignments types.Set) map[string][]string {
+	if projectRoleAssignments.IsNull() || projectRoleAssignments.IsUnknown() {
+		return nil
+	}
+	var assignments []TFProjectRoleAssignmentModel
+	_ = projectRoleAssignments.ElementsAs(ctx, &assignments, false)
+	projectRoles := make(map[string][]string, len(assignments))
+	for _, assignment := range assignments {
+		roleNames := conversion.TypesSetToString(ctx, assignment.RoleNames)
+		sort.Strings(roleNames)
+		projectRoles[assignment.ProjectID.ValueString()] = roleNames
+	}
+	return projectRoles
+}
+
+func NewOrgInvitationReq(ctx context.Context, tfModel *TFModel) *admin.OrganizationInvitationRequest {
+	roles := conversion.TypesSetToString(ctx, tfModel.Roles)
+	return &admin.OrganizationInvitationRequest{
+		Username:             tfModel.Username.ValueStringPointer(),
+		Roles:                &roles,
+		GroupRoleAssignments: newGroupRoleAssignmentsReq(NewProjectRolesMap(ctx, tfModel.ProjectRoleAssignments)),
+	}
+}
+
+func NewOrgInvitationUpdateReq(ctx context.Context, tfModel *TFModel) *admin.OrganizationInvitationUpdateRequest {
+	roles := conversion.TypesSetToString(ctx, tfModel.Roles)
+	return &admin.OrganizationInvitationUpdateRequest{
+		Roles:                &roles,
+		GroupRoleAssignments: newGroupRoleAssignmentsReq(NewProjectRolesMap(ctx, tfModel.ProjectRoleAssignments)),
+	}
+}
+
+func newGroupRoleAssignmentsReq(projectRoles map[string][]string) *[]admin.OrganizationInvitationGroupRoleAssignmentsRequest {
+	if projectRoles == nil {
+		return nil
+	}
+	projectIDs := make([]string, 0, len(projectRoles))
+	for projectID := range projectRoles {
+		projectIDs = append(projectIDs, projectID)
+	}
+	sort.Strings(projectIDs)
+	assignments := make([]admin.OrganizationInvitationGroupRoleAssignmentsRequest, len(projectIDs))
+	for i, projectID := range projectIDs {
+		assignments[i] = admin.OrganizationInvitationGroupRoleAssignmentsRequest{
+			GroupId: admin.PtrString(projectID),
+			Roles:   conversion.Pointer(projectRoles[projectID]),
+		}
+	}
+	return &assignments
+}
+
+// GetProjectRoleChanges returns the projects the user must be added to, the ones where roles must be updated and the ones the user must be removed from.
+func GetProjectRoleChanges(current, desired map[string][]string) (toAdd, toUpdate map[string][]string, toRemove []string) {
+	toAdd = map[string][]string{}
+	toUpdate = map[string][]string{}
+	for projectID, roleNames := range desired {
+		currentRoleNames, ok := current[projectID]
+		switch {
+		case !ok:
+			toAdd[projectID] = roleNames
+		case !sameElements(currentRoleNames, roleNames):
+			toUpdate[projectID] = roleNames
+		}
+	}
+	for projectID := range current {
+		if _, ok := desired[projectID]; !ok {
+			toRemove = append(toRemove, projectID)
+		}
+	}
+	sort.Strings(toRemove)
+	return toAdd, toUpdate, toRemove
+}
+
+func sameElements(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	sort.Strings(a)
+	sort.Strings(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,186 @@
+package orguser_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/orguser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+const (
+	orgID        = "664619d870c247237f4b86a6"
+	otherOrgID   = "664619d870c247237f4b86a7"
+	projectID    = "664619d870c247237f4b86a8"
+	otherProject = "664619d870c247237f4b86a9"
+	userID       = "664619d870c247237f4b86aa"
+	invitationID = "664619d870c247237f4b86ab"
+	username     = "user@example.com"
+)
+
+func TestNewTFModelFromUser(t *testing.T) {
+	ctx := context.Background()
+	user := &admin.CloudAppUser{
+		Id:       admin.PtrString(userID),
+		Username: username,
+		Roles: &[]admin.CloudAccessRoleAssignment{
+			{OrgId: admin.PtrString(orgID), RoleName: admin.PtrString("ORG_MEMBER")},
+			{OrgId: admin.PtrString(otherOrgID), RoleName: admin.PtrString("ORG_OWNER")},
+			{GroupId: admin.PtrString(projectID), RoleName: admin.PtrString("GROUP_READ_ONLY")},
+			{GroupId: admin.PtrString(otherProject), RoleName: admin.PtrString("GROUP_OWNER")},
+		},
+	}
+	roles, _ := types.SetValueFrom(ctx, types.StringType, []string{"ORG_MEMBER"})
+	testCases := map[string]struct {
+		trackedProjects  map[string][]string
+		expectedProjects []orguser.TFProjectRoleAssignmentModel
+	}{
+		"no project assignments": {
+			trackedProjects: nil,
+		},
+		"only tracked projects": {
+			trackedProjects: map[string][]string{projectID: {"GROUP_OWNER"}},
+			expectedProjects: []orguser.TFProjectRoleAssignmentModel{
+				{ProjectID: types.StringValue(projectID), RoleNames: stringSet(t, "GROUP_READ_ONLY")},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tfModel, diags := orguser.NewTFModelFromUser(ctx, orgID, invitationID, user, tc.trackedProjects)
+			require.False(t, diags.HasError())
+			assert.Equal(t, &orguser.TFModel{
+				OrgID:                  types.StringValue(orgID),
+				Username:               types.StringValue(username),
+				UserID:                 types.StringValue(userID),
+				InvitationID:           types.StringValue(invitationID),
+				Status:                 types.StringValue(orguser.StatusActive),
+				Roles:                  roles,
+				ProjectRoleAssignments: projectAssignmentsSet(t, tc.trackedProjects != nil, tc.expectedProjects),
+			}, tfModel)
+		})
+	}
+}
+
+func TestNewTFModelFromInvitation(t *testing.T) {
+	ctx := context.Background()
+	invitation := &admin.OrganizationInvitation{
+		Id:       admin.PtrString(invitationID),
+		Username: admin.PtrString(username),
+		Roles:    &[]string{"ORG_MEMBER", "ORG_BILLING_ADMIN"},
+		GroupRoleAssignments: &[]admin.GroupRole{
+			{GroupId: admin.PtrString(projectID), GroupRole: admin.PtrString("GROUP_READ_ONLY")},
+			{GroupId: admin.PtrString(projectID), GroupRole: admin.PtrString("GROUP_DATA_ACCESS_READ_ONLY")},
+		},
+	}
+	tfModel, diags := orguser.NewTFModelFromInvitation(ctx, orgID, invitation, map[string][]string{projectID: nil})
+	require.False(t, diags.HasError())
+	assert.Equal(t, &orguser.TFModel{
+		OrgID:        types.StringValue(orgID),
+		Username:     types.StringValue(username),
+		UserID:       types.StringNull(),
+		InvitationID: types.StringValue(invitationID),
+		Status:       types.StringValue(orguser.StatusPendingInvitation),
+		Roles:        stringSet(t, "ORG_MEMBER", "ORG_BILLING_ADMIN"),
+		ProjectRoleAssignments: projectAssignmentsSet(t, true, []orguser.TFProjectRoleAssignmentModel{
+			{ProjectID: types.StringValue(projectID), RoleNames: stringSet(t, "GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY")},
+		}),
+	}, tfModel)
+}
+
+func TestNewOrgInvitationReq(t *testing.T) {
+	tfModel := &orguser.TFModel{
+		OrgID:    types.StringValue(orgID),
+		Username: types.StringValue(username),
+		Roles:    stringSet(t, "ORG_MEMBER"),
+		ProjectRoleAssignments: projectAssignmentsSet(t, true, []orguser.TFProjectRoleAssignmentModel{
+			{ProjectID: types.StringValue(otherProject), RoleNames: stringSet(t, "GROUP_OWNER")},
+			{ProjectID: types.StringValue(projectID), RoleNames: stringSet(t, "GROUP_READ_ONLY")},
+		}),
+	}
+	assert.Equal(t, &admin.OrganizationInvitationRequest{
+		Username: admin.PtrString(username),
+		Roles:    &[]string{"ORG_MEMBER"},
+		GroupRoleAssignments: &[]admin.OrganizationInvitationGroupRoleAssignmentsRequest{
+			{GroupId: admin.PtrString(projectID), Roles: &[]string{"GROUP_READ_ONLY"}},
+			{GroupId: admin.PtrString(otherProject), Roles: &[]string{"GROUP_OWNER"}},
+		},
+	}, orguser.NewOrgInvitationReq(context.Background(), tfModel))
+
+	tfModel.ProjectRoleAssignments = types.SetNull(orguser.ProjectRoleAssignmentObjectType)
+	assert.Equal(t, &admin.OrganizationInvitationUpdateRequest{
+		Roles: &[]string{"ORG_MEMBER"},
+	}, orguser.NewOrgInvitationUpdateReq(context.Background(), tfModel))
+}
+
+func TestGetProjectRoleChanges(t *testing.T) {
+	testCases := map[string]struct {
+		current          map[string][]string
+		desired          map[string][]string
+		expectedToAdd    map[string][]string
+		expectedToUpdate map[string][]string
+		expectedToRemove []string
+	}{
+		"no changes": {
+			current:          map[string][]string{projectID: {"GROUP_OWNER", "GROUP_READ_ONLY"}},
+			desired:          map[string][]string{projectID: {"GROUP_READ_ONLY", "GROUP_OWNER"}},
+			expectedToAdd:    map[string][]string{},
+			expectedToUpdate: map[string][]string{},
+		},
+		"add, update and remove": {
+			current:          map[string][]string{projectID: {"GROUP_OWNER"}, otherProject: {"GROUP_OWNER"}},
+			desired:          map[string][]string{projectID: {"GROUP_READ_ONLY"}, "664619d870c247237f4b86ac": {"GROUP_OWNER"}},
+			expectedToAdd:    map[string][]string{"664619d870c247237f4b86ac": {"GROUP_OWNER"}},
+			expectedToUpdate: map[string][]string{projectID: {"GROUP_READ_ONLY"}},
+			expectedToRemove: []string{otherProject},
+		},
+		"remove all": {
+			current:          map[string][]string{projectID: {"GROUP_OWNER"}},
+			desired:          nil,
+			expectedToAdd:    map[string][]string{},
+			expectedToUpdate: map[string][]string{},
+			expectedToRemove: []string{projectID},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			toAdd, toUpdate, toRemove := orguser.GetProjectRoleChanges(tc.current, tc.desired)
+			assert.Equal(t, tc.expectedToAdd, toAdd)
+			assert.Equal(t, tc.expectedToUpdate, toUpdate)
+			assert.Equal(t, tc.expectedToRemove, toRemove)
+		})
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	gotOrgID, gotUsername, err := orguser.SplitImportID(orgID + "-first-last@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, orgID, gotOrgID)
+	assert.Equal(t, "first-last@example.com", gotUsername)
+
+	_, _, err = orguser.SplitImportID("org-" + username)
+	require.Error(t, err)
+}
+
+func stringSet(t *testing.T, values ...string) types.Set {
+	t.Helper()
+	set, diags := types.SetValueFrom(context.Background(), types.StringType, values)
+	require.False(t, diags.HasError())
+	return set
+}
+
+func projectAssignmentsSet(t *testing.T, set bool, assignments []orguser.TFProjectRoleAssignmentModel) types.Set {
+	t.Helper()
+	if !set {
+		return types.SetNull(orguser.ProjectRoleAssignmentObjectType)
+	}
+	if assignments == nil {
+		assignments = []orguser.TFProjectRoleAssignmentModel{}
+	}
+	value, diags := types.SetValueFrom(context.Background(), orguser.ProjectRoleAssignmentObjectType, assignments)
+	require.False(t, diags.HasError())
+	return value
+}
@@ -0,0 +1,277 @@
+package orguser
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+const (
+	resourceName     = "org_user"
+	fullResourceName = "mongodbatlas_" + resourceName
+	errorCreate      = "error creating resource " + fullResourceName
+	errorRead        = "error reading resource " + fullResourceName
+	errorUpdate      = "error updating resource " + fullResourceName
+	errorDelete      = "error deleting resource " + fullResourceName
+	errorImport      = "import format error: to import an org user, use the format {org_id}-{username}"
+)
+
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+
+var importIDRegex = regexp.MustCompile(`^([0-9a-fA-F]{24})-(.+)$`)
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
+		},
+	}
+}
+
+type rs struct {
+	config.RSCommon
+}
+
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.apply(ctx, &plan, nil); err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+	newModel, diags := r.read(ctx, plan.OrgID.ValueString(), plan.Username.ValueString(), "", NewProjectRolesMap(ctx, plan.ProjectRoleAssignments))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if newModel == nil {
+		resp.Diagnostics.AddError(errorCreate, fmt.Sprintf("user %s not found in organization %s", plan.Username.ValueString(), plan.OrgID.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newModel, diags := r.read(ctx, state.OrgID.ValueString(), state.Username.ValueString(), state.InvitationID.ValueString(), NewProjectRolesMap(ctx, state.ProjectRoleAssignments))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if newModel == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.apply(ctx, &plan, NewProjectRolesMap(ctx, state.ProjectRoleAssignments)); err != nil {
+		resp.Diagnostics.AddError(errorUpdate, err.Error())
+		return
+	}
+	newModel, diags := r.read(ctx, plan.OrgID.ValueString(), plan.Username.ValueString(), state.InvitationID.ValueString(), NewProjectRolesMap(ctx, plan.ProjectRoleAssignments))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if newModel == nil {
+		resp.Diagnostics.AddError(errorUpdate, fmt.Sprintf("user %s not found in organization %s", plan.Username.ValueString(), plan.OrgID.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	orgID := state.OrgID.ValueString()
+	user, err := getOrgUser(ctx, connV2, orgID, state.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errorDelete, err.Error())
+		return
+	}
+	if user != nil {
+		if _, httpResp, err := connV2.OrganizationsApi.RemoveOrganizationUser(ctx, orgID, user.GetId()).Execute(); err != nil && !isNotFound(httpResp) {
+			resp.Diagnostics.AddError(errorDelete, err.Error())
+		}
+		return
+	}
+	invitation, err := getOrgInvitation(ctx, connV2, orgID, state.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errorDelete, err.Error())
+		return
+	}
+	if invitation == nil {
+		return
+	}
+	if _, httpResp, err := connV2.OrganizationsApi.DeleteOrganizationInvitation(ctx, orgID, invitation.GetId()).Execute(); err != nil && !isNotFound(httpResp) {
+		resp.Diagnostics.AddError(errorDelete, err.Error())
+	}
+}
+
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, username, err := SplitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error splitting import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), username)...)
+}
+
+func SplitImportID(id string) (orgID, username string, err error) {
+	parts := importIDRegex.FindStringSubmatch(id)
+	if len(parts) != 3 {
+		return "", "", fmt.Errorf("%s, got: %s", errorImport, id)
+	}
+	return parts[1], parts[2], nil
+}
+
+// apply invites the user if they are not a member of the organization yet, or updates their roles otherwise.
+// stateProjects are the projects managed in the previous state, the user is removed from the ones no longer in the plan.
+func (r *rs) apply(ctx context.Context, plan *TFModel, stateProjects map[string][]string) error {
+	connV2 := r.Client.AtlasV2
+	orgID := plan.OrgID.ValueString()
+	username := plan.Username.ValueString()
+	user, err := getOrgUser(ctx, connV2, orgID, username)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		invitation, err := getOrgInvitation(ctx, connV2, orgID, username)
+		if err != nil {
+			return err
+		}
+		if invitation == nil {
+			_, _, err = connV2.OrganizationsApi.CreateOrganizationInvitation(ctx, orgID, NewOrgInvitationReq(ctx, plan)).Execute()
+		} else {
+			_, _, err = connV2.OrganizationsApi.UpdateOrganizationInvitationById(ctx, orgID, invitation.GetId(), NewOrgInvitationUpdateReq(ctx, plan)).Execute()
+		}
+		return err
+	}
+
+	userID := user.GetId()
+	orgRoles := conversion.TypesSetToString(ctx, plan.Roles)
+	if _, _, err := connV2.OrganizationsApi.UpdateOrganizationRoles(ctx, orgID, userID, &admin.UpdateOrgRolesForUser{OrgRoles: &orgRoles}).Execute(); err != nil {
+		return fmt.Errorf("error updating organization roles: %w", err)
+	}
+	desiredProjects := NewProjectRolesMap(ctx, plan.ProjectRoleAssignments)
+	currentProjects := map[string][]string{}
+	for projectID, roleNames := range NewUserProjectRolesMap(user) {
+		_, inState := stateProjects[projectID]
+		_, inPlan := desiredProjects[projectID]
+		if inState || inPlan {
+			currentProjects[projectID] = roleNames
+		}
+	}
+	toAdd, toUpdate, toRemove := GetProjectRoleChanges(currentProjects, desiredProjects)
+	for projectID, roleNames := range toAdd {
+		req := &admin.GroupInvitationRequest{Username: admin.PtrString(username), Roles: conversion.Pointer(roleNames)}
+		if _, _, err := connV2.ProjectsApi.AddUserToProject(ctx, projectID, req).Execute(); err != nil {
+			return fmt.Errorf("error adding user to project %s: %w", projectID, err)
+		}
+	}
+	for projectID, roleNames := range toUpdate {
+		req := &admin.UpdateGroupRolesForUser{GroupRoles: conversion.Pointer(roleNames)}
+		if _, _, err := connV2.ProjectsApi.UpdateProjectRoles(ctx, projectID, userID, req).Execute(); err != nil {
+			return fmt.Errorf("error updating roles in project %s: %w", projectID, err)
+		}
+	}
+	for _, projectID := range toRemove {
+		if httpResp, err := connV2.ProjectsApi.RemoveProjectUser(ctx, projectID, userID).Execute(); err != nil && !isNotFound(httpResp) {
+			return fmt.Errorf("error removing user from project %s: %w", projectID, err)
+		}
+	}
+	return nil
+}
+
+// read returns the model of the user in the organization, or nil if the user is neither a member nor invited.
+func (r *rs) read(ctx context.Context, orgID, username, invitationID string, trackedProjects map[string][]string) (*TFModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	connV2 := r.Client.AtlasV2
+	user, err := getOrgUser(ctx, connV2, orgID, username)
+	if err != nil {
+		diags.AddError(errorRead, err.Error())
+		return nil, diags
+	}
+	if user != nil {
+		return NewTFModelFromUser(ctx, orgID, invitationID, user, trackedProjects)
+	}
+	invitation, err := getOrgInvitation(ctx, connV2, orgID, username)
+	if err != nil {
+		diags.AddError(errorRead, err.Error())
+		return nil, diags
+	}
+	if invitation == nil {
+		return nil, nil
+	}
+	return NewTFModelFromInvitation(ctx, orgID, invitation, trackedProjects)
+}
+
+func getOrgUser(ctx context.Context, connV2 *admin.APIClient, orgID, username string) (*admin.CloudAppUser, error) {
+	users, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.CloudAppUser], *http.Response, error) {
+		request := connV2.OrganizationsApi.ListOrganizationUsers(ctx, orgID)
+		request = request.PageNum(pageNum)
+		return request.Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if strings.EqualFold(users[i].GetUsername(), username) {
+			return &users[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func getOrgInvitation(ctx context.Context, connV2 *admin.APIClient, orgID, username string) (*admin.OrganizationInvitation, error) {
+	invitations, _, err := connV2.OrganizationsApi.ListOrganizationInvitations(ctx, orgID).Username(username).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for i := range invitations {
+		if strings.EqualFold(invitations[i].GetUsername(), username) {
+			return &invitations[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func isNotFound(httpResp *http.Response) bool {
+	return httpResp != nil && httpResp.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,103 @@
+package orguser
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/customplanmodifier"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the organization.",
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Email address that represents the username of the MongoDB Cloud user.",
+			},
+			"roles": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: "One or more organization level roles to assign to the MongoDB Cloud user.",
+			},
+			"project_role_assignments": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the project.",
+						},
+						"role_names": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							MarkdownDescription: "One or more project level roles to assign to the MongoDB Cloud user.",
+						},
+					},
+				},
+				MarkdownDescription: "Projects the MongoDB Cloud user belongs to and the roles granted in each of them. Only the projects defined here are managed by the resource.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					// user_id is unknown while the invitation is pending as it can be accepted between plan and apply
+					customplanmodifier.UseStateForUnknownIfSetStringPlanModifier(),
+				},
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the MongoDB Cloud user. Only set once the user is a member of the organization.",
+			},
+			"invitation_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the invitation sent to the user, if any.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Membership status of the user in the organization. Possible values are PENDING_INVITATION and ACTIVE.",
+			},
+		},
+	}
+}
+
+type TFModel struct {
+	OrgID                  types.String `tfsdk:"org_id"`
+	Username               types.String `tfsdk:"username"`
+	UserID                 types.String `tfsdk:"user_id"`
+	InvitationID           types.String `tfsdk:"invitation_id"`
+	Status                 types.String `tfsdk:"status"`
+	Roles                  types.Set    `tfsdk:"roles"`
+	ProjectRoleAssignments types.Set    `tfsdk:"project_role_assignments"`
+}
+
+type TFProjectRoleAssignmentModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	RoleNames types.Set    `tfsdk:"role_names"`
+}
+
+var ProjectRoleAssignmentObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"project_id": types.StringType,
+	"role_names": types.SetType{ElemType: types.StringType},
+}}
@@ -0,0 +1,161 @@
+package orguser_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const resourceName = "mongodbatlas_org_user.test"
+
+func TestAccOrgUser_pendingInvitation(t *testing.T) {
+	var (
+		orgID     = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectID = acc.ProjectIDExecution(t)
+		username  = acc.RandomEmail()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(orgID, projectID, username, []string{"ORG_MEMBER"}, []string{"GROUP_READ_ONLY"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "org_id", orgID),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "status", "PENDING_INVITATION"),
+					resource.TestCheckResourceAttrSet(resourceName, "invitation_id"),
+					resource.TestCheckNoResourceAttr(resourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "project_role_assignments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "project_role_assignments.0.project_id", projectID),
+				),
+			},
+			{
+				Config: configBasic(orgID, projectID, username, []string{"ORG_MEMBER", "ORG_BILLING_ADMIN"}, []string{"GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "project_role_assignments.0.role_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    importStateIDFunc(resourceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "username",
+				ImportStateVerifyIgnore:              []string{"project_role_assignments"},
+			},
+		},
+	})
+}
+
+func TestAccOrgUser_existingMember(t *testing.T) {
+	var (
+		orgID     = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectID = acc.ProjectIDExecution(t)
+		username  = os.Getenv("MONGODB_ATLAS_USERNAME")
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckAtlasUsername(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				ResourceName:  resourceName,
+				Config:        configBasic(orgID, projectID, username, []string{"ORG_OWNER"}, []string{"GROUP_OWNER"}),
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s-%s", orgID, username),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+					if status := states[0].Attributes["status"]; status != "ACTIVE" {
+						return fmt.Errorf("expected status ACTIVE, got %s", status)
+					}
+					if states[0].Attributes["user_id"] == "" {
+						return fmt.Errorf("expected user_id to be set")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func configBasic(orgID, projectID, username string, roles, projectRoles []string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_org_user" "test" {
+			org_id   = %[1]q
+			username = %[3]q
+			roles    = %[4]s
+
+			project_role_assignments = [{
+				project_id = %[2]q
+				role_names = %[5]s
+			}]
+		}
+	`, orgID, projectID, username,
+		strings.ReplaceAll(fmt.Sprintf("%+q", roles), " ", ","),
+		strings.ReplaceAll(fmt.Sprintf("%+q", projectRoles), " ", ","),
+	)
+}
+
+func checkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if !exists(rs) {
+			return fmt.Errorf("org user (%s) does not exist", rs.Primary.Attributes["username"])
+		}
+		return nil
+	}
+}
+
+func checkDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type == "mongodbatlas_org_user" && exists(rs) {
+			return fmt.Errorf("org user (%s) still exists", rs.Primary.Attributes["username"])
+		}
+	}
+	return nil
+}
+
+func exists(rs *terraform.ResourceState) bool {
+	orgID := rs.Primary.Attributes["org_id"]
+	username := rs.Primary.Attributes["username"]
+	invitations, _, err := acc.ConnV2().OrganizationsApi.ListOrganizationInvitations(context.Background(), orgID).Username(username).Execute()
+	if err == nil && len(invitations) > 0 {
+		return true
+	}
+	users, _, err := acc.ConnV2().OrganizationsApi.ListOrganizationUsers(context.Background(), orgID).Execute()
+	if err != nil {
+		return false
+	}
+	for _, user := range users.GetResults() {
+		if strings.EqualFold(user.GetUsername(), username) {
+			return true
+		}
+	}
+	return false
+}
+
+func importStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["org_id"], rs.Primary.Attributes["username"]), nil
+	}
+}
@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"sort"
 
 	"go.mongodb.org/atlas-sdk/v20241113004/admin"
 
@@ -207,3 +208,77 @@ func UpdateProjectBool(plan, state types.Bool, setting **bool) bool {
 	}
 	return false
 }
+
+// projectSettingsAttrs returns the project settings attributes of the model keyed by attribute name.
+func projectSettingsAttrs(tfProject *TFProjectRSModel) map[string]*types.Bool {
+	return map[string]*types.Bool{
+		"is_collect_database_specifics_statistics_enabled": &tfProject.IsCollectDatabaseSpecificsStatisticsEnabled,
+		"is_data_explorer_enabled":                         &tfProject.IsDataExplorerEnabled,
+		"is_extended_storage_sizes_enabled":                &tfProject.IsExtendedStorageSizesEnabled,
+		"is_performance_advisor_enabled":                   &tfProject.IsPerformanceAdvisorEnabled,
+		"is_realtime_performance_panel_enabled":            &tfProject.IsRealtimePerformancePanelEnabled,
+		"is_schema_advisor_enabled":                        &tfProject.IsSchemaAdvisorEnabled,
+		"is_slow_operation_thresholding_enabled":           &tfProject.IsSlowOperationThresholdingEnabled,
+	}
+}
+
+// NewProjectSettingsMap returns the known values of the project settings attributes keyed by attribute name.
+func NewProjectSettingsMap(tfProject *TFProjectRSModel) map[string]bool {
+	settings := make(map[string]bool)
+	for name, value := range projectSettingsAttrs(tfProject) {
+		if !value.IsNull() && !value.IsUnknown() {
+			settings[name] = value.ValueBool()
+		}
+	}
+	return settings
+}
+
+// GetSettingsDrift returns the sorted names of the settings whose current value differs from the applied one.
+func GetSettingsDrift(applied, current map[string]bool) []string {
+	var drift []string
+	for name, appliedValue := range applied {
+		if currentValue, ok := current[name]; ok && currentValue != appliedValue {
+			drift = append(drift, name)
+		}
+	}
+	sort.Strings(drift)
+	return drift
+}
+
+// RevertSettingsDrift sets the settings not defined in the config back to their applied values in the plan.
+// It returns the names of the settings that were changed in the plan.
+func RevertSettingsDrift(config, plan *TFProjectRSModel, applied map[string]bool) []string {
+	configAttrs := projectSettingsAttrs(config)
+	var reverted []string
+	for name, planValue := range projectSettingsAttrs(plan) {
+		appliedValue, ok := applied[name]
+		if !ok || !configAttrs[name].IsNull() {
+			continue
+		}
+		if planValue.IsUnknown() || planValue.IsNull() || planValue.ValueBool() != appliedValue {
+			*planValue = types.BoolValue(appliedValue)
+			reverted = append(reverted, name)
+		}
+	}
+	sort.Strings(reverted)
+	return reverted
+}
+
+// GetUnmanagedTeamsAndLimits returns the sorted team IDs and limit names that exist in Atlas but are not defined in the resource.
+func GetUnmanagedTeamsAndLimits(atlasProps *AdditionalProperties, tfTeams []TFTeamModel, tfLimits []TFLimitModel) (teamIDs, limitNames []string) {
+	definedTeams := NewTfTeamModelMap(tfTeams)
+	for _, team := range atlasProps.Teams.GetResults() {
+		if _, ok := definedTeams[types.StringValue(team.GetTeamId())]; !ok {
+			teamIDs = append(teamIDs, team.GetTeamId())
+		}
+	}
+	definedLimits := NewTfLimitModelMap(tfLimits)
+	for _, limit := range atlasProps.Limits {
+		if _, ok := definedLimits[types.StringValue(limit.Name)]; !ok {
+			limitNames = append(limitNames, limit.Name)
+		}
+	}
+	sort.Strings(teamIDs)
+	sort.Strings(limitNames)
+	return teamIDs, limitNames
+}
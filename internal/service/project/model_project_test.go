@@ -550,3 +550,57 @@ func TestUpdateProjectBool(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSettingsDrift(t *testing.T) {
+	applied := map[string]bool{"is_data_explorer_enabled": true, "is_schema_advisor_enabled": true, "is_performance_advisor_enabled": false}
+	current := map[string]bool{"is_data_explorer_enabled": false, "is_schema_advisor_enabled": true, "is_performance_advisor_enabled": true}
+	assert.Equal(t, []string{"is_data_explorer_enabled", "is_performance_advisor_enabled"}, project.GetSettingsDrift(applied, current))
+	assert.Empty(t, project.GetSettingsDrift(applied, applied))
+}
+
+func TestRevertSettingsDrift(t *testing.T) {
+	config := &project.TFProjectRSModel{
+		IsDataExplorerEnabled:  types.BoolNull(),
+		IsSchemaAdvisorEnabled: types.BoolValue(false),
+	}
+	plan := &project.TFProjectRSModel{
+		IsDataExplorerEnabled:             types.BoolValue(false),
+		IsSchemaAdvisorEnabled:            types.BoolValue(false),
+		IsPerformanceAdvisorEnabled:       types.BoolValue(true),
+		IsRealtimePerformancePanelEnabled: types.BoolValue(true),
+	}
+	applied := map[string]bool{
+		"is_data_explorer_enabled":              true,
+		"is_schema_advisor_enabled":             true,
+		"is_performance_advisor_enabled":        true,
+		"is_realtime_performance_panel_enabled": false,
+	}
+	reverted := project.RevertSettingsDrift(config, plan, applied)
+	assert.Equal(t, []string{"is_data_explorer_enabled", "is_realtime_performance_panel_enabled"}, reverted)
+	assert.Equal(t, types.BoolValue(true), plan.IsDataExplorerEnabled)
+	assert.Equal(t, types.BoolValue(false), plan.IsSchemaAdvisorEnabled, "settings defined in the config are not reverted")
+	assert.Equal(t, types.BoolValue(true), plan.IsPerformanceAdvisorEnabled)
+	assert.Equal(t, types.BoolValue(false), plan.IsRealtimePerformancePanelEnabled)
+}
+
+func TestGetUnmanagedTeamsAndLimits(t *testing.T) {
+	props := &project.AdditionalProperties{
+		Teams: &admin.PaginatedTeamRole{
+			Results: &[]admin.TeamRole{
+				{TeamId: conversion.StringPtr("team2")},
+				{TeamId: conversion.StringPtr("team1")},
+				{TeamId: conversion.StringPtr("team3")},
+			},
+		},
+		Limits: []admin.DataFederationLimit{
+			{Name: "atlas.project.deployment.clusters"},
+			{Name: limitName},
+		},
+	}
+	teamIDs, limitNames := project.GetUnmanagedTeamsAndLimits(props,
+		[]project.TFTeamModel{{TeamID: types.StringValue("team1")}},
+		[]project.TFLimitModel{{Name: types.StringValue(limitName)}},
+	)
+	assert.Equal(t, []string{"team2", "team3"}, teamIDs)
+	assert.Equal(t, []string{"atlas.project.deployment.clusters"}, limitNames)
+}
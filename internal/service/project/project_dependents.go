@@ -0,0 +1,446 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+)
+
+const (
+	DependentTypeStreamInstance          = "stream instance"
+	DependentTypeFederatedDatabase       = "federated database instance"
+	DependentTypeFederationEndpoint      = "data federation private endpoint"
+	DependentTypeCluster                 = "cluster"
+	DependentTypeServerlessInstance      = "serverless instance"
+	DependentTypeFlexCluster             = "flex cluster"
+	DependentTypePrivateEndpoint         = "private endpoint"
+	DependentTypePeeringConnection       = "network peering connection"
+	DependentTypePrivateEndpointService  = "private endpoint service"
+	projectDependentsDeleteTimeout       = 2 * time.Hour
+	projectDependentsDeleteMinTimeout    = 30 * time.Second
+	errorProjectDependentsBlockingDelete = "project %s can't be deleted until the following resources are deleted: %s. Delete them first or set force_destroy to true to delete them along with the project"
+)
+
+// Stages in which project dependents are deleted with force_destroy, each stage only starts when the previous one is gone.
+const (
+	StageStreamInstances = iota
+	StageFederatedDatabases
+	StageDeployments
+	StagePrivateEndpoints
+	StagePrivateEndpointServices
+)
+
+var (
+	privateEndpointProviders = []string{constant.AWS, constant.AZURE, constant.GCP}
+	// errorCodeFeatureUnavailable matches the error codes returned when listing dependents of a feature that is not enabled or supported in the project.
+	errorCodeFeatureUnavailable = regexp.MustCompile(`(NOT_ENABLED|NOT_SUPPORTED|UNSUPPORTED)`)
+)
+
+// ProjectDependent is a resource that prevents a project from being deleted.
+type ProjectDependent struct {
+	delete    func(ctx context.Context) error
+	Type      string
+	Name      string
+	StateName string
+	Stage     int
+}
+
+func (d *ProjectDependent) String() string {
+	return fmt.Sprintf("%s %q", d.Type, d.Name)
+}
+
+// IsDeleting returns true if the dependent is already being deleted.
+func (d *ProjectDependent) IsDeleting() bool {
+	switch d.StateName {
+	case "DELETING", "DELETED", "TERMINATING":
+		return true
+	}
+	return false
+}
+
+// DependentsAPIs groups the APIs used to find and delete the resources that depend on a project.
+type DependentsAPIs struct {
+	ClustersAPI         admin.ClustersApi
+	ServerlessAPI       admin.ServerlessInstancesApi
+	FlexClustersAPI     admin.FlexClustersApi
+	StreamsAPI          admin.StreamsApi
+	DataFederationAPI   admin.DataFederationApi
+	PrivateEndpointsAPI admin.PrivateEndpointServicesApi
+	NetworkPeeringAPI   admin.NetworkPeeringApi
+}
+
+func NewDependentsAPIs(connV2 *admin.APIClient) *DependentsAPIs {
+	return &DependentsAPIs{
+		ClustersAPI:         connV2.ClustersApi,
+		ServerlessAPI:       connV2.ServerlessInstancesApi,
+		FlexClustersAPI:     connV2.FlexClustersApi,
+		StreamsAPI:          connV2.StreamsApi,
+		DataFederationAPI:   connV2.DataFederationApi,
+		PrivateEndpointsAPI: connV2.PrivateEndpointServicesApi,
+		NetworkPeeringAPI:   connV2.NetworkPeeringApi,
+	}
+}
+
+// ListProjectDependents returns the resources that prevent the project from being deleted, sorted in the order they must be deleted.
+func ListProjectDependents(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	listers := []func(context.Context, *DependentsAPIs, string) ([]ProjectDependent, error){
+		listStreamInstances,
+		listFederatedDatabases,
+		listFederationEndpoints,
+		listClusters,
+		listServerlessInstances,
+		listFlexClusters,
+		listPrivateEndpointServices,
+		listPeeringConnections,
+	}
+	var dependents []ProjectDependent
+	for _, list := range listers {
+		found, err := list(ctx, apis, projectID)
+		if err != nil {
+			if !isListUnavailable(err) {
+				return nil, err
+			}
+			tflog.Warn(ctx, fmt.Sprintf("skipping project %s dependents that can't be listed: %s", projectID, err))
+			continue
+		}
+		dependents = append(dependents, found...)
+	}
+	sort.SliceStable(dependents, func(i, j int) bool { return dependents[i].Stage < dependents[j].Stage })
+	return dependents, nil
+}
+
+// GetBlockingDependents returns the dependents that are not being deleted already.
+func GetBlockingDependents(dependents []ProjectDependent) []ProjectDependent {
+	var blockers []ProjectDependent
+	for i := range dependents {
+		if !dependents[i].IsDeleting() {
+			blockers = append(blockers, dependents[i])
+		}
+	}
+	return blockers
+}
+
+// NewBlockingDependentsError returns the error reported when the project can't be deleted because of its dependents.
+func NewBlockingDependentsError(projectID string, blockers []ProjectDependent) error {
+	names := make([]string, len(blockers))
+	for i := range blockers {
+		names[i] = blockers[i].String()
+	}
+	return fmt.Errorf(errorProjectDependentsBlockingDelete, projectID, strings.Join(names, ", "))
+}
+
+// DeleteProjectDependents deletes the project dependents stage by stage, waiting for each stage to be gone before starting the next one.
+func DeleteProjectDependents(ctx context.Context, apis *DependentsAPIs, projectID string) error {
+	for stage := StageStreamInstances; stage <= StagePrivateEndpointServices; stage++ {
+		dependents, err := ListProjectDependents(ctx, apis, projectID)
+		if err != nil {
+			return err
+		}
+		for i := range dependents {
+			dependent := &dependents[i]
+			if dependent.Stage != stage || dependent.IsDeleting() {
+				continue
+			}
+			tflog.Info(ctx, fmt.Sprintf("deleting %s in project %s", dependent, projectID))
+			if err := dependent.delete(ctx); err != nil {
+				return fmt.Errorf("error deleting %s: %w", dependent, err)
+			}
+		}
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{projectDependentsStateDeleting},
+			Target:     []string{projectDependentsStateIdle},
+			Refresh:    ResourceProjectDependentsStageRefreshFunc(ctx, apis, projectID, stage),
+			Timeout:    projectDependentsDeleteTimeout,
+			MinTimeout: projectDependentsDeleteMinTimeout,
+			Delay:      0,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for project %s dependents to be deleted: %w", projectID, err)
+		}
+	}
+	return nil
+}
+
+// ResourceProjectDependentsStageRefreshFunc reports the project dependents of the stage as deleting until none of them is left.
+func ResourceProjectDependentsStageRefreshFunc(ctx context.Context, apis *DependentsAPIs, projectID string, stage int) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		dependents, err := ListProjectDependents(ctx, apis, projectID)
+		if err != nil {
+			return nil, "", err
+		}
+		var pending []ProjectDependent
+		for i := range dependents {
+			if dependents[i].Stage == stage {
+				pending = append(pending, dependents[i])
+			}
+		}
+		if len(pending) > 0 {
+			return pending, projectDependentsStateDeleting, nil
+		}
+		return pending, projectDependentsStateIdle, nil
+	}
+}
+
+func listStreamInstances(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	instances, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.StreamsTenant], *http.Response, error) {
+		return apis.StreamsAPI.ListStreamInstances(ctx, projectID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing stream instances: %w", err)
+	}
+	dependents := make([]ProjectDependent, len(instances))
+	for i := range instances {
+		name := instances[i].GetName()
+		dependents[i] = ProjectDependent{
+			Type:  DependentTypeStreamInstance,
+			Name:  name,
+			Stage: StageStreamInstances,
+			delete: func(ctx context.Context) error {
+				_, httpResp, err := apis.StreamsAPI.DeleteStreamInstance(ctx, projectID, name).Execute()
+				return ignoreNotFound(httpResp, err)
+			},
+		}
+	}
+	return dependents, nil
+}
+
+func listFederatedDatabases(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	tenants, _, err := apis.DataFederationAPI.ListFederatedDatabases(ctx, projectID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error listing federated database instances: %w", err)
+	}
+	dependents := make([]ProjectDependent, len(tenants))
+	for i := range tenants {
+		name := tenants[i].GetName()
+		dependents[i] = ProjectDependent{
+			Type:      DependentTypeFederatedDatabase,
+			Name:      name,
+			StateName: tenants[i].GetState(),
+			Stage:     StageFederatedDatabases,
+			delete: func(ctx context.Context) error {
+				_, httpResp, err := apis.DataFederationAPI.DeleteFederatedDatabase(ctx, projectID, name).Execute()
+				return ignoreNotFound(httpResp, err)
+			},
+		}
+	}
+	return dependents, nil
+}
+
+func listFederationEndpoints(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	endpoints, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.PrivateNetworkEndpointIdEntry], *http.Response, error) {
+		return apis.DataFederationAPI.ListDataFederationPrivateEndpoints(ctx, projectID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing data federation private endpoints: %w", err)
+	}
+	dependents := make([]ProjectDependent, len(endpoints))
+	for i := range endpoints {
+		endpointID := endpoints[i].GetEndpointId()
+		dependents[i] = ProjectDependent{
+			Type:      DependentTypeFederationEndpoint,
+			Name:      endpointID,
+			StateName: endpoints[i].GetStatus(),
+			Stage:     StageFederatedDatabases,
+			delete: func(ctx context.Context) error {
+				_, httpResp, err := apis.DataFederationAPI.DeleteDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
+				return ignoreNotFound(httpResp, err)
+			},
+		}
+	}
+	return dependents, nil
+}
+
+func listClusters(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	clusters, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.ClusterDescription20240805], *http.Response, error) {
+		return apis.ClustersAPI.ListClusters(ctx, projectID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing clusters: %w", err)
+	}
+	dependents := make([]ProjectDependent, len(clusters))
+	for i := range clusters {
+		name := clusters[i].GetName()
+		dependents[i] = ProjectDependent{
+			Type:      DependentTypeCluster,
+			Name:      name,
+			StateName: clusters[i].GetStateName(),
+			Stage:     StageDeployments,
+			delete: func(ctx context.Context) error {
+				httpResp, err := apis.ClustersAPI.DeleteCluster(ctx, projectID, name).Execute()
+				return ignoreNotFound(httpResp, err)
+			},
+		}
+	}
+	return dependents, nil
+}
+
+func listServerlessInstances(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	instances, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.ServerlessInstanceDescription], *http.Response, error) {
+		return apis.ServerlessAPI.ListServerlessInstances(ctx, projectID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing serverless instances: %w", err)
+	}
+	dependents := make([]ProjectDependent, len(instances))
+	for i := range instances {
+		name := instances[i].GetName()
+		dependents[i] = ProjectDependent{
+			Type:      DependentTypeServerlessInstance,
+			Name:      name,
+			StateName: instances[i].GetStateName(),
+			Stage:     StageDeployments,
+			delete: func(ctx context.Context) error {
+				_, httpResp, err := apis.ServerlessAPI.DeleteServerlessInstance(ctx, projectID, name).Execute()
+				return ignoreNotFound(httpResp, err)
+			},
+		}
+	}
+	return dependents, nil
+}
+
+func listFlexClusters(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	clusters, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.FlexClusterDescription20241113], *http.Response, error) {
+		return apis.FlexClustersAPI.ListFlexClusters(ctx, projectID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing flex clusters: %w", err)
+	}
+	dependents := make([]ProjectDependent, len(clusters))
+	for i := range clusters {
+		name := clusters[i].GetName()
+		dependents[i] = ProjectDependent{
+			Type:      DependentTypeFlexCluster,
+			Name:      name,
+			StateName: clusters[i].GetStateName(),
+			Stage:     StageDeployments,
+			delete: func(ctx context.Context) error {
+				_, httpResp, err := apis.FlexClustersAPI.DeleteFlexCluster(ctx, projectID, name).Execute()
+				return ignoreNotFound(httpResp, err)
+			},
+		}
+	}
+	return dependents, nil
+}
+
+func listPrivateEndpointServices(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	var dependents []ProjectDependent
+	for _, provider := range privateEndpointProviders {
+		services, _, err := apis.PrivateEndpointsAPI.ListPrivateEndpointServices(ctx, projectID, provider).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s private endpoint services: %w", provider, err)
+		}
+		for i := range services {
+			serviceID := services[i].GetId()
+			for _, endpointID := range getPrivateEndpointIDs(&services[i]) {
+				endpoint, httpResp, err := apis.PrivateEndpointsAPI.GetPrivateEndpoint(ctx, projectID, provider, endpointID, serviceID).Execute()
+				if err != nil {
+					if ignoreNotFound(httpResp, err) == nil {
+						continue
+					}
+					return nil, fmt.Errorf("error getting %s private endpoint %s: %w", provider, endpointID, err)
+				}
+				dependents = append(dependents, ProjectDependent{
+					Type:      DependentTypePrivateEndpoint,
+					Name:      fmt.Sprintf("%s/%s", provider, endpointID),
+					StateName: getPrivateEndpointStateName(endpoint),
+					Stage:     StagePrivateEndpoints,
+					delete: func(ctx context.Context) error {
+						_, httpResp, err := apis.PrivateEndpointsAPI.DeletePrivateEndpoint(ctx, projectID, provider, endpointID, serviceID).Execute()
+						return ignoreNotFound(httpResp, err)
+					},
+				})
+			}
+			dependents = append(dependents, ProjectDependent{
+				Type:      DependentTypePrivateEndpointService,
+				Name:      fmt.Sprintf("%s/%s", provider, serviceID),
+				StateName: services[i].GetStatus(),
+				Stage:     StagePrivateEndpointServices,
+				delete: func(ctx context.Context) error {
+					_, httpResp, err := apis.PrivateEndpointsAPI.DeletePrivateEndpointService(ctx, projectID, provider, serviceID).Execute()
+					return ignoreNotFound(httpResp, err)
+				},
+			})
+		}
+	}
+	return dependents, nil
+}
+
+func getPrivateEndpointIDs(service *admin.EndpointService) []string {
+	switch service.GetCloudProvider() {
+	case constant.AZURE:
+		return service.GetPrivateEndpoints()
+	case constant.GCP:
+		return service.GetEndpointGroupNames()
+	default:
+		return service.GetInterfaceEndpoints()
+	}
+}
+
+// getPrivateEndpointStateName returns the connection status of AWS endpoints, Azure and GCP endpoints use the status instead.
+func getPrivateEndpointStateName(endpoint *admin.PrivateLinkEndpoint) string {
+	if endpoint.GetCloudProvider() == constant.AWS {
+		return endpoint.GetConnectionStatus()
+	}
+	return endpoint.GetStatus()
+}
+
+func listPeeringConnections(ctx context.Context, apis *DependentsAPIs, projectID string) ([]ProjectDependent, error) {
+	var dependents []ProjectDependent
+	for _, provider := range privateEndpointProviders {
+		peers, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.BaseNetworkPeeringConnectionSettings], *http.Response, error) {
+			return apis.NetworkPeeringAPI.ListPeeringConnections(ctx, projectID).ProviderName(provider).PageNum(pageNum).Execute()
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s network peering connections: %w", provider, err)
+		}
+		for i := range peers {
+			peerID := peers[i].GetId()
+			stateName := peers[i].GetStatusName()
+			if stateName == "" {
+				stateName = peers[i].GetStatus()
+			}
+			dependents = append(dependents, ProjectDependent{
+				Type:      DependentTypePeeringConnection,
+				Name:      fmt.Sprintf("%s/%s", provider, peerID),
+				StateName: stateName,
+				Stage:     StagePrivateEndpoints,
+				delete: func(ctx context.Context) error {
+					_, httpResp, err := apis.NetworkPeeringAPI.DeletePeeringConnection(ctx, projectID, peerID).Execute()
+					return ignoreNotFound(httpResp, err)
+				},
+			})
+		}
+	}
+	return dependents, nil
+}
+
+// isListUnavailable returns true when the dependents can't be listed because the API key lacks permissions or the feature is not available in the project.
+func isListUnavailable(err error) bool {
+	apiError, ok := admin.AsError(err)
+	if !ok {
+		return false
+	}
+	switch apiError.GetError() {
+	case http.StatusForbidden, http.StatusNotFound:
+		return true
+	}
+	return errorCodeFeatureUnavailable.MatchString(apiError.GetErrorCode())
+}
+
+func ignoreNotFound(httpResp *http.Response, err error) error {
+	if err != nil && httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,169 @@
+package project_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+	"go.mongodb.org/atlas-sdk/v20241113004/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/project"
+)
+
+type dependentsMocks struct {
+	clusters         *mockadmin.ClustersApi
+	serverless       *mockadmin.ServerlessInstancesApi
+	flexClusters     *mockadmin.FlexClustersApi
+	streams          *mockadmin.StreamsApi
+	dataFederation   *mockadmin.DataFederationApi
+	privateEndpoints *mockadmin.PrivateEndpointServicesApi
+	networkPeering   *mockadmin.NetworkPeeringApi
+}
+
+func (m *dependentsMocks) apis() *project.DependentsAPIs {
+	return &project.DependentsAPIs{
+		ClustersAPI:         m.clusters,
+		ServerlessAPI:       m.serverless,
+		FlexClustersAPI:     m.flexClusters,
+		StreamsAPI:          m.streams,
+		DataFederationAPI:   m.dataFederation,
+		PrivateEndpointsAPI: m.privateEndpoints,
+		NetworkPeeringAPI:   m.networkPeering,
+	}
+}
+
+// newDependentsMocks returns mocks listing a cluster, a stream instance, a federated database instance and an AWS private endpoint service with two endpoints.
+// When streamsErr is set, listing the stream instances fails with it.
+func newDependentsMocks(t *testing.T, streamsErr error) *dependentsMocks {
+	t.Helper()
+	m := &dependentsMocks{
+		clusters:         mockadmin.NewClustersApi(t),
+		serverless:       mockadmin.NewServerlessInstancesApi(t),
+		flexClusters:     mockadmin.NewFlexClustersApi(t),
+		streams:          mockadmin.NewStreamsApi(t),
+		dataFederation:   mockadmin.NewDataFederationApi(t),
+		privateEndpoints: mockadmin.NewPrivateEndpointServicesApi(t),
+		networkPeering:   mockadmin.NewNetworkPeeringApi(t),
+	}
+	m.streams.EXPECT().ListStreamInstances(mock.Anything, dummyProjectID).Return(admin.ListStreamInstancesApiRequest{ApiService: m.streams})
+	if streamsErr != nil {
+		m.streams.EXPECT().ListStreamInstancesExecute(mock.Anything).Return(nil, nil, streamsErr)
+	} else {
+		m.streams.EXPECT().ListStreamInstancesExecute(mock.Anything).Return(&admin.PaginatedApiStreamsTenant{
+			Results:    &[]admin.StreamsTenant{{Name: conversion.StringPtr("stream1")}},
+			TotalCount: conversion.IntPtr(1),
+		}, nil, nil)
+	}
+	m.dataFederation.EXPECT().ListFederatedDatabases(mock.Anything, dummyProjectID).Return(admin.ListFederatedDatabasesApiRequest{ApiService: m.dataFederation})
+	m.dataFederation.EXPECT().ListFederatedDatabasesExecute(mock.Anything).Return([]admin.DataLakeTenant{
+		{Name: conversion.StringPtr("federated1"), State: conversion.StringPtr("ACTIVE")},
+	}, nil, nil)
+	m.dataFederation.EXPECT().ListDataFederationPrivateEndpoints(mock.Anything, dummyProjectID).Return(admin.ListDataFederationPrivateEndpointsApiRequest{ApiService: m.dataFederation})
+	m.dataFederation.EXPECT().ListDataFederationPrivateEndpointsExecute(mock.Anything).Return(&admin.PaginatedPrivateNetworkEndpointIdEntry{}, nil, nil)
+	m.clusters.EXPECT().ListClusters(mock.Anything, dummyProjectID).Return(admin.ListClustersApiRequest{ApiService: m.clusters})
+	m.clusters.EXPECT().ListClustersExecute(mock.Anything).Return(&admin.PaginatedClusterDescription20240805{
+		Results: &[]admin.ClusterDescription20240805{
+			{Name: conversion.StringPtr("cluster1"), StateName: conversion.StringPtr("IDLE")},
+			{Name: conversion.StringPtr("cluster2"), StateName: conversion.StringPtr("DELETING")},
+		},
+		TotalCount: conversion.IntPtr(2),
+	}, nil, nil)
+	m.serverless.EXPECT().ListServerlessInstances(mock.Anything, dummyProjectID).Return(admin.ListServerlessInstancesApiRequest{ApiService: m.serverless})
+	m.serverless.EXPECT().ListServerlessInstancesExecute(mock.Anything).Return(&admin.PaginatedServerlessInstanceDescription{}, nil, nil)
+	m.flexClusters.EXPECT().ListFlexClusters(mock.Anything, dummyProjectID).Return(admin.ListFlexClustersApiRequest{ApiService: m.flexClusters})
+	m.flexClusters.EXPECT().ListFlexClustersExecute(mock.Anything).Return(&admin.PaginatedFlexClusters20241113{}, nil, nil)
+	for _, provider := range []string{"AWS", "AZURE", "GCP"} {
+		services := []admin.EndpointService{}
+		if provider == "AWS" {
+			services = append(services, admin.EndpointService{
+				Id:                 conversion.StringPtr("service1"),
+				CloudProvider:      "AWS",
+				Status:             conversion.StringPtr("AVAILABLE"),
+				InterfaceEndpoints: &[]string{"vpce-1", "vpce-2"},
+			})
+			for endpointID, status := range map[string]string{"vpce-1": "AVAILABLE", "vpce-2": "DELETING"} {
+				m.privateEndpoints.EXPECT().GetPrivateEndpoint(mock.Anything, dummyProjectID, provider, endpointID, "service1").Return(admin.GetPrivateEndpointApiRequest{ApiService: m.privateEndpoints}).Once()
+				m.privateEndpoints.EXPECT().GetPrivateEndpointExecute(mock.Anything).Return(&admin.PrivateLinkEndpoint{
+					CloudProvider:    "AWS",
+					ConnectionStatus: conversion.StringPtr(status),
+				}, nil, nil).Once()
+			}
+		}
+		m.privateEndpoints.EXPECT().ListPrivateEndpointServices(mock.Anything, dummyProjectID, provider).Return(admin.ListPrivateEndpointServicesApiRequest{ApiService: m.privateEndpoints}).Once()
+		m.privateEndpoints.EXPECT().ListPrivateEndpointServicesExecute(mock.Anything).Return(services, nil, nil).Once()
+	}
+	m.networkPeering.EXPECT().ListPeeringConnections(mock.Anything, dummyProjectID).Return(admin.ListPeeringConnectionsApiRequest{ApiService: m.networkPeering})
+	m.networkPeering.EXPECT().ListPeeringConnectionsExecute(mock.Anything).Return(&admin.PaginatedContainerPeer{}, nil, nil)
+	return m
+}
+
+func TestListProjectDependents(t *testing.T) {
+	dependents, err := project.ListProjectDependents(context.Background(), newDependentsMocks(t, nil).apis(), dummyProjectID)
+	require.NoError(t, err)
+
+	names := make([]string, len(dependents))
+	stages := make([]int, len(dependents))
+	for i := range dependents {
+		names[i] = dependents[i].String()
+		stages[i] = dependents[i].Stage
+	}
+	assert.Equal(t, []string{
+		`stream instance "stream1"`,
+		`federated database instance "federated1"`,
+		`cluster "cluster1"`,
+		`cluster "cluster2"`,
+		`private endpoint "AWS/vpce-1"`,
+		`private endpoint "AWS/vpce-2"`,
+		`private endpoint service "AWS/service1"`,
+	}, names)
+	assert.Equal(t, []int{
+		project.StageStreamInstances,
+		project.StageFederatedDatabases,
+		project.StageDeployments,
+		project.StageDeployments,
+		project.StagePrivateEndpoints,
+		project.StagePrivateEndpoints,
+		project.StagePrivateEndpointServices,
+	}, stages)
+
+	blockers := project.GetBlockingDependents(dependents)
+	assert.Len(t, blockers, 5, "clusters and private endpoints being deleted are not blockers")
+	assert.EqualError(t, project.NewBlockingDependentsError(dummyProjectID, blockers[:2]),
+		`project 6575af27f93c7a6a4b50b239 can't be deleted until the following resources are deleted: stream instance "stream1", federated database instance "federated1". Delete them first or set force_destroy to true to delete them along with the project`)
+}
+
+func TestListProjectDependentsError(t *testing.T) {
+	streams := mockadmin.NewStreamsApi(t)
+	streams.EXPECT().ListStreamInstances(mock.Anything, dummyProjectID).Return(admin.ListStreamInstancesApiRequest{ApiService: streams})
+	streams.EXPECT().ListStreamInstancesExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusInternalServerError}, apiError(http.StatusInternalServerError, "UNEXPECTED_ERROR"))
+
+	_, err := project.ListProjectDependents(context.Background(), &project.DependentsAPIs{StreamsAPI: streams}, dummyProjectID)
+	require.ErrorContains(t, err, "error listing stream instances")
+}
+
+func TestListProjectDependentsSkipsUnavailable(t *testing.T) {
+	testCases := map[string]error{
+		"forbidden":           apiError(http.StatusForbidden, "USER_UNAUTHORIZED"),
+		"not found":           apiError(http.StatusNotFound, "RESOURCE_NOT_FOUND"),
+		"feature not enabled": apiError(http.StatusBadRequest, "STREAMS_NOT_ENABLED"),
+	}
+	for name, streamsErr := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dependents, err := project.ListProjectDependents(context.Background(), newDependentsMocks(t, streamsErr).apis(), dummyProjectID)
+			require.NoError(t, err)
+			assert.Len(t, dependents, 6, "stream instances are skipped")
+			assert.Equal(t, `federated database instance "federated1"`, dependents[0].String())
+		})
+	}
+}
+
+func apiError(status int, errorCode string) error {
+	err := &admin.GenericOpenAPIError{}
+	err.SetModel(admin.ApiError{Error: status, ErrorCode: errorCode})
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"go.mongodb.org/atlas-sdk/v20241113004/admin"
@@ -32,10 +33,12 @@ const (
 	projectDependentsStateDeleting = "DELETING"
 	projectDependentsStateRetry    = "RETRY"
 	projectResourceName            = "project"
+	appliedSettingsPrivateKey      = "appliedSettings"
 )
 
 var _ resource.ResourceWithConfigure = &projectRS{}
 var _ resource.ResourceWithImportState = &projectRS{}
+var _ resource.ResourceWithModifyPlan = &projectRS{}
 
 func Resource() resource.Resource {
 	return &projectRS{
@@ -92,7 +95,7 @@ func (r *projectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 
 		_, _, err := connV2.TeamsApi.AddAllTeamsToProject(ctx, project.GetId(), NewTeamRoleList(ctx, teams)).Execute()
 		if err != nil {
-			errd := deleteProject(ctx, connV2.ClustersApi, connV2.ProjectsApi, project.GetId())
+			errd := deleteProject(ctx, connV2, project.GetId(), false)
 			if errd != nil {
 				resp.Diagnostics.AddError("error during project deletion when adding teams", fmt.Sprintf(errorProjectDelete, project.GetId(), err.Error()))
 				return
@@ -113,7 +116,7 @@ func (r *projectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 			}
 			_, _, err := connV2.ProjectsApi.SetProjectLimit(ctx, limit.Name.ValueString(), project.GetId(), dataFederationLimit).Execute()
 			if err != nil {
-				errd := deleteProject(ctx, connV2.ClustersApi, connV2.ProjectsApi, project.GetId())
+				errd := deleteProject(ctx, connV2, project.GetId(), false)
 				if errd != nil {
 					resp.Diagnostics.AddError("error during project deletion when adding limits", fmt.Sprintf(errorProjectDelete, project.GetId(), err.Error()))
 					return
@@ -127,7 +130,7 @@ func (r *projectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 	// add settings
 	projectSettings, _, err := connV2.ProjectsApi.GetProjectSettings(ctx, *project.Id).Execute()
 	if err != nil {
-		errd := deleteProject(ctx, connV2.ClustersApi, connV2.ProjectsApi, project.GetId())
+		errd := deleteProject(ctx, connV2, project.GetId(), false)
 		if errd != nil {
 			resp.Diagnostics.AddError("error during project deletion when getting project settings", fmt.Sprintf(errorProjectDelete, project.GetId(), err.Error()))
 			return
@@ -144,7 +147,7 @@ func (r *projectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 	SetProjectBool(projectPlan.IsSchemaAdvisorEnabled, &projectSettings.IsSchemaAdvisorEnabled)
 
 	if _, _, err = connV2.ProjectsApi.UpdateProjectSettings(ctx, project.GetId(), projectSettings).Execute(); err != nil {
-		errd := deleteProject(ctx, connV2.ClustersApi, connV2.ProjectsApi, project.GetId())
+		errd := deleteProject(ctx, connV2, project.GetId(), false)
 		if errd != nil {
 			resp.Diagnostics.AddError("error during project deletion when updating project settings", fmt.Sprintf(errorProjectDelete, project.GetId(), err.Error()))
 			return
@@ -174,6 +177,7 @@ func (r *projectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	filteredLimits := FilterUserDefinedLimits(projectProps.Limits, limits)
 	projectProps.Limits = filteredLimits
+	projectProps.Teams = FilterUserDefinedTeams(projectProps.Teams, teams)
 
 	projectPlanNew, diags := NewTFProjectResourceModel(ctx, projectRes, *projectProps)
 	resp.Diagnostics.Append(diags...)
@@ -181,6 +185,7 @@ func (r *projectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 	updatePlanFromConfig(projectPlanNew, &projectPlan)
+	resp.Diagnostics.Append(setAppliedSettings(ctx, resp.Private, NewProjectSettingsMap(projectPlanNew))...)
 
 	// set state to fully populated data
 	diags = resp.State.Set(ctx, projectPlanNew)
@@ -224,8 +229,23 @@ func (r *projectRS) Read(ctx context.Context, req resource.ReadRequest, resp *re
 		return
 	}
 
-	filteredLimits := FilterUserDefinedLimits(projectProps.Limits, limits)
-	projectProps.Limits = filteredLimits
+	authoritativeSettings := projectState.AuthoritativeSettings.ValueString()
+	var stateTeams []TFTeamModel
+	_ = projectState.Teams.ElementsAs(ctx, &stateTeams, false)
+	if authoritativeSettings == AuthoritativeSettingsDetect {
+		warnUnmanagedTeamsAndLimits(projectID, projectProps, stateTeams, limits, &resp.Diagnostics)
+	}
+
+	// in enforce mode all limits and teams are read so the ones not defined in the resource are removed on apply
+	if authoritativeSettings != AuthoritativeSettingsEnforce {
+		filteredLimits := FilterUserDefinedLimits(projectProps.Limits, limits)
+		projectProps.Limits = filteredLimits
+
+		// teams are null only after import, in that case all teams assigned to the project are read
+		if !projectState.Teams.IsNull() {
+			projectProps.Teams = FilterUserDefinedTeams(projectProps.Teams, stateTeams)
+		}
+	}
 
 	projectStateNew, diags := NewTFProjectResourceModel(ctx, projectRes, *projectProps)
 	resp.Diagnostics.Append(diags...)
@@ -234,6 +254,27 @@ func (r *projectRS) Read(ctx context.Context, req resource.ReadRequest, resp *re
 	}
 	updatePlanFromConfig(projectStateNew, &projectState)
 
+	if authoritativeSettings != "" {
+		applied, diags := getAppliedSettings(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if applied == nil {
+			applied = NewProjectSettingsMap(&projectState)
+		}
+		current := NewProjectSettingsMap(projectStateNew)
+		if drift := GetSettingsDrift(applied, current); len(drift) > 0 {
+			detail := fmt.Sprintf("The following settings of project %s no longer match the values applied by Terraform: %s.", projectID, strings.Join(drift, ", "))
+			if authoritativeSettings == AuthoritativeSettingsEnforce {
+				detail += " They will be reverted on the next apply unless they are defined in the configuration."
+			}
+			resp.Diagnostics.AddWarning("Project settings changed outside of Terraform", detail)
+		}
+		// in detect mode changes are only reported until the refreshed state is saved
+		if authoritativeSettings == AuthoritativeSettingsDetect {
+			applied = current
+		}
+		resp.Diagnostics.Append(setAppliedSettings(ctx, resp.Private, applied)...)
+	}
+
 	// save read data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &projectStateNew)...)
 	if resp.Diagnostics.HasError() {
@@ -300,12 +341,17 @@ func (r *projectRS) Update(ctx context.Context, req resource.UpdateRequest, resp
 	filteredLimits := FilterUserDefinedLimits(projectProps.Limits, planLimits)
 	projectProps.Limits = filteredLimits
 
+	var planTeams []TFTeamModel
+	_ = projectPlan.Teams.ElementsAs(ctx, &planTeams, false)
+	projectProps.Teams = FilterUserDefinedTeams(projectProps.Teams, planTeams)
+
 	projectPlanNew, diags := NewTFProjectResourceModel(ctx, projectRes, *projectProps)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	updatePlanFromConfig(projectPlanNew, &projectPlan)
+	resp.Diagnostics.Append(setAppliedSettings(ctx, resp.Private, NewProjectSettingsMap(projectPlanNew))...)
 
 	// save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &projectPlanNew)...)
@@ -321,7 +367,7 @@ func (r *projectRS) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	}
 
 	projectID := project.ID.ValueString()
-	err := deleteProject(ctx, r.Client.AtlasV2.ClustersApi, r.Client.AtlasV2.ProjectsApi, projectID)
+	err := deleteProject(ctx, r.Client.AtlasV2, projectID, project.ForceDestroy.ValueBool())
 
 	if err != nil {
 		resp.Diagnostics.AddError("error when destroying resource", fmt.Sprintf(errorProjectDelete, projectID, err.Error()))
@@ -333,11 +379,34 @@ func (r *projectRS) ImportState(ctx context.Context, req resource.ImportStateReq
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// ModifyPlan reverts the settings changed outside of Terraform when authoritative_settings is ENFORCE.
+func (r *projectRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var projectPlan, projectConfig TFProjectRSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &projectPlan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &projectConfig)...)
+	if resp.Diagnostics.HasError() || projectPlan.AuthoritativeSettings.ValueString() != AuthoritativeSettingsEnforce {
+		return
+	}
+	applied, diags := getAppliedSettings(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if applied == nil {
+		return
+	}
+	if reverted := RevertSettingsDrift(&projectConfig, &projectPlan, applied); len(reverted) > 0 {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &projectPlan)...)
+	}
+}
+
 func updatePlanFromConfig(projectPlanNewPtr, projectPlan *TFProjectRSModel) {
 	// we need to reset defaults from what was previously in the state:
 	// https://discuss.hashicorp.com/t/boolean-optional-default-value-migration-to-framework/55932
 	projectPlanNewPtr.WithDefaultAlertsSettings = projectPlan.WithDefaultAlertsSettings
 	projectPlanNewPtr.ProjectOwnerID = projectPlan.ProjectOwnerID
+	projectPlanNewPtr.AuthoritativeSettings = projectPlan.AuthoritativeSettings
+	projectPlanNewPtr.ForceDestroy = projectPlan.ForceDestroy
 	if projectPlan.Tags.IsNull() && len(projectPlanNewPtr.Tags.Elements()) == 0 {
 		projectPlanNewPtr.Tags = types.MapNull(types.StringType)
 	}
@@ -360,6 +429,62 @@ func FilterUserDefinedLimits(allAtlasLimits []admin.DataFederationLimit, tflimit
 	return filteredLimits
 }
 
+// FilterUserDefinedTeams keeps only the teams defined in the resource so project team assignments managed elsewhere,
+// e.g. with mongodbatlas_team_project_assignment, are not reported as changes nor removed.
+func FilterUserDefinedTeams(atlasTeams *admin.PaginatedTeamRole, tfTeams []TFTeamModel) *admin.PaginatedTeamRole {
+	definedTeams := NewTfTeamModelMap(tfTeams)
+	filteredTeams := []admin.TeamRole{}
+	for _, team := range atlasTeams.GetResults() {
+		if _, ok := definedTeams[types.StringValue(team.GetTeamId())]; ok {
+			filteredTeams = append(filteredTeams, team)
+		}
+	}
+	return &admin.PaginatedTeamRole{
+		Results:    &filteredTeams,
+		TotalCount: admin.PtrInt(len(filteredTeams)),
+	}
+}
+
+// privateState is implemented by the private state of the resource in the framework requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getAppliedSettings returns the project settings applied by Terraform in the last create or update, nil if unknown.
+func getAppliedSettings(ctx context.Context, private privateState) (map[string]bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, appliedSettingsPrivateKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+	var applied map[string]bool
+	if err := json.Unmarshal(value, &applied); err != nil {
+		diags.AddWarning("error reading applied project settings", err.Error())
+		return nil, diags
+	}
+	return applied, diags
+}
+
+func setAppliedSettings(ctx context.Context, private privateState, applied map[string]bool) diag.Diagnostics {
+	value, err := json.Marshal(applied)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddWarning("error saving applied project settings", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, appliedSettingsPrivateKey, value)
+}
+
+func warnUnmanagedTeamsAndLimits(projectID string, projectProps *AdditionalProperties, tfTeams []TFTeamModel, tfLimits []TFLimitModel, warnings *diag.Diagnostics) {
+	teamIDs, limitNames := GetUnmanagedTeamsAndLimits(projectProps, tfTeams, tfLimits)
+	if len(teamIDs) > 0 {
+		warnings.AddWarning("Project teams not managed by Terraform", fmt.Sprintf("The following teams are assigned to project %s but not defined in the resource: %s.", projectID, strings.Join(teamIDs, ", ")))
+	}
+	if len(limitNames) > 0 {
+		warnings.AddWarning("Project limits not managed by Terraform", fmt.Sprintf("The following limits are set in project %s but not defined in the resource: %s.", projectID, strings.Join(limitNames, ", ")))
+	}
+}
+
 type AdditionalProperties struct {
 	Teams                              *admin.PaginatedTeamRole
 	Settings                           *admin.GroupSettings
@@ -592,11 +717,20 @@ func UpdateProject(ctx context.Context, projectsAPI admin.ProjectsApi, projectSt
 	return nil
 }
 
-func deleteProject(ctx context.Context, clustersAPI admin.ClustersApi, projectsAPI admin.ProjectsApi, projectID string) error {
+func deleteProject(ctx context.Context, connV2 *admin.APIClient, projectID string, forceDestroy bool) error {
+	dependentsAPIs := NewDependentsAPIs(connV2)
+	if forceDestroy {
+		if err := DeleteProjectDependents(ctx, dependentsAPIs, projectID); err != nil {
+			return err
+		}
+		_, _, err := connV2.ProjectsApi.DeleteProject(ctx, projectID).Execute()
+		return err
+	}
+
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{projectDependentsStateDeleting, projectDependentsStateRetry},
 		Target:     []string{projectDependentsStateIdle},
-		Refresh:    ResourceProjectDependentsDeletingRefreshFunc(ctx, projectID, clustersAPI),
+		Refresh:    ResourceProjectDependentsDeletingRefreshFunc(ctx, projectID, connV2.ClustersApi),
 		Timeout:    30 * time.Minute,
 		MinTimeout: 30 * time.Second,
 		Delay:      0,
@@ -608,7 +742,15 @@ func deleteProject(ctx context.Context, clustersAPI admin.ClustersApi, projectsA
 		tflog.Info(ctx, fmt.Sprintf("[ERROR] could not determine MongoDB project %s dependents status: %s", projectID, err.Error()))
 	}
 
-	_, _, err = projectsAPI.DeleteProject(ctx, projectID).Execute()
+	// pre-flight check to report the exact resources preventing the deletion instead of the API error
+	dependents, err := ListProjectDependents(ctx, dependentsAPIs, projectID)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("could not list MongoDB project %s dependents: %s", projectID, err.Error()))
+	} else if blockers := GetBlockingDependents(dependents); len(blockers) > 0 {
+		return NewBlockingDependentsError(projectID, blockers)
+	}
+
+	_, _, err = connV2.ProjectsApi.DeleteProject(ctx, projectID).Execute()
 
 	return err
 }
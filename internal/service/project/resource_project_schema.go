@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -13,11 +14,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"go.mongodb.org/atlas-sdk/v20241113004/admin"
 )
 
+const (
+	AuthoritativeSettingsDetect  = "DETECT"
+	AuthoritativeSettingsEnforce = "ENFORCE"
+)
+
 func ResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -147,6 +154,15 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+			},
+			"authoritative_settings": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(AuthoritativeSettingsDetect, AuthoritativeSettingsEnforce),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"teams": schema.SetNestedBlock{
@@ -201,6 +217,7 @@ type TFProjectRSModel struct {
 	OrgID                                       types.String `tfsdk:"org_id"`
 	Created                                     types.String `tfsdk:"created"`
 	ProjectOwnerID                              types.String `tfsdk:"project_owner_id"`
+	AuthoritativeSettings                       types.String `tfsdk:"authoritative_settings"`
 	ID                                          types.String `tfsdk:"id"`
 	ClusterCount                                types.Int64  `tfsdk:"cluster_count"`
 	IsDataExplorerEnabled                       types.Bool   `tfsdk:"is_data_explorer_enabled"`
@@ -211,6 +228,7 @@ type TFProjectRSModel struct {
 	IsCollectDatabaseSpecificsStatisticsEnabled types.Bool   `tfsdk:"is_collect_database_specifics_statistics_enabled"`
 	WithDefaultAlertsSettings                   types.Bool   `tfsdk:"with_default_alerts_settings"`
 	IsSlowOperationThresholdingEnabled          types.Bool   `tfsdk:"is_slow_operation_thresholding_enabled"`
+	ForceDestroy                                types.Bool   `tfsdk:"force_destroy"`
 }
 
 type TFTeamModel struct {
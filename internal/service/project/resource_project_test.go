@@ -200,6 +200,43 @@ func TestFilterUserDefinedLimits(t *testing.T) {
 	}
 }
 
+func TestFilterUserDefinedTeams(t *testing.T) {
+	atlasTeams := &admin.PaginatedTeamRole{
+		Results: &[]admin.TeamRole{
+			{TeamId: admin.PtrString("team1"), RoleNames: &[]string{"GROUP_OWNER"}},
+			{TeamId: admin.PtrString("team2"), RoleNames: &[]string{"GROUP_READ_ONLY"}},
+		},
+		TotalCount: admin.PtrInt(2),
+	}
+	testCases := map[string]struct {
+		tfTeams        []project.TFTeamModel
+		expectedResult *admin.PaginatedTeamRole
+	}{
+		"keeps only teams defined in the resource": {
+			tfTeams: []project.TFTeamModel{{TeamID: types.StringValue("team2")}},
+			expectedResult: &admin.PaginatedTeamRole{
+				Results:    &[]admin.TeamRole{{TeamId: admin.PtrString("team2"), RoleNames: &[]string{"GROUP_READ_ONLY"}}},
+				TotalCount: admin.PtrInt(1),
+			},
+		},
+		"no teams defined in the resource": {
+			tfTeams: nil,
+			expectedResult: &admin.PaginatedTeamRole{
+				Results:    &[]admin.TeamRole{},
+				TotalCount: admin.PtrInt(0),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resultModel := project.FilterUserDefinedTeams(atlasTeams, tc.tfTeams)
+			if !reflect.DeepEqual(resultModel, tc.expectedResult) {
+				t.Errorf("Filtered teams did not match expected output")
+			}
+		})
+	}
+}
+
 func TestUpdateProject(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -1121,6 +1158,112 @@ func TestAccProject_slowOperationReadOnly(t *testing.T) {
 	})
 }
 
+func TestAccProject_authoritativeSettings(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acc.RandomProjectName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyProject,
+		Steps: []resource.TestStep{
+			{
+				Config: configWithAuthoritativeSettings(orgID, projectName, project.AuthoritativeSettingsDetect),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authoritative_settings", project.AuthoritativeSettingsDetect),
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "true"),
+				),
+			},
+			{
+				PreConfig: func() { changeDataExplorerSetting(t, projectName, false) },
+				Config:    configWithAuthoritativeSettings(orgID, projectName, project.AuthoritativeSettingsDetect),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "false"),
+				),
+			},
+			{
+				Config: configWithAuthoritativeSettings(orgID, projectName, project.AuthoritativeSettingsEnforce),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "authoritative_settings", project.AuthoritativeSettingsEnforce),
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "false"),
+				),
+			},
+			{
+				PreConfig:          func() { changeDataExplorerSetting(t, projectName, true) },
+				Config:             configWithAuthoritativeSettings(orgID, projectName, project.AuthoritativeSettingsEnforce),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: configWithAuthoritativeSettings(orgID, projectName, project.AuthoritativeSettingsEnforce),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProject_forceDestroy(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acc.RandomProjectName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyProject,
+		Steps: []resource.TestStep{
+			{
+				Config: configWithForceDestroy(orgID, projectName, false),
+				Check:  checkExists(resourceName),
+			},
+			{
+				PreConfig: func() { createStreamInstance(t, projectName) },
+				Config:    configWithForceDestroy(orgID, projectName, false),
+				Destroy:   true,
+				// the stream instance created outside of Terraform prevents the project deletion
+				ExpectError: regexp.MustCompile(`can't be deleted until the following resources are deleted: stream instance`),
+			},
+			{
+				Config: configWithForceDestroy(orgID, projectName, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+			},
+		},
+	})
+}
+
+func createStreamInstance(t *testing.T, projectName string) {
+	t.Helper()
+	ctx := context.Background()
+	respProject, _, err := acc.ConnV2().ProjectsApi.GetProjectByName(ctx, projectName).Execute()
+	if err != nil {
+		t.Fatalf("PreConfig: error finding project %s: %s", projectName, err)
+	}
+	instance := &admin.StreamsTenant{
+		Name:              conversion.StringPtr(acc.RandomName()),
+		DataProcessRegion: &admin.StreamsDataProcessRegion{CloudProvider: "AWS", Region: "VIRGINIA_USA"},
+	}
+	if _, _, err := acc.ConnV2().StreamsApi.CreateStreamInstance(ctx, respProject.GetId(), instance).Execute(); err != nil {
+		t.Fatalf("PreConfig: error creating stream instance %s", err)
+	}
+}
+
+func changeDataExplorerSetting(t *testing.T, projectName string, enabled bool) {
+	t.Helper()
+	ctx := context.Background()
+	respProject, _, err := acc.ConnV2().ProjectsApi.GetProjectByName(ctx, projectName).Execute()
+	if err != nil {
+		t.Fatalf("PreConfig: error finding project %s: %s", projectName, err)
+	}
+	settings := &admin.GroupSettings{IsDataExplorerEnabled: admin.PtrBool(enabled)}
+	if _, _, err := acc.ConnV2().ProjectsApi.UpdateProjectSettings(ctx, respProject.GetId(), settings).Execute(); err != nil {
+		t.Fatalf("PreConfig: error updating project settings %s", err)
+	}
+}
+
 func changeRoles(t *testing.T, orgID, projectName, roleName string) {
 	t.Helper()
 	ctx := context.Background()
@@ -1259,6 +1402,26 @@ func configWithFalseDefaultSettings(orgID, projectName, projectOwnerID string) s
 	`, orgID, projectName, projectOwnerID)
 }
 
+func configWithAuthoritativeSettings(orgID, projectName, authoritativeSettings string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			org_id                 = %[1]q
+			name                   = %[2]q
+			authoritative_settings = %[3]q
+		}
+	`, orgID, projectName, authoritativeSettings)
+}
+
+func configWithForceDestroy(orgID, projectName string, forceDestroy bool) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			org_id        = %[1]q
+			name          = %[2]q
+			force_destroy = %[3]t
+		}
+	`, orgID, projectName, forceDestroy)
+}
+
 func configWithLimits(orgID, projectName string, limits []*admin.DataFederationLimit) string {
 	var limitsString string
 
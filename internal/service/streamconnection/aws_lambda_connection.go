@@ -0,0 +1,119 @@
+package streamconnection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+// The pinned Atlas SDK doesn't model the aws configuration of stream connections, so AWSLambda connections are
+// created, updated and read with requests built here using the SDK configuration. The rest of the connection is
+// still encoded and decoded with admin.StreamsConnection.
+
+const (
+	streamConnectionsPath = "/api/atlas/v2/groups/%s/streams/%s/connections"
+	streamsAPIVersion     = "application/vnd.atlas.2023-02-01+json"
+)
+
+type streamsConnectionAWS struct {
+	AWS *struct {
+		RoleArn string `json:"roleArn"`
+	} `json:"aws,omitempty"`
+}
+
+func createAWSLambdaConnection(ctx context.Context, client *admin.APIClient, projectID, instanceName string, connection *admin.StreamsConnection, roleArn string) (*admin.StreamsConnection, string, error) {
+	path := fmt.Sprintf(streamConnectionsPath, url.PathEscape(projectID), url.PathEscape(instanceName))
+	return doAWSLambdaConnectionRequest(ctx, client, http.MethodPost, path, connection, roleArn)
+}
+
+func updateAWSLambdaConnection(ctx context.Context, client *admin.APIClient, projectID, instanceName, connectionName string, connection *admin.StreamsConnection, roleArn string) (*admin.StreamsConnection, string, error) {
+	path := fmt.Sprintf(streamConnectionsPath, url.PathEscape(projectID), url.PathEscape(instanceName)) + "/" + url.PathEscape(connectionName)
+	return doAWSLambdaConnectionRequest(ctx, client, http.MethodPatch, path, connection, roleArn)
+}
+
+// getAWSRoleArn returns the IAM role ARN of an AWSLambda connection.
+func getAWSRoleArn(ctx context.Context, client *admin.APIClient, projectID, instanceName, connectionName string) (string, error) {
+	path := fmt.Sprintf(streamConnectionsPath, url.PathEscape(projectID), url.PathEscape(instanceName)) + "/" + url.PathEscape(connectionName)
+	_, roleArn, err := doAWSLambdaConnectionRequest(ctx, client, http.MethodGet, path, nil, "")
+	return roleArn, err
+}
+
+func doAWSLambdaConnectionRequest(ctx context.Context, client *admin.APIClient, method, path string, connection *admin.StreamsConnection, roleArn string) (*admin.StreamsConnection, string, error) {
+	var body io.Reader
+	if connection != nil {
+		payload, err := newAWSLambdaConnectionPayload(connection, roleArn)
+		if err != nil {
+			return nil, "", err
+		}
+		body = bytes.NewReader(payload)
+	}
+	cfg := client.GetConfig()
+	baseURL, err := cfg.ServerURLWithContext(ctx, "StreamsApiService.GetStreamConnection")
+	if err != nil {
+		return nil, "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, body)
+	if err != nil {
+		return nil, "", err
+	}
+	for key, value := range cfg.DefaultHeader {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	req.Header.Set("Accept", streamsAPIVersion)
+	if body != nil {
+		req.Header.Set("Content-Type", streamsAPIVersion)
+	}
+	resp, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		apiErr := admin.ApiError{}
+		_ = json.Unmarshal(respBody, &apiErr)
+		openAPIErr := &admin.GenericOpenAPIError{}
+		openAPIErr.SetModel(apiErr)
+		openAPIErr.SetError(admin.FormatErrorMessageWithDetails(resp.Status, path, method, apiErr))
+		return nil, "", openAPIErr
+	}
+	return decodeAWSLambdaConnection(respBody)
+}
+
+func newAWSLambdaConnectionPayload(connection *admin.StreamsConnection, roleArn string) ([]byte, error) {
+	payload, err := json.Marshal(connection)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, err
+	}
+	fields["aws"] = map[string]string{"roleArn": roleArn}
+	return json.Marshal(fields)
+}
+
+func decodeAWSLambdaConnection(body []byte) (*admin.StreamsConnection, string, error) {
+	connection := &admin.StreamsConnection{}
+	if err := json.Unmarshal(body, connection); err != nil {
+		return nil, "", err
+	}
+	aws := &streamsConnectionAWS{}
+	if err := json.Unmarshal(body, aws); err != nil {
+		return nil, "", err
+	}
+	if aws.AWS == nil {
+		return connection, "", nil
+	}
+	return connection, aws.AWS.RoleArn, nil
+}
@@ -51,5 +51,9 @@ func (d *streamConnectionDS) Read(ctx context.Context, req datasource.ReadReques
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	if err := setAWSModel(ctx, connV2, newStreamConnectionModel); err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionModel)...)
 }
@@ -63,6 +63,12 @@ func (d *streamConnectionsDS) Read(ctx context.Context, req datasource.ReadReque
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	for i := range newStreamConnectionsModel.Results {
+		if err := setAWSModel(ctx, connV2, &newStreamConnectionsModel.Results[i]); err != nil {
+			resp.Diagnostics.AddError("error fetching results", err.Error())
+			return
+		}
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionsModel)...)
 }
 
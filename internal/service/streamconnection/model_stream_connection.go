@@ -3,8 +3,11 @@ package streamconnection
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -18,6 +21,7 @@ func NewStreamConnectionReq(ctx context.Context, plan *TFStreamConnectionModel)
 		Type:             plan.Type.ValueStringPointer(),
 		ClusterName:      plan.ClusterName.ValueStringPointer(),
 		BootstrapServers: plan.BootstrapServers.ValueStringPointer(),
+		Url:              plan.URL.ValueStringPointer(),
 	}
 	if !plan.Authentication.IsNull() {
 		authenticationModel := &TFConnectionAuthenticationModel{}
@@ -49,6 +53,14 @@ func NewStreamConnectionReq(ctx context.Context, plan *TFStreamConnectionModel)
 		streamConnection.Config = configMap
 	}
 
+	if !plan.Headers.IsNull() {
+		headersMap := &map[string]string{}
+		if diags := plan.Headers.ElementsAs(ctx, headersMap, true); diags.HasError() {
+			return nil, diags
+		}
+		streamConnection.Headers = headersMap
+	}
+
 	if !plan.DBRoleToExecute.IsNull() {
 		dbRoleToExecuteModel := &TFDbRoleToExecuteModel{}
 		if diags := plan.DBRoleToExecute.As(ctx, dbRoleToExecuteModel, basetypes.ObjectAsOptions{}); diags.HasError() {
@@ -75,6 +87,64 @@ func NewStreamConnectionReq(ctx context.Context, plan *TFStreamConnectionModel)
 	return &streamConnection, nil
 }
 
+// typeAttributes are the type specific attributes of each connection type.
+var typeAttributes = map[string][]string{
+	TypeCluster:   {"cluster_name", "db_role_to_execute"},
+	TypeKafka:     {"authentication", "bootstrap_servers", "config", "security", "networking"},
+	TypeSample:    {},
+	TypeHTTPS:     {"url", "headers"},
+	TypeAWSLambda: {"aws"},
+}
+
+// requiredTypeAttributes are the type specific attributes that must be set for the connection type.
+var requiredTypeAttributes = map[string][]string{
+	TypeHTTPS:     {"url"},
+	TypeAWSLambda: {"aws"},
+}
+
+// ValidateTypeAttributes checks that the Sample, Https and AWSLambda connections only set their own type specific attributes,
+// and that the url and headers or aws attributes are only used with their connection type. Unknown connection types are not validated.
+func ValidateTypeAttributes(connection *TFStreamConnectionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	connectionType := connection.Type.ValueString()
+	allowed, knownType := typeAttributes[connectionType]
+	if !knownType {
+		return nil
+	}
+	values := map[string]attr.Value{
+		"cluster_name":       connection.ClusterName,
+		"db_role_to_execute": connection.DBRoleToExecute,
+		"authentication":     connection.Authentication,
+		"bootstrap_servers":  connection.BootstrapServers,
+		"config":             connection.Config,
+		"security":           connection.Security,
+		"networking":         connection.Networking,
+		"url":                connection.URL,
+		"headers":            connection.Headers,
+		"aws":                connection.AWS,
+	}
+	for _, name := range requiredTypeAttributes[connectionType] {
+		if values[name].IsNull() {
+			diags.AddAttributeError(path.Root(name), "missing stream connection attribute", fmt.Sprintf("%s is required for %s connections", name, connectionType))
+		}
+	}
+	for otherType, names := range typeAttributes {
+		if otherType == connectionType {
+			continue
+		}
+		// existing Cluster and Kafka configurations may set attributes of each other, only new connection types are strict
+		if (connectionType == TypeCluster || connectionType == TypeKafka) && (otherType == TypeCluster || otherType == TypeKafka) {
+			continue
+		}
+		for _, name := range names {
+			if !values[name].IsNull() && !slices.Contains(allowed, name) {
+				diags.AddAttributeError(path.Root(name), "invalid stream connection attribute", fmt.Sprintf("%s can only be used with %s connections, not with %s connections", name, otherType, connectionType))
+			}
+		}
+	}
+	return diags
+}
+
 func NewTFStreamConnection(ctx context.Context, projID, instanceName string, currAuthConfig *types.Object, apiResp *admin.StreamsConnection) (*TFStreamConnectionModel, diag.Diagnostics) {
 	rID := fmt.Sprintf("%s-%s-%s", instanceName, projID, conversion.SafeString(apiResp.Name))
 	connectionModel := TFStreamConnectionModel{
@@ -85,6 +155,8 @@ func NewTFStreamConnection(ctx context.Context, projID, instanceName string, cur
 		Type:             types.StringPointerValue(apiResp.Type),
 		ClusterName:      types.StringPointerValue(apiResp.ClusterName),
 		BootstrapServers: types.StringPointerValue(apiResp.BootstrapServers),
+		URL:              types.StringPointerValue(apiResp.Url),
+		AWS:              types.ObjectNull(AWSObjectType.AttrTypes),
 	}
 
 	authModel, diags := newTFConnectionAuthenticationModel(ctx, currAuthConfig, apiResp.Authentication)
@@ -102,6 +174,15 @@ func NewTFStreamConnection(ctx context.Context, projID, instanceName string, cur
 		connectionModel.Config = mapValue
 	}
 
+	connectionModel.Headers = types.MapNull(types.StringType)
+	if apiResp.Headers != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, apiResp.Headers)
+		if diags.HasError() {
+			return nil, diags
+		}
+		connectionModel.Headers = mapValue
+	}
+
 	connectionModel.Security = types.ObjectNull(ConnectionSecurityObjectType.AttrTypes)
 	if apiResp.Security != nil {
 		securityModel, diags := types.ObjectValueFrom(ctx, ConnectionSecurityObjectType.AttrTypes, TFConnectionSecurityModel{
@@ -142,6 +223,27 @@ func NewTFStreamConnection(ctx context.Context, projID, instanceName string, cur
 	return &connectionModel, nil
 }
 
+// NewAWSRoleArnReq returns the IAM role ARN of an AWSLambda connection, it's empty for other connection types.
+func NewAWSRoleArnReq(ctx context.Context, plan *TFStreamConnectionModel) (string, diag.Diagnostics) {
+	if plan.AWS.IsNull() || plan.AWS.IsUnknown() {
+		return "", nil
+	}
+	awsModel := &TFAWSModel{}
+	if diags := plan.AWS.As(ctx, awsModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", diags
+	}
+	return awsModel.RoleArn.ValueString(), nil
+}
+
+func NewTFAWSModel(ctx context.Context, roleArn string) (types.Object, diag.Diagnostics) {
+	if roleArn == "" {
+		return types.ObjectNull(AWSObjectType.AttrTypes), nil
+	}
+	return types.ObjectValueFrom(ctx, AWSObjectType.AttrTypes, TFAWSModel{
+		RoleArn: types.StringValue(roleArn),
+	})
+}
+
 func newTFConnectionAuthenticationModel(ctx context.Context, currAuthConfig *types.Object, authResp *admin.StreamsKafkaAuthentication) (*types.Object, diag.Diagnostics) {
 	if authResp != nil {
 		resultAuthModel := TFConnectionAuthenticationModel{
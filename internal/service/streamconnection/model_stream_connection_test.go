@@ -25,12 +25,18 @@ const (
 	dbRoleType           = "CUSTOM"
 	sampleConnectionName = "sample_stream_solar"
 	networkingType       = "PUBLIC"
+	httpsURL             = "https://example.com"
+	roleArn              = "arn:aws:iam::123456789012:role/lambda-role"
 )
 
 var configMap = map[string]string{
 	"auto.offset.reset": "earliest",
 }
 
+var headersMap = map[string]string{
+	"Authorization": "Bearer token",
+}
+
 type sdkToTFModelTestCase struct {
 	SDKResp              *admin.StreamsConnection
 	providedProjID       string
@@ -69,6 +75,8 @@ func TestStreamConnectionSDKToTFModel(t *testing.T) {
 				Security:        types.ObjectNull(streamconnection.ConnectionSecurityObjectType.AttrTypes),
 				DBRoleToExecute: tfDBRoleToExecuteObject(t, dbRole, dbRoleType),
 				Networking:      types.ObjectNull(streamconnection.NetworkingObjectType.AttrTypes),
+				Headers:         types.MapNull(types.StringType),
+				AWS:             types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
 			},
 		},
 		{
@@ -101,6 +109,8 @@ func TestStreamConnectionSDKToTFModel(t *testing.T) {
 				Security:         tfSecurityObject(t, DummyCACert, securityProtocol),
 				DBRoleToExecute:  types.ObjectNull(streamconnection.DBRoleToExecuteObjectType.AttrTypes),
 				Networking:       types.ObjectNull(streamconnection.NetworkingObjectType.AttrTypes),
+				Headers:          types.MapNull(types.StringType),
+				AWS:              types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
 			},
 		},
 		{
@@ -122,6 +132,8 @@ func TestStreamConnectionSDKToTFModel(t *testing.T) {
 				Security:        types.ObjectNull(streamconnection.ConnectionSecurityObjectType.AttrTypes),
 				DBRoleToExecute: types.ObjectNull(streamconnection.DBRoleToExecuteObjectType.AttrTypes),
 				Networking:      types.ObjectNull(streamconnection.NetworkingObjectType.AttrTypes),
+				Headers:         types.MapNull(types.StringType),
+				AWS:             types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
 			},
 		},
 		{
@@ -154,6 +166,8 @@ func TestStreamConnectionSDKToTFModel(t *testing.T) {
 				Security:         tfSecurityObject(t, DummyCACert, securityProtocol),
 				DBRoleToExecute:  types.ObjectNull(streamconnection.DBRoleToExecuteObjectType.AttrTypes),
 				Networking:       types.ObjectNull(streamconnection.NetworkingObjectType.AttrTypes),
+				Headers:          types.MapNull(types.StringType),
+				AWS:              types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
 			},
 		},
 		{
@@ -174,6 +188,33 @@ func TestStreamConnectionSDKToTFModel(t *testing.T) {
 				Security:        types.ObjectNull(streamconnection.ConnectionSecurityObjectType.AttrTypes),
 				DBRoleToExecute: types.ObjectNull(streamconnection.DBRoleToExecuteObjectType.AttrTypes),
 				Networking:      types.ObjectNull(streamconnection.NetworkingObjectType.AttrTypes),
+				Headers:         types.MapNull(types.StringType),
+				AWS:             types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
+			},
+		},
+		{
+			name: "Https connection type SDK response",
+			SDKResp: &admin.StreamsConnection{
+				Name:    admin.PtrString(connectionName),
+				Type:    admin.PtrString("Https"),
+				Url:     admin.PtrString(httpsURL),
+				Headers: &headersMap,
+			},
+			providedProjID:       dummyProjectID,
+			providedInstanceName: instanceName,
+			expectedTFModel: &streamconnection.TFStreamConnectionModel{
+				ProjectID:       types.StringValue(dummyProjectID),
+				InstanceName:    types.StringValue(instanceName),
+				ConnectionName:  types.StringValue(connectionName),
+				Type:            types.StringValue("Https"),
+				URL:             types.StringValue(httpsURL),
+				Headers:         tfConfigMap(t, headersMap),
+				Authentication:  types.ObjectNull(streamconnection.ConnectionAuthenticationObjectType.AttrTypes),
+				Config:          types.MapNull(types.StringType),
+				Security:        types.ObjectNull(streamconnection.ConnectionSecurityObjectType.AttrTypes),
+				DBRoleToExecute: types.ObjectNull(streamconnection.DBRoleToExecuteObjectType.AttrTypes),
+				Networking:      types.ObjectNull(streamconnection.NetworkingObjectType.AttrTypes),
+				AWS:             types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
 			},
 		},
 	}
@@ -265,6 +306,8 @@ func TestStreamConnectionsSDKToTFModel(t *testing.T) {
 						Security:         tfSecurityObject(t, DummyCACert, securityProtocol),
 						DBRoleToExecute:  types.ObjectNull(streamconnection.DBRoleToExecuteObjectType.AttrTypes),
 						Networking:       tfNetworkingObject(t, networkingType),
+						Headers:          types.MapNull(types.StringType),
+						AWS:              types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
 					},
 					{
 						ID:              types.StringValue(fmt.Sprintf("%s-%s-%s", instanceName, dummyProjectID, connectionName)),
@@ -278,6 +321,8 @@ func TestStreamConnectionsSDKToTFModel(t *testing.T) {
 						Security:        types.ObjectNull(streamconnection.ConnectionSecurityObjectType.AttrTypes),
 						DBRoleToExecute: tfDBRoleToExecuteObject(t, dbRole, dbRoleType),
 						Networking:      types.ObjectNull(streamconnection.NetworkingObjectType.AttrTypes),
+						Headers:         types.MapNull(types.StringType),
+						AWS:             types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
 					},
 					{
 						ID:              types.StringValue(fmt.Sprintf("%s-%s-%s", instanceName, dummyProjectID, sampleConnectionName)),
@@ -291,6 +336,8 @@ func TestStreamConnectionsSDKToTFModel(t *testing.T) {
 						Security:        types.ObjectNull(streamconnection.ConnectionSecurityObjectType.AttrTypes),
 						DBRoleToExecute: types.ObjectNull(streamconnection.DBRoleToExecuteObjectType.AttrTypes),
 						Networking:      types.ObjectNull(streamconnection.NetworkingObjectType.AttrTypes),
+						Headers:         types.MapNull(types.StringType),
+						AWS:             types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
 					},
 				},
 			},
@@ -412,6 +459,23 @@ func TestStreamInstanceTFToSDKCreateModel(t *testing.T) {
 				Type: admin.PtrString("Sample"),
 			},
 		},
+		{
+			name: "Https type TF state",
+			tfModel: &streamconnection.TFStreamConnectionModel{
+				ProjectID:      types.StringValue(dummyProjectID),
+				InstanceName:   types.StringValue(instanceName),
+				ConnectionName: types.StringValue(connectionName),
+				Type:           types.StringValue("Https"),
+				URL:            types.StringValue(httpsURL),
+				Headers:        tfConfigMap(t, headersMap),
+			},
+			expectedSDKReq: &admin.StreamsConnection{
+				Name:    admin.PtrString(connectionName),
+				Type:    admin.PtrString("Https"),
+				Url:     admin.PtrString(httpsURL),
+				Headers: &headersMap,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -427,6 +491,72 @@ func TestStreamInstanceTFToSDKCreateModel(t *testing.T) {
 	}
 }
 
+func TestAWSRoleArn(t *testing.T) {
+	ctx := context.Background()
+	awsObject, diags := streamconnection.NewTFAWSModel(ctx, roleArn)
+	assert.False(t, diags.HasError())
+	result, diags := streamconnection.NewAWSRoleArnReq(ctx, &streamconnection.TFStreamConnectionModel{AWS: awsObject})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, roleArn, result)
+
+	nullObject, diags := streamconnection.NewTFAWSModel(ctx, "")
+	assert.False(t, diags.HasError())
+	assert.True(t, nullObject.IsNull())
+	result, diags = streamconnection.NewAWSRoleArnReq(ctx, &streamconnection.TFStreamConnectionModel{AWS: nullObject})
+	assert.False(t, diags.HasError())
+	assert.Empty(t, result)
+}
+
+func TestValidateTypeAttributes(t *testing.T) {
+	ctx := context.Background()
+	awsObject, _ := streamconnection.NewTFAWSModel(ctx, roleArn)
+	testCases := map[string]struct {
+		connection     streamconnection.TFStreamConnectionModel
+		expectedErrors []string
+	}{
+		"https": {
+			connection: streamconnection.TFStreamConnectionModel{Type: types.StringValue("Https"), URL: types.StringValue(httpsURL), Headers: tfConfigMap(t, headersMap)},
+		},
+		"httpsWithoutURL": {
+			connection:     streamconnection.TFStreamConnectionModel{Type: types.StringValue("Https")},
+			expectedErrors: []string{"url is required for Https connections"},
+		},
+		"awsLambda": {
+			connection: streamconnection.TFStreamConnectionModel{Type: types.StringValue("AWSLambda"), AWS: awsObject},
+		},
+		"awsLambdaWithoutAWS": {
+			connection:     streamconnection.TFStreamConnectionModel{Type: types.StringValue("AWSLambda")},
+			expectedErrors: []string{"aws is required for AWSLambda connections"},
+		},
+		"sample": {
+			connection: streamconnection.TFStreamConnectionModel{Type: types.StringValue("Sample")},
+		},
+		"sampleWithClusterName": {
+			connection:     streamconnection.TFStreamConnectionModel{Type: types.StringValue("Sample"), ClusterName: types.StringValue(clusterName)},
+			expectedErrors: []string{"cluster_name can only be used with Cluster connections, not with Sample connections"},
+		},
+		"kafkaWithURL": {
+			connection:     streamconnection.TFStreamConnectionModel{Type: types.StringValue("Kafka"), BootstrapServers: types.StringValue(bootstrapServers), URL: types.StringValue(httpsURL)},
+			expectedErrors: []string{"url can only be used with Https connections, not with Kafka connections"},
+		},
+		"clusterWithKafkaAttributes": {
+			connection: streamconnection.TFStreamConnectionModel{Type: types.StringValue("Cluster"), ClusterName: types.StringValue(clusterName), Config: tfConfigMap(t, configMap)},
+		},
+		"unknownType": {
+			connection: streamconnection.TFStreamConnectionModel{Type: types.StringValue("NewType"), URL: types.StringValue(httpsURL)},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := streamconnection.ValidateTypeAttributes(&tc.connection)
+			assert.Len(t, diags, len(tc.expectedErrors))
+			for i, expected := range tc.expectedErrors {
+				assert.Equal(t, expected, diags[i].Detail())
+			}
+		})
+	}
+}
+
 func tfAuthenticationObject(t *testing.T, mechanism, username, password string) types.Object {
 	t.Helper()
 	auth, diags := types.ObjectValueFrom(context.Background(), streamconnection.ConnectionAuthenticationObjectType.AttrTypes, streamconnection.TFConnectionAuthenticationModel{
@@ -116,6 +116,26 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 			},
+
+			// https type specific
+			"url": schema.StringAttribute{
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			// aws lambda type specific
+			"aws": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"role_arn": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
 		},
 	}
 }
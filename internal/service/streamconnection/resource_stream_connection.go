@@ -3,6 +3,7 @@ package streamconnection
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"regexp"
 	"time"
@@ -14,12 +15,22 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
 )
 
 const streamConnectionName = "stream_connection"
 
+const (
+	TypeCluster   = "Cluster"
+	TypeKafka     = "Kafka"
+	TypeSample    = "Sample"
+	TypeHTTPS     = "Https"
+	TypeAWSLambda = "AWSLambda"
+)
+
 var _ resource.ResourceWithConfigure = &streamConnectionRS{}
 var _ resource.ResourceWithImportState = &streamConnectionRS{}
+var _ resource.ResourceWithValidateConfig = &streamConnectionRS{}
 
 func Resource() resource.Resource {
 	return &streamConnectionRS{
@@ -46,6 +57,9 @@ type TFStreamConnectionModel struct {
 	Security         types.Object `tfsdk:"security"`
 	DBRoleToExecute  types.Object `tfsdk:"db_role_to_execute"`
 	Networking       types.Object `tfsdk:"networking"`
+	URL              types.String `tfsdk:"url"`
+	Headers          types.Map    `tfsdk:"headers"`
+	AWS              types.Object `tfsdk:"aws"`
 }
 
 type TFConnectionAuthenticationModel struct {
@@ -96,11 +110,28 @@ var NetworkingObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
 	"access": NetworkingAccessObjectType,
 }}
 
+type TFAWSModel struct {
+	RoleArn types.String `tfsdk:"role_arn"`
+}
+
+var AWSObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"role_arn": types.StringType,
+}}
+
 func (r *streamConnectionRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = ResourceSchema(ctx)
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *streamConnectionRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var connection TFStreamConnectionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &connection)...)
+	if resp.Diagnostics.HasError() || connection.Type.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(ValidateTypeAttributes(&connection)...)
+}
+
 func (r *streamConnectionRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var streamConnectionPlan TFStreamConnectionModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamConnectionPlan)...)
@@ -116,7 +147,18 @@ func (r *streamConnectionRS) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	apiResp, _, err := connV2.StreamsApi.CreateStreamConnection(ctx, projectID, instanceName, streamConnectionReq).Execute()
+	roleArn, diags := NewAWSRoleArnReq(ctx, &streamConnectionPlan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	var apiResp *admin.StreamsConnection
+	var err error
+	if streamConnectionPlan.Type.ValueString() == TypeAWSLambda {
+		apiResp, roleArn, err = createAWSLambdaConnection(ctx, connV2, projectID, instanceName, streamConnectionReq, roleArn)
+	} else {
+		apiResp, _, err = connV2.StreamsApi.CreateStreamConnection(ctx, projectID, instanceName, streamConnectionReq).Execute()
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("error creating resource", err.Error())
 		return
@@ -127,6 +169,11 @@ func (r *streamConnectionRS) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamConnectionModel.AWS, diags = NewTFAWSModel(ctx, roleArn)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionModel)...)
 }
 
@@ -156,6 +203,10 @@ func (r *streamConnectionRS) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	if err := setAWSModel(ctx, connV2, newStreamConnectionModel); err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionModel)...)
 }
 
@@ -175,7 +226,18 @@ func (r *streamConnectionRS) Update(ctx context.Context, req resource.UpdateRequ
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	apiResp, _, err := connV2.StreamsApi.UpdateStreamConnection(ctx, projectID, instanceName, connectionName, streamConnectionReq).Execute()
+	roleArn, diags := NewAWSRoleArnReq(ctx, &streamConnectionPlan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	var apiResp *admin.StreamsConnection
+	var err error
+	if streamConnectionPlan.Type.ValueString() == TypeAWSLambda {
+		apiResp, roleArn, err = updateAWSLambdaConnection(ctx, connV2, projectID, instanceName, connectionName, streamConnectionReq, roleArn)
+	} else {
+		apiResp, _, err = connV2.StreamsApi.UpdateStreamConnection(ctx, projectID, instanceName, connectionName, streamConnectionReq).Execute()
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("error updating resource", err.Error())
 		return
@@ -186,6 +248,11 @@ func (r *streamConnectionRS) Update(ctx context.Context, req resource.UpdateRequ
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamConnectionModel.AWS, diags = NewTFAWSModel(ctx, roleArn)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionModel)...)
 }
 
@@ -232,3 +299,20 @@ func splitStreamConnectionImportID(id string) (instanceName, projectID, connecti
 	connectionName = parts[3]
 	return
 }
+
+// setAWSModel sets the aws attribute of AWSLambda connections, the Atlas SDK doesn't return it.
+func setAWSModel(ctx context.Context, client *admin.APIClient, connection *TFStreamConnectionModel) error {
+	if connection.Type.ValueString() != TypeAWSLambda {
+		return nil
+	}
+	roleArn, err := getAWSRoleArn(ctx, client, connection.ProjectID.ValueString(), connection.InstanceName.ValueString(), connection.ConnectionName.ValueString())
+	if err != nil {
+		return err
+	}
+	awsModel, diags := NewTFAWSModel(ctx, roleArn)
+	if diags.HasError() {
+		return fmt.Errorf("error converting aws attribute: %s", diags.Errors()[0].Detail())
+	}
+	connection.AWS = awsModel
+	return nil
+}
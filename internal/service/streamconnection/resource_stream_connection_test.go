@@ -4,6 +4,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -180,6 +181,104 @@ func TestAccStreamRSStreamConnection_sample(t *testing.T) {
 	})
 }
 
+func TestAccStreamRSStreamConnection_https(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_stream_connection.test"
+		projectID    = acc.ProjectIDExecution(t)
+		instanceName = acc.RandomName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             CheckDestroyStreamConnection,
+		Steps: []resource.TestStep{
+			{
+				Config: httpsStreamConnectionConfig(projectID, instanceName, "https://example.com", `{"Authorization" = "Bearer token1"}`),
+				Check:  httpsStreamConnectionAttributeChecks(resourceName, instanceName, "https://example.com", "Bearer token1"),
+			},
+			{
+				Config: httpsStreamConnectionConfig(projectID, instanceName, "https://example.com/updated", `{"Authorization" = "Bearer token2"}`),
+				Check:  httpsStreamConnectionAttributeChecks(resourceName, instanceName, "https://example.com/updated", "Bearer token2"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: checkStreamConnectionImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStreamRSStreamConnection_awsLambda(t *testing.T) {
+	var (
+		resourceName   = "mongodbatlas_stream_connection.test"
+		projectID      = acc.ProjectIDExecution(t)
+		instanceName   = acc.RandomName()
+		awsIAMRoleName = acc.RandomIAMRole()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t); acc.PreCheckAwsEnvBasic(t) },
+		ExternalProviders:        acc.ExternalProvidersOnlyAWS(),
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             CheckDestroyStreamConnection,
+		Steps: []resource.TestStep{
+			{
+				Config: awsLambdaStreamConnectionConfig(projectID, instanceName, awsIAMRoleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkStreamConnectionExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "AWSLambda"),
+					resource.TestCheckResourceAttrPair(resourceName, "aws.role_arn", "aws_iam_role.test_role", "arn"),
+					resource.TestCheckResourceAttrPair("data.mongodbatlas_stream_connection.test", "aws.role_arn", "aws_iam_role.test_role", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: checkStreamConnectionImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStreamRSStreamConnection_invalidTypeAttributes(t *testing.T) {
+	var (
+		projectID    = acc.ProjectIDExecution(t)
+		instanceName = acc.RandomName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             CheckDestroyStreamConnection,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "mongodbatlas_stream_connection" "test" {
+						project_id      = %[1]q
+						instance_name   = %[2]q
+						connection_name = "HttpsConnection"
+						type            = "Https"
+					}
+				`, projectID, instanceName),
+				ExpectError: regexp.MustCompile("url is required for Https connections"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "mongodbatlas_stream_connection" "test" {
+						project_id      = %[1]q
+						instance_name   = %[2]q
+						connection_name = "sample_stream_solar"
+						type            = "Sample"
+						url             = "https://example.com"
+					}
+				`, projectID, instanceName),
+				ExpectError: regexp.MustCompile("url can only be used with Https connections, not with Sample connections"),
+			},
+		},
+	})
+}
+
 func kafkaStreamConnectionConfig(projectID, instanceName, username, password, bootstrapServers, configValue, networkingConfig string, useSSL bool) string {
 	projectAndStreamInstanceConfig := acc.StreamInstanceConfig(projectID, instanceName, "VIRGINIA_USA", "AWS")
 	securityConfig := `
@@ -232,6 +331,88 @@ func sampleStreamConnectionConfig(projectID, instanceName, sampleName string) st
 	`, streamInstanceConfig, sampleName)
 }
 
+func httpsStreamConnectionConfig(projectID, instanceName, url, headers string) string {
+	streamInstanceConfig := acc.StreamInstanceConfig(projectID, instanceName, "VIRGINIA_USA", "AWS")
+
+	return fmt.Sprintf(`
+		%[1]s
+
+		resource "mongodbatlas_stream_connection" "test" {
+			project_id      = mongodbatlas_stream_instance.test.project_id
+			instance_name   = mongodbatlas_stream_instance.test.instance_name
+			connection_name = "HttpsConnection"
+			type            = "Https"
+			url             = %[2]q
+			headers         = %[3]s
+		}
+	`, streamInstanceConfig, url, headers)
+}
+
+func httpsStreamConnectionAttributeChecks(resourceName, instanceName, url, authorizationHeader string) resource.TestCheckFunc {
+	resourceChecks := []resource.TestCheckFunc{
+		checkStreamConnectionExists(),
+		resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+		resource.TestCheckResourceAttr(resourceName, "instance_name", instanceName),
+		resource.TestCheckResourceAttr(resourceName, "connection_name", "HttpsConnection"),
+		resource.TestCheckResourceAttr(resourceName, "type", "Https"),
+		resource.TestCheckResourceAttr(resourceName, "url", url),
+		resource.TestCheckResourceAttr(resourceName, "headers.Authorization", authorizationHeader),
+	}
+	return resource.ComposeAggregateTestCheckFunc(resourceChecks...)
+}
+
+func awsLambdaStreamConnectionConfig(projectID, instanceName, awsIAMRoleName string) string {
+	streamInstanceConfig := acc.StreamInstanceConfig(projectID, instanceName, "VIRGINIA_USA", "AWS")
+
+	return fmt.Sprintf(`
+		%[1]s
+
+		resource "mongodbatlas_cloud_provider_access_setup" "setup_only" {
+			project_id    = %[2]q
+			provider_name = "AWS"
+		}
+
+		resource "aws_iam_role" "test_role" {
+			name                 = %[3]q
+			max_session_duration = 43200
+
+			assume_role_policy = jsonencode({
+				Version = "2012-10-17"
+				Statement = [{
+					Effect    = "Allow"
+					Principal = { AWS = mongodbatlas_cloud_provider_access_setup.setup_only.aws_config[0].atlas_aws_account_arn }
+					Action    = "sts:AssumeRole"
+					Condition = { StringEquals = { "sts:ExternalId" = mongodbatlas_cloud_provider_access_setup.setup_only.aws_config[0].atlas_assumed_role_external_id } }
+				}]
+			})
+		}
+
+		resource "mongodbatlas_cloud_provider_access_authorization" "auth_role" {
+			project_id = %[2]q
+			role_id    = mongodbatlas_cloud_provider_access_setup.setup_only.role_id
+			aws {
+				iam_assumed_role_arn = aws_iam_role.test_role.arn
+			}
+		}
+
+		resource "mongodbatlas_stream_connection" "test" {
+			project_id      = mongodbatlas_stream_instance.test.project_id
+			instance_name   = mongodbatlas_stream_instance.test.instance_name
+			connection_name = "AWSLambdaConnection"
+			type            = "AWSLambda"
+			aws = {
+				role_arn = mongodbatlas_cloud_provider_access_authorization.auth_role.aws[0].iam_assumed_role_arn
+			}
+		}
+
+		data "mongodbatlas_stream_connection" "test" {
+			project_id      = mongodbatlas_stream_connection.test.project_id
+			instance_name   = mongodbatlas_stream_connection.test.instance_name
+			connection_name = mongodbatlas_stream_connection.test.connection_name
+		}
+	`, streamInstanceConfig, projectID, awsIAMRoleName)
+}
+
 func sampleStreamConnectionAttributeChecks(
 	resourceName, instanceName, sampleName string) resource.TestCheckFunc {
 	resourceChecks := []resource.TestCheckFunc{
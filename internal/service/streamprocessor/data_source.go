@@ -24,7 +24,7 @@ type StreamProccesorDS struct {
 }
 
 func (d *StreamProccesorDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = conversion.DataSourceSchemaFromResource(ResourceSchema(ctx), &conversion.DataSourceSchemaRequest{
+	resp.Schema = conversion.DataSourceSchemaFromResource(dataSourceBaseSchema(ctx), &conversion.DataSourceSchemaRequest{
 		RequiredFields: []string{"project_id", "instance_name", "processor_name"},
 	})
 }
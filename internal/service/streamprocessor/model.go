@@ -21,25 +21,90 @@ func NewStreamProcessorReq(ctx context.Context, plan *TFStreamProcessorRSModel)
 		Pipeline: &pipeline,
 	}
 
-	if !plan.Options.IsNull() && !plan.Options.IsUnknown() {
+	dlq, diags := newDlqReq(ctx, plan.Options)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if dlq != nil {
+		streamProcessor.Options = &admin.StreamsOptions{
+			Dlq: dlq,
+		}
+	}
+
+	return streamProcessor, nil
+}
+
+func NewStreamProcessorModifyReq(ctx context.Context, plan *TFStreamProcessorRSModel) (*admin.StreamsModifyStreamProcessor, diag.Diagnostics) {
+	pipeline, diags := convertPipelineToSdk(plan.Pipeline.ValueString())
+	if diags != nil {
+		return nil, diags
+	}
+	modifyReq := &admin.StreamsModifyStreamProcessor{
+		Pipeline: &pipeline,
+	}
+
+	dlq, diags := newDlqReq(ctx, plan.Options)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if dlq != nil || !plan.ResumeFromCheckpoint.IsNull() {
+		modifyReq.Options = &admin.StreamsModifyStreamProcessorOptions{
+			Dlq:                  dlq,
+			ResumeFromCheckpoint: plan.ResumeFromCheckpoint.ValueBoolPointer(),
+		}
+	}
+
+	return modifyReq, nil
+}
+
+func newDlqReq(ctx context.Context, options types.Object) (*admin.StreamsDLQ, diag.Diagnostics) {
+	if options.IsNull() || options.IsUnknown() {
+		return nil, nil
+	}
+	optionsModel := &TFOptionsModel{}
+	if diags := options.As(ctx, optionsModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, diags
+	}
+	dlqModel := &TFDlqModel{}
+	if diags := optionsModel.Dlq.As(ctx, dlqModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, diags
+	}
+	return &admin.StreamsDLQ{
+		Coll:           dlqModel.Coll.ValueStringPointer(),
+		ConnectionName: dlqModel.ConnectionName.ValueStringPointer(),
+		Db:             dlqModel.DB.ValueStringPointer(),
+	}, nil
+}
+
+// NewReferencedConnectionsTF returns the connections referenced by the planned pipeline and DLQ, it's unknown until both are known.
+func NewReferencedConnectionsTF(ctx context.Context, plan *TFStreamProcessorRSModel) (types.List, diag.Diagnostics) {
+	unknown := types.ListUnknown(types.StringType)
+	if plan.Pipeline.IsUnknown() || plan.Options.IsUnknown() {
+		return unknown, nil
+	}
+	stages, diags := convertPipelineToSdk(plan.Pipeline.ValueString())
+	if diags.HasError() {
+		return unknown, diags
+	}
+	dlqConnectionName := types.StringNull()
+	if !plan.Options.IsNull() {
 		optionsModel := &TFOptionsModel{}
 		if diags := plan.Options.As(ctx, optionsModel, basetypes.ObjectAsOptions{}); diags.HasError() {
-			return nil, diags
+			return unknown, diags
+		}
+		if optionsModel.Dlq.IsUnknown() {
+			return unknown, nil
 		}
 		dlqModel := &TFDlqModel{}
 		if diags := optionsModel.Dlq.As(ctx, dlqModel, basetypes.ObjectAsOptions{}); diags.HasError() {
-			return nil, diags
-		}
-		streamProcessor.Options = &admin.StreamsOptions{
-			Dlq: &admin.StreamsDLQ{
-				Coll:           dlqModel.Coll.ValueStringPointer(),
-				ConnectionName: dlqModel.ConnectionName.ValueStringPointer(),
-				Db:             dlqModel.DB.ValueStringPointer(),
-			},
+			return unknown, diags
 		}
+		dlqConnectionName = dlqModel.ConnectionName
 	}
-
-	return streamProcessor, nil
+	if dlqConnectionName.IsUnknown() {
+		return unknown, nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, ReferencedConnections(stages, dlqConnectionName.ValueString()))
 }
 
 func NewStreamProcessorWithStats(ctx context.Context, projectID, instanceName string, apiResp *admin.StreamsProcessorWithStats) (*TFStreamProcessorRSModel, diag.Diagnostics) {
@@ -58,15 +123,21 @@ func NewStreamProcessorWithStats(ctx context.Context, projectID, instanceName st
 	if diags.HasError() {
 		return nil, diags
 	}
+	dlqConnectionName := apiResp.GetOptions().Dlq.GetConnectionName()
+	referencedConnectionsTF, diags := types.ListValueFrom(ctx, types.StringType, ReferencedConnections(apiResp.GetPipeline(), dlqConnectionName))
+	if diags.HasError() {
+		return nil, diags
+	}
 	tfModel := &TFStreamProcessorRSModel{
-		InstanceName:  types.StringPointerValue(&instanceName),
-		Options:       *optionsTF,
-		Pipeline:      pipelineTF,
-		ProcessorID:   types.StringPointerValue(&apiResp.Id),
-		ProcessorName: types.StringPointerValue(&apiResp.Name),
-		ProjectID:     types.StringPointerValue(&projectID),
-		State:         types.StringPointerValue(&apiResp.State),
-		Stats:         statsTF,
+		InstanceName:          types.StringPointerValue(&instanceName),
+		Options:               *optionsTF,
+		Pipeline:              pipelineTF,
+		ProcessorID:           types.StringPointerValue(&apiResp.Id),
+		ProcessorName:         types.StringPointerValue(&apiResp.Name),
+		ProjectID:             types.StringPointerValue(&projectID),
+		State:                 types.StringPointerValue(&apiResp.State),
+		Stats:                 statsTF,
+		ReferencedConnections: referencedConnectionsTF,
 	}
 	return tfModel, nil
 }
@@ -87,19 +158,115 @@ func NewTFStreamprocessorDSModel(ctx context.Context, projectID, instanceName st
 	if diags.HasError() {
 		return nil, diags
 	}
+	dlqConnectionName := apiResp.GetOptions().Dlq.GetConnectionName()
+	referencedConnectionsTF, diags := types.ListValueFrom(ctx, types.StringType, ReferencedConnections(apiResp.GetPipeline(), dlqConnectionName))
+	if diags.HasError() {
+		return nil, diags
+	}
 	tfModel := &TFStreamProcessorDSModel{
-		ID:            types.StringPointerValue(&apiResp.Id),
-		InstanceName:  types.StringPointerValue(&instanceName),
-		Options:       *optionsTF,
-		Pipeline:      types.StringValue(pipelineTF.ValueString()),
-		ProcessorName: types.StringPointerValue(&apiResp.Name),
-		ProjectID:     types.StringPointerValue(&projectID),
-		State:         types.StringPointerValue(&apiResp.State),
-		Stats:         statsTF,
+		ID:                    types.StringPointerValue(&apiResp.Id),
+		InstanceName:          types.StringPointerValue(&instanceName),
+		Options:               *optionsTF,
+		Pipeline:              types.StringValue(pipelineTF.ValueString()),
+		ProcessorName:         types.StringPointerValue(&apiResp.Name),
+		ProjectID:             types.StringPointerValue(&projectID),
+		State:                 types.StringPointerValue(&apiResp.State),
+		Stats:                 statsTF,
+		ReferencedConnections: referencedConnectionsTF,
 	}
 	return tfModel, nil
 }
 
+// streamProcessorStats decodes the untyped stats returned by the API, numbers are decoded as float64 as the API returns
+// counts and sizes both as integers and as decimals.
+type streamProcessorStats struct {
+	Status                         *string                 `json:"status"`
+	InputMessageCount              *float64                `json:"inputMessageCount"`
+	InputMessageSize               *float64                `json:"inputMessageSize"`
+	OutputMessageCount             *float64                `json:"outputMessageCount"`
+	OutputMessageSize              *float64                `json:"outputMessageSize"`
+	DlqMessageCount                *float64                `json:"dlqMessageCount"`
+	DlqMessageSize                 *float64                `json:"dlqMessageSize"`
+	MemoryTrackerBytes             *float64                `json:"memoryTrackerBytes"`
+	StateSize                      *float64                `json:"stateSize"`
+	ChangeStreamTimeDifferenceSecs *float64                `json:"changeStreamTimeDifferenceSecs"`
+	OperatorStats                  []streamProcessorOpStat `json:"operatorStats"`
+}
+
+type streamProcessorOpStat struct {
+	Name               *string  `json:"name"`
+	InputMessageCount  *float64 `json:"inputMessageCount"`
+	InputMessageSize   *float64 `json:"inputMessageSize"`
+	OutputMessageCount *float64 `json:"outputMessageCount"`
+	OutputMessageSize  *float64 `json:"outputMessageSize"`
+	DlqMessageCount    *float64 `json:"dlqMessageCount"`
+	DlqMessageSize     *float64 `json:"dlqMessageSize"`
+	StateSize          *float64 `json:"stateSize"`
+	MaxMemoryUsage     *float64 `json:"maxMemoryUsage"`
+	TimeSpentMillis    *float64 `json:"timeSpentMillis"`
+	ExecutionTimeSecs  *float64 `json:"executionTimeSecs"`
+}
+
+func NewTFStreamProcessorStats(ctx context.Context, projectID, instanceName string, apiResp *admin.StreamsProcessorWithStats) (*TFStreamProcessorStatsModel, diag.Diagnostics) {
+	if apiResp == nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("streamProcessor API response is nil", "")}
+	}
+	stats := streamProcessorStats{}
+	if apiStats := apiResp.GetStats(); apiStats != nil {
+		statsJSON, err := json.Marshal(apiStats)
+		if err == nil {
+			err = json.Unmarshal(statsJSON, &stats)
+		}
+		if err != nil {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic("failed to decode stats", err.Error())}
+		}
+	}
+	operatorStats := make([]TFOperatorStatsModel, len(stats.OperatorStats))
+	for i, opStat := range stats.OperatorStats {
+		operatorStats[i] = TFOperatorStatsModel{
+			Name:               types.StringPointerValue(opStat.Name),
+			InputMessageCount:  int64Value(opStat.InputMessageCount),
+			InputMessageSize:   types.Float64PointerValue(opStat.InputMessageSize),
+			OutputMessageCount: int64Value(opStat.OutputMessageCount),
+			OutputMessageSize:  types.Float64PointerValue(opStat.OutputMessageSize),
+			DlqMessageCount:    int64Value(opStat.DlqMessageCount),
+			DlqMessageSize:     types.Float64PointerValue(opStat.DlqMessageSize),
+			StateSize:          types.Float64PointerValue(opStat.StateSize),
+			MaxMemoryUsage:     types.Float64PointerValue(opStat.MaxMemoryUsage),
+			TimeSpentMillis:    int64Value(opStat.TimeSpentMillis),
+			ExecutionTimeSecs:  int64Value(opStat.ExecutionTimeSecs),
+		}
+	}
+	operatorStatsTF, diags := types.ListValueFrom(ctx, OperatorStatsObjectType, operatorStats)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &TFStreamProcessorStatsModel{
+		ProjectID:          types.StringValue(projectID),
+		InstanceName:       types.StringValue(instanceName),
+		ProcessorName:      types.StringValue(apiResp.GetName()),
+		State:              types.StringValue(apiResp.GetState()),
+		Status:             types.StringPointerValue(stats.Status),
+		InputMessageCount:  int64Value(stats.InputMessageCount),
+		InputMessageSize:   types.Float64PointerValue(stats.InputMessageSize),
+		OutputMessageCount: int64Value(stats.OutputMessageCount),
+		OutputMessageSize:  types.Float64PointerValue(stats.OutputMessageSize),
+		DlqMessageCount:    int64Value(stats.DlqMessageCount),
+		DlqMessageSize:     types.Float64PointerValue(stats.DlqMessageSize),
+		MemoryTrackerBytes: types.Float64PointerValue(stats.MemoryTrackerBytes),
+		StateSize:          types.Float64PointerValue(stats.StateSize),
+		WatermarkLagSecs:   types.Float64PointerValue(stats.ChangeStreamTimeDifferenceSecs),
+		OperatorStats:      operatorStatsTF,
+	}, nil
+}
+
+func int64Value(value *float64) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
 func ConvertOptionsToTF(ctx context.Context, options *admin.StreamsOptions) (*types.Object, diag.Diagnostics) {
 	if options == nil || !options.HasDlq() {
 		optionsTF := types.ObjectNull(OptionsObjectType.AttributeTypes())
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
@@ -105,15 +106,20 @@ func streamProcessorWithStats(t *testing.T, options *admin.StreamsOptions) *admi
 
 func streamProcessorDSTFModel(t *testing.T, state, stats string, options types.Object) *streamprocessor.TFStreamProcessorDSModel {
 	t.Helper()
+	referencedConnections := referencedConnectionsTF("sample_stream_solar", "__testLog")
+	if !options.IsNull() {
+		referencedConnections = referencedConnectionsTF("sample_stream_solar", "__testLog", "testConnection")
+	}
 	return &streamprocessor.TFStreamProcessorDSModel{
-		ID:            types.StringValue(processorID),
-		InstanceName:  types.StringValue(instanceName),
-		Options:       options,
-		Pipeline:      types.StringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
-		ProcessorName: types.StringValue(processorName),
-		ProjectID:     types.StringValue(projectID),
-		State:         conversion.StringNullIfEmpty(state),
-		Stats:         conversion.StringNullIfEmpty(stats),
+		ID:                    types.StringValue(processorID),
+		InstanceName:          types.StringValue(instanceName),
+		Options:               options,
+		Pipeline:              types.StringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+		ProcessorName:         types.StringValue(processorName),
+		ProjectID:             types.StringValue(projectID),
+		State:                 conversion.StringNullIfEmpty(state),
+		Stats:                 conversion.StringNullIfEmpty(stats),
+		ReferencedConnections: referencedConnections,
 	}
 }
 
@@ -189,42 +195,45 @@ func TestSDKToTFModel(t *testing.T) {
 				processorID, processorName, []any{pipelineStageSourceSample, pipelineStageEmitLog}, "CREATED",
 			),
 			expectedTFModel: &streamprocessor.TFStreamProcessorRSModel{
-				InstanceName:  types.StringValue(instanceName),
-				Options:       types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
-				ProcessorID:   types.StringValue(processorID),
-				Pipeline:      fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
-				ProcessorName: types.StringValue(processorName),
-				ProjectID:     types.StringValue(projectID),
-				State:         types.StringValue("CREATED"),
-				Stats:         types.StringNull(),
+				InstanceName:          types.StringValue(instanceName),
+				Options:               types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+				ProcessorID:           types.StringValue(processorID),
+				Pipeline:              fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+				ProcessorName:         types.StringValue(processorName),
+				ProjectID:             types.StringValue(projectID),
+				State:                 types.StringValue("CREATED"),
+				Stats:                 types.StringNull(),
+				ReferencedConnections: referencedConnectionsTF("sample_stream_solar", "__testLog"),
 			},
 		},
 		{
 			name:     "afterStarted",
 			sdkModel: streamProcessorWithStats(t, nil),
 			expectedTFModel: &streamprocessor.TFStreamProcessorRSModel{
-				InstanceName:  types.StringValue(instanceName),
-				Options:       types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
-				ProcessorID:   types.StringValue(processorID),
-				Pipeline:      fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
-				ProcessorName: types.StringValue(processorName),
-				ProjectID:     types.StringValue(projectID),
-				State:         types.StringValue("STARTED"),
-				Stats:         types.StringValue(statsExample),
+				InstanceName:          types.StringValue(instanceName),
+				Options:               types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+				ProcessorID:           types.StringValue(processorID),
+				Pipeline:              fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+				ProcessorName:         types.StringValue(processorName),
+				ProjectID:             types.StringValue(projectID),
+				State:                 types.StringValue("STARTED"),
+				Stats:                 types.StringValue(statsExample),
+				ReferencedConnections: referencedConnectionsTF("sample_stream_solar", "__testLog"),
 			},
 		},
 		{
 			name:     "withOptions",
 			sdkModel: streamProcessorWithStats(t, &streamOptionsExample),
 			expectedTFModel: &streamprocessor.TFStreamProcessorRSModel{
-				InstanceName:  types.StringValue(instanceName),
-				Options:       optionsToTFModel(t, &streamOptionsExample),
-				ProcessorID:   types.StringValue(processorID),
-				Pipeline:      fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
-				ProcessorName: types.StringValue(processorName),
-				ProjectID:     types.StringValue(projectID),
-				State:         types.StringValue("STARTED"),
-				Stats:         types.StringNull(),
+				InstanceName:          types.StringValue(instanceName),
+				Options:               optionsToTFModel(t, &streamOptionsExample),
+				ProcessorID:           types.StringValue(processorID),
+				Pipeline:              fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+				ProcessorName:         types.StringValue(processorName),
+				ProjectID:             types.StringValue(projectID),
+				State:                 types.StringValue("STARTED"),
+				Stats:                 types.StringNull(),
+				ReferencedConnections: referencedConnectionsTF("sample_stream_solar", "__testLog", "testConnection"),
 			},
 		},
 	}
@@ -237,6 +246,7 @@ func TestSDKToTFModel(t *testing.T) {
 				t.Fatalf("unexpected errors found: %s", diags.Errors()[0].Summary())
 			}
 			assert.Equal(t, tc.expectedTFModel.Options, resultModel.Options)
+			assert.Equal(t, tc.expectedTFModel.ReferencedConnections, resultModel.ReferencedConnections)
 			if sdkModel.Stats != nil {
 				assert.True(t, schemafunc.EqualJSON(resultModel.Pipeline.String(), tc.expectedTFModel.Pipeline.String(), "test stream processor schema"))
 				var statsResult any
@@ -252,6 +262,15 @@ func TestSDKToTFModel(t *testing.T) {
 		})
 	}
 }
+
+func referencedConnectionsTF(names ...string) types.List {
+	values := make([]attr.Value, len(names))
+	for i, name := range names {
+		values[i] = types.StringValue(name)
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
 func TestPluralDSSDKToTFModel(t *testing.T) {
 	testCases := map[string]struct {
 		sdkModel        *admin.PaginatedApiStreamsStreamProcessorWithStats
@@ -294,3 +313,145 @@ func TestPluralDSSDKToTFModel(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamProcessorModifyReq(t *testing.T) {
+	pipeline := fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]")
+	expectedPipeline := []any{pipelineStageSourceSample, pipelineStageEmitLog}
+	testCases := []struct {
+		tfModel     *streamprocessor.TFStreamProcessorRSModel
+		expectedReq *admin.StreamsModifyStreamProcessor
+		name        string
+	}{
+		{
+			name: "pipelineOnly",
+			tfModel: &streamprocessor.TFStreamProcessorRSModel{
+				Pipeline:             pipeline,
+				Options:              types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+				ResumeFromCheckpoint: types.BoolNull(),
+			},
+			expectedReq: &admin.StreamsModifyStreamProcessor{
+				Pipeline: &expectedPipeline,
+			},
+		},
+		{
+			name: "startFresh",
+			tfModel: &streamprocessor.TFStreamProcessorRSModel{
+				Pipeline:             pipeline,
+				Options:              types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+				ResumeFromCheckpoint: types.BoolValue(false),
+			},
+			expectedReq: &admin.StreamsModifyStreamProcessor{
+				Pipeline: &expectedPipeline,
+				Options: &admin.StreamsModifyStreamProcessorOptions{
+					ResumeFromCheckpoint: conversion.Pointer(false),
+				},
+			},
+		},
+		{
+			name: "withOptions",
+			tfModel: &streamprocessor.TFStreamProcessorRSModel{
+				Pipeline:             pipeline,
+				Options:              optionsToTFModel(t, &streamOptionsExample),
+				ResumeFromCheckpoint: types.BoolValue(true),
+			},
+			expectedReq: &admin.StreamsModifyStreamProcessor{
+				Pipeline: &expectedPipeline,
+				Options: &admin.StreamsModifyStreamProcessorOptions{
+					Dlq:                  streamOptionsExample.Dlq,
+					ResumeFromCheckpoint: conversion.Pointer(true),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			modifyReq, diags := streamprocessor.NewStreamProcessorModifyReq(context.Background(), tc.tfModel)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors found: %s", diags.Errors()[0].Summary())
+			}
+			assert.Equal(t, tc.expectedReq, modifyReq)
+		})
+	}
+}
+
+func TestStatsSDKToTFModel(t *testing.T) {
+	operatorStats := func(name string, outputMessageSize float64) attr.Value {
+		return types.ObjectValueMust(streamprocessor.OperatorStatsObjectType.AttrTypes, map[string]attr.Value{
+			"name":                 types.StringValue(name),
+			"input_message_count":  types.Int64Value(12),
+			"input_message_size":   types.Float64Value(4681),
+			"output_message_count": types.Int64Value(12),
+			"output_message_size":  types.Float64Value(outputMessageSize),
+			"dlq_message_count":    types.Int64Value(0),
+			"dlq_message_size":     types.Float64Value(0),
+			"state_size":           types.Float64Value(0),
+			"max_memory_usage":     types.Float64Value(0),
+			"time_spent_millis":    types.Int64Value(0),
+			"execution_time_secs":  types.Int64Value(0),
+		})
+	}
+	testCases := []struct {
+		sdkModel        *admin.StreamsProcessorWithStats
+		expectedTFModel *streamprocessor.TFStreamProcessorStatsModel
+		name            string
+	}{
+		{
+			name: "withoutStats",
+			sdkModel: admin.NewStreamsProcessorWithStats(
+				processorID, processorName, []any{pipelineStageSourceSample, pipelineStageEmitLog}, stateCreated,
+			),
+			expectedTFModel: &streamprocessor.TFStreamProcessorStatsModel{
+				ProjectID:          types.StringValue(projectID),
+				InstanceName:       types.StringValue(instanceName),
+				ProcessorName:      types.StringValue(processorName),
+				State:              types.StringValue(stateCreated),
+				Status:             types.StringNull(),
+				InputMessageCount:  types.Int64Null(),
+				InputMessageSize:   types.Float64Null(),
+				OutputMessageCount: types.Int64Null(),
+				OutputMessageSize:  types.Float64Null(),
+				DlqMessageCount:    types.Int64Null(),
+				DlqMessageSize:     types.Float64Null(),
+				MemoryTrackerBytes: types.Float64Null(),
+				StateSize:          types.Float64Null(),
+				WatermarkLagSecs:   types.Float64Null(),
+				OperatorStats:      types.ListValueMust(streamprocessor.OperatorStatsObjectType, []attr.Value{}),
+			},
+		},
+		{
+			name:     "withStats",
+			sdkModel: streamProcessorWithStats(t, nil),
+			expectedTFModel: &streamprocessor.TFStreamProcessorStatsModel{
+				ProjectID:          types.StringValue(projectID),
+				InstanceName:       types.StringValue(instanceName),
+				ProcessorName:      types.StringValue(processorName),
+				State:              types.StringValue(stateStarted),
+				Status:             types.StringValue("running"),
+				InputMessageCount:  types.Int64Value(12),
+				InputMessageSize:   types.Float64Value(4681),
+				OutputMessageCount: types.Int64Value(12),
+				OutputMessageSize:  types.Float64Value(4681),
+				DlqMessageCount:    types.Int64Value(0),
+				DlqMessageSize:     types.Float64Value(0),
+				MemoryTrackerBytes: types.Float64Value(0),
+				StateSize:          types.Float64Value(0),
+				WatermarkLagSecs:   types.Float64Null(),
+				OperatorStats: types.ListValueMust(streamprocessor.OperatorStatsObjectType, []attr.Value{
+					operatorStats("SampleDataSourceOperator", 0),
+					operatorStats("LogSinkOperator", 4681),
+				}),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resultModel, diags := streamprocessor.NewTFStreamProcessorStats(context.Background(), projectID, instanceName, tc.sdkModel)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors found: %s", diags.Errors()[0].Summary())
+			}
+			assert.Equal(t, tc.expectedTFModel, resultModel)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package streamprocessor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	stageSource = "$source"
+	stageEmit   = "$emit"
+	stageMerge  = "$merge"
+
+	// builtInConnectionPrefix identifies connections provided by Atlas Stream Processing, e.g. __testLog, that are not declared in the instance.
+	builtInConnectionPrefix = "__"
+)
+
+var (
+	sinkStages = []string{stageEmit, stageMerge}
+
+	// windowStages contain an inner pipeline that is analyzed as well.
+	windowStages = []string{"$tumblingWindow", "$hoppingWindow", "$sessionWindow"}
+
+	knownStages = append(append([]string{
+		stageSource,
+		"$validate",
+		"$lookup",
+		"$cachedLookup",
+		"$https",
+		"$externalFunction",
+		"$addFields",
+		"$set",
+		"$unset",
+		"$project",
+		"$match",
+		"$redact",
+		"$replaceRoot",
+		"$replaceWith",
+		"$unwind",
+		"$group",
+		"$sort",
+		"$limit",
+		"$count",
+	}, windowStages...), sinkStages...)
+)
+
+// PipelineAnalysis is the result of the static analysis of a stream processor pipeline.
+type PipelineAnalysis struct {
+	// ReferencedConnections are the connection names used by the pipeline stages in order of appearance, without duplicates.
+	ReferencedConnections []string
+	// UnknownStages are stage names that are not recognized by the provider, they are reported as warnings as Atlas may support them.
+	UnknownStages []string
+}
+
+// AnalyzePipeline checks the structure of a stream processor pipeline: the first stage must be a $source, the last stage must be a sink ($emit or $merge)
+// and every stage must be a document with a single $-prefixed key. It returns the connections referenced by the stages.
+func AnalyzePipeline(pipeline string) (*PipelineAnalysis, diag.Diagnostics) {
+	stages, diags := convertPipelineToSdk(pipeline)
+	if diags.HasError() {
+		return nil, diags
+	}
+	analysis := &PipelineAnalysis{}
+	names, errs := analyzeStages(stages, analysis)
+	switch {
+	case len(names) == 0:
+		errs = append(errs, fmt.Errorf("pipeline must have at least a %s and a sink stage", stageSource))
+	default:
+		if first := names[0]; first != "" && first != stageSource {
+			errs = append(errs, fmt.Errorf("first stage must be %s, got %s", stageSource, first))
+		}
+		if last := names[len(names)-1]; last != "" && !slices.Contains(sinkStages, last) {
+			errs = append(errs, fmt.Errorf("last stage must be one of %s, got %s", strings.Join(sinkStages, ", "), last))
+		}
+	}
+	for i, name := range names {
+		if i > 0 && name == stageSource {
+			errs = append(errs, fmt.Errorf("%s can only be used as the first stage, found at position %d", stageSource, i))
+		}
+		if i < len(names)-1 && slices.Contains(sinkStages, name) {
+			errs = append(errs, fmt.Errorf("%s can only be used as the last stage, found at position %d", name, i))
+		}
+	}
+	for _, err := range errs {
+		diags.AddError(errorInvalidPipeline, err.Error())
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return analysis, nil
+}
+
+// ReferencedConnections returns the connections used by the pipeline stages and the DLQ, it doesn't fail for pipelines with an invalid structure.
+func ReferencedConnections(stages []any, dlqConnectionName string) []string {
+	analysis := &PipelineAnalysis{ReferencedConnections: []string{}}
+	analyzeStages(stages, analysis)
+	addConnection(analysis, dlqConnectionName)
+	return analysis.ReferencedConnections
+}
+
+// MissingConnections returns the referenced connections that are not in the instance connections. Built-in connections are never missing.
+func MissingConnections(referenced []string, instanceConnections map[string]bool) []string {
+	var missing []string
+	for _, name := range referenced {
+		if !strings.HasPrefix(name, builtInConnectionPrefix) && !instanceConnections[name] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// analyzeStages returns the stage names and the structural errors found, stages with an invalid structure have an empty name.
+func analyzeStages(stages []any, analysis *PipelineAnalysis) (names []string, errs []error) {
+	names = make([]string, len(stages))
+	for i, stage := range stages {
+		stageMap, ok := stage.(map[string]any)
+		if !ok || len(stageMap) != 1 {
+			errs = append(errs, fmt.Errorf("stage at position %d must be a document with a single stage name key", i))
+			continue
+		}
+		for name, spec := range stageMap {
+			if !strings.HasPrefix(name, "$") {
+				errs = append(errs, fmt.Errorf("stage name %q at position %d must start with $", name, i))
+				continue
+			}
+			names[i] = name
+			if !slices.Contains(knownStages, name) && !slices.Contains(analysis.UnknownStages, name) {
+				analysis.UnknownStages = append(analysis.UnknownStages, name)
+			}
+			specMap, _ := spec.(map[string]any)
+			addConnection(analysis, stageConnectionName(name, specMap))
+			if !slices.Contains(windowStages, name) {
+				continue
+			}
+			inner, _ := specMap["pipeline"].([]any)
+			innerNames, innerErrs := analyzeStages(inner, analysis)
+			for _, err := range innerErrs {
+				errs = append(errs, fmt.Errorf("%s at position %d: %w", name, i, err))
+			}
+			for _, innerName := range innerNames {
+				if innerName == stageSource || slices.Contains(sinkStages, innerName) {
+					errs = append(errs, fmt.Errorf("%s at position %d can't contain a %s stage", name, i, innerName))
+				}
+			}
+		}
+	}
+	return names, errs
+}
+
+// stageConnectionName returns the connection name referenced by a stage, $merge and $lookup stages reference it in a nested document.
+func stageConnectionName(name string, spec map[string]any) string {
+	switch name {
+	case stageMerge:
+		spec, _ = spec["into"].(map[string]any)
+	case "$lookup", "$cachedLookup":
+		spec, _ = spec["from"].(map[string]any)
+	}
+	connectionName, _ := spec["connectionName"].(string)
+	return connectionName
+}
+
+func addConnection(analysis *PipelineAnalysis, connectionName string) {
+	if connectionName != "" && !slices.Contains(analysis.ReferencedConnections, connectionName) {
+		analysis.ReferencedConnections = append(analysis.ReferencedConnections, connectionName)
+	}
+}
@@ -0,0 +1,103 @@
+package streamprocessor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+)
+
+func TestAnalyzePipeline(t *testing.T) {
+	testCases := map[string]struct {
+		pipeline              string
+		expectedConnections   []string
+		expectedUnknownStages []string
+		expectedErrors        []string
+	}{
+		"sourceAndEmit": {
+			pipeline:            `[{"$source":{"connectionName":"sample_stream_solar"}},{"$emit":{"connectionName":"__testLog"}}]`,
+			expectedConnections: []string{"sample_stream_solar", "__testLog"},
+		},
+		"mergeLookupAndWindow": {
+			pipeline: `[
+				{"$source":{"connectionName":"kafka"}},
+				{"$lookup":{"from":{"connectionName":"cluster","db":"db","coll":"coll"},"localField":"a","foreignField":"b","as":"c"}},
+				{"$tumblingWindow":{"interval":{"size":10,"unit":"second"},"pipeline":[{"$group":{"_id":"$a"}}]}},
+				{"$https":{"connectionName":"webhook","method":"POST"}},
+				{"$merge":{"into":{"connectionName":"cluster","db":"db","coll":"out"}}}
+			]`,
+			expectedConnections: []string{"kafka", "cluster", "webhook"},
+		},
+		"unknownStage": {
+			pipeline:              `[{"$source":{"connectionName":"kafka"}},{"$newStage":{}},{"$emit":{"connectionName":"kafka2","topic":"t"}}]`,
+			expectedConnections:   []string{"kafka", "kafka2"},
+			expectedUnknownStages: []string{"$newStage"},
+		},
+		"sourceWithDocuments": {
+			pipeline:            `[{"$source":{"documents":[{"a":1}]}},{"$emit":{"connectionName":"__testLog"}}]`,
+			expectedConnections: []string{"__testLog"},
+		},
+		"empty": {
+			pipeline:       `[]`,
+			expectedErrors: []string{"pipeline must have at least a $source and a sink stage"},
+		},
+		"noSource": {
+			pipeline:       `[{"$match":{"a":1}},{"$emit":{"connectionName":"__testLog"}}]`,
+			expectedErrors: []string{"first stage must be $source, got $match"},
+		},
+		"noSink": {
+			pipeline:       `[{"$source":{"connectionName":"kafka"}},{"$match":{"a":1}}]`,
+			expectedErrors: []string{"last stage must be one of $emit, $merge, got $match"},
+		},
+		"sinkInTheMiddle": {
+			pipeline:       `[{"$source":{"connectionName":"kafka"}},{"$emit":{"connectionName":"kafka"}},{"$merge":{"into":{"connectionName":"cluster"}}}]`,
+			expectedErrors: []string{"$emit can only be used as the last stage, found at position 1"},
+		},
+		"invalidStages": {
+			pipeline: `[{"$source":{"connectionName":"kafka"}},{"match":{}},{"$match":{},"$project":{}},{"$emit":{"connectionName":"kafka"}}]`,
+			expectedErrors: []string{
+				`stage name "match" at position 1 must start with $`,
+				"stage at position 2 must be a document with a single stage name key",
+			},
+		},
+		"sourceInWindow": {
+			pipeline:       `[{"$source":{"connectionName":"kafka"}},{"$hoppingWindow":{"pipeline":[{"$source":{}}]}},{"$emit":{"connectionName":"kafka"}}]`,
+			expectedErrors: []string{"$hoppingWindow at position 1 can't contain a $source stage"},
+		},
+		"notAnArray": {
+			pipeline:       `{"$source":{}}`,
+			expectedErrors: []string{"failed to unmarshal pipeline"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			analysis, diags := streamprocessor.AnalyzePipeline(tc.pipeline)
+			if len(tc.expectedErrors) > 0 {
+				require.True(t, diags.HasError())
+				require.Len(t, diags, len(tc.expectedErrors))
+				for i, expected := range tc.expectedErrors {
+					assert.Contains(t, diags[i].Summary()+" "+diags[i].Detail(), expected)
+				}
+				return
+			}
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tc.expectedConnections, analysis.ReferencedConnections)
+			assert.Equal(t, tc.expectedUnknownStages, analysis.UnknownStages)
+		})
+	}
+}
+
+func TestReferencedConnections(t *testing.T) {
+	invalidPipeline := []any{map[string]any{"$emit": map[string]any{"connectionName": "kafka"}}, "invalid"}
+	assert.Equal(t, []string{"kafka", "cluster"}, streamprocessor.ReferencedConnections(invalidPipeline, "cluster"))
+	assert.Equal(t, []string{"kafka"}, streamprocessor.ReferencedConnections(invalidPipeline, "kafka"))
+	assert.Empty(t, streamprocessor.ReferencedConnections(nil, ""))
+}
+
+func TestMissingConnections(t *testing.T) {
+	instanceConnections := map[string]bool{"kafka": true}
+	assert.Equal(t, []string{"cluster"}, streamprocessor.MissingConnections([]string{"kafka", "__testLog", "cluster"}, instanceConnections))
+	assert.Empty(t, streamprocessor.MissingConnections([]string{"kafka", "__testLog"}, instanceConnections))
+}
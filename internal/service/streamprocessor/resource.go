@@ -2,14 +2,18 @@ package streamprocessor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 	"go.mongodb.org/atlas-sdk/v20241113004/admin"
 )
@@ -18,11 +22,16 @@ const StreamProcessorName = "stream_processor"
 
 var _ resource.ResourceWithConfigure = &streamProcessorRS{}
 var _ resource.ResourceWithImportState = &streamProcessorRS{}
+var _ resource.ResourceWithValidateConfig = &streamProcessorRS{}
+var _ resource.ResourceWithModifyPlan = &streamProcessorRS{}
 
 const (
 	errorCreateStartActions    = "You need to fix the processor and import the resource or delete it manually and re-run terraform apply."
 	errorCreateStart           = "Error starting stream processor. " + errorCreateStartActions
 	errorCreateStartTransition = "Error changing state of stream processor. " + errorCreateStartActions
+	errorInvalidPipeline       = "invalid stream processor pipeline"
+	errorMissingConnections    = "stream processor pipeline references connections that don't exist in the stream instance"
+	missingConnectionsDetail   = "connections %s are not found in stream instance %s. Make sure they are created before the stream processor, e.g. by referencing the mongodbatlas_stream_connection resources or using depends_on."
 )
 
 func Resource() resource.Resource {
@@ -42,6 +51,62 @@ func (r *streamProcessorRS) Schema(ctx context.Context, req resource.SchemaReque
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *streamProcessorRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var pipeline fwtypes.JSONString
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pipeline"), &pipeline)...)
+	// invalid JSON is reported by the attribute type
+	if resp.Diagnostics.HasError() || pipeline.IsNull() || pipeline.IsUnknown() || !json.Valid([]byte(pipeline.ValueString())) {
+		return
+	}
+	analysis, diags := AnalyzePipeline(pipeline.ValueString())
+	if diags.HasError() {
+		for _, d := range diags {
+			resp.Diagnostics.AddAttributeError(path.Root("pipeline"), d.Summary(), d.Detail())
+		}
+		return
+	}
+	if len(analysis.UnknownStages) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("pipeline"), "unknown stream processor pipeline stages",
+			fmt.Sprintf("stages %s are not recognized by the provider, the pipeline will be validated by Atlas when the stream processor is created or updated.", strings.Join(analysis.UnknownStages, ", ")))
+	}
+}
+
+func (r *streamProcessorRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan TFStreamProcessorRSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	referencedConnections, diags := NewReferencedConnectionsTF(ctx, &plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("referenced_connections"), referencedConnections)...)
+	if referencedConnections.IsUnknown() || plan.ProjectID.IsUnknown() || plan.InstanceName.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state TFStreamProcessorRSModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || !updatedPipelineOrOptions(&plan, &state) {
+			return
+		}
+	}
+	// connections can be created in the same apply, so missing connections are only an error when the stream processor is created or updated
+	missing, err := getMissingConnections(ctx, r.Client.AtlasV2.StreamsApi, plan.ProjectID.ValueString(), plan.InstanceName.ValueString(), conversion.TypesListToString(ctx, referencedConnections))
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching stream connections to validate the stream processor pipeline", err.Error())
+		return
+	}
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("pipeline"), errorMissingConnections, fmt.Sprintf(missingConnectionsDetail, strings.Join(missing, ", "), plan.InstanceName.ValueString()))
+	}
+}
+
 func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TFStreamProcessorRSModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -72,6 +137,10 @@ func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateReque
 	projectID := plan.ProjectID.ValueString()
 	instanceName := plan.InstanceName.ValueString()
 	processorName := plan.ProcessorName.ValueString()
+	if err := checkReferencedConnections(ctx, connV2.StreamsApi, projectID, instanceName, streamProcessorReq.GetPipeline(), streamProcessorReq.GetOptions().Dlq); err != nil {
+		resp.Diagnostics.AddError(errorMissingConnections, err.Error())
+		return
+	}
 	_, _, err := connV2.StreamsApi.CreateStreamProcessor(ctx, projectID, instanceName, streamProcessorReq).Execute()
 
 	if err != nil {
@@ -115,6 +184,7 @@ func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamProcessorModel.ResumeFromCheckpoint = plan.ResumeFromCheckpoint
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
 }
 
@@ -144,6 +214,7 @@ func (r *streamProcessorRS) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamProcessorModel.ResumeFromCheckpoint = state.ResumeFromCheckpoint
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
 }
 
@@ -158,62 +229,84 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	connV2 := r.Client.AtlasV2
-	pendingStates := []string{CreatedState}
-	desiredState := []string{}
 	projectID := plan.ProjectID.ValueString()
 	instanceName := plan.InstanceName.ValueString()
 	processorName := plan.ProcessorName.ValueString()
 	currentState := state.State.ValueString()
-	if !updatedStateOnly(&plan, &state) {
-		resp.Diagnostics.AddError("updating a Stream Processor is not supported", "")
+	desiredState := currentState
+	if !plan.State.IsNull() && !plan.State.IsUnknown() {
+		desiredState = plan.State.ValueString()
+	}
+	if !sameIdentity(&plan, &state) {
+		resp.Diagnostics.AddError("updating the project_id, instance_name or processor_name of a Stream Processor is not supported", "")
 		return
 	}
-	switch plan.State.ValueString() {
-	case StartedState:
-		desiredState = append(desiredState, StartedState)
-		pendingStates = append(pendingStates, StoppedState)
-		_, _, err := connV2.StreamsApi.StartStreamProcessorWithParams(ctx,
-			&admin.StartStreamProcessorApiParams{
-				GroupId:       projectID,
-				TenantName:    instanceName,
-				ProcessorName: processorName,
-			},
-		).Execute()
-		if err != nil {
-			resp.Diagnostics.AddError("Error starting stream processor", err.Error())
+	if !plan.Options.IsUnknown() && plan.Options.IsNull() && !state.Options.IsNull() {
+		resp.Diagnostics.AddError("removing the options of a Stream Processor is not supported", "")
+		return
+	}
+	if desiredState == StoppedState && currentState != StoppedState && currentState != StartedState {
+		resp.Diagnostics.AddError(fmt.Sprintf("Stream Processor must be in %s state to transition to %s state", StartedState, StoppedState), "")
+		return
+	}
+
+	requestParams := &admin.GetStreamProcessorApiParams{
+		GroupId:       projectID,
+		TenantName:    instanceName,
+		ProcessorName: processorName,
+	}
+
+	var streamProcessorResp *admin.StreamsProcessorWithStats
+	if updatedPipelineOrOptions(&plan, &state) {
+		modifyReq, diags := NewStreamProcessorModifyReq(ctx, &plan)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
 		}
-	case StoppedState:
-		if currentState != StartedState {
-			resp.Diagnostics.AddError(fmt.Sprintf("Stream Processor must be in %s state to transition to %s state", StartedState, StoppedState), "")
+		if err := checkReferencedConnections(ctx, connV2.StreamsApi, projectID, instanceName, modifyReq.GetPipeline(), modifyReq.GetOptions().Dlq); err != nil {
+			resp.Diagnostics.AddError(errorMissingConnections, err.Error())
 			return
 		}
-		desiredState = append(desiredState, StoppedState)
-		pendingStates = append(pendingStates, StartedState)
-		_, _, err := connV2.StreamsApi.StopStreamProcessorWithParams(ctx,
-			&admin.StopStreamProcessorApiParams{
-				GroupId:       projectID,
-				TenantName:    instanceName,
-				ProcessorName: processorName,
-			},
-		).Execute()
+		// a running processor must be stopped before it can be modified, it's restarted afterwards if it must keep running
+		if currentState == StartedState {
+			if _, err := stopStreamProcessor(ctx, connV2.StreamsApi, requestParams); err != nil {
+				resp.Diagnostics.AddError("Error stopping stream processor before modifying it", err.Error())
+				return
+			}
+		}
+		var err error
+		streamProcessorResp, _, err = connV2.StreamsApi.ModifyStreamProcessor(ctx, projectID, instanceName, processorName, modifyReq).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error stopping stream processor", err.Error())
+			resp.Diagnostics.AddError("Error modifying stream processor", err.Error())
 			return
 		}
-	default:
-		resp.Diagnostics.AddError("transitions to states other than STARTED or STOPPED are not supported", "")
-		return
+		currentState = streamProcessorResp.GetState()
 	}
 
-	requestParams := &admin.GetStreamProcessorApiParams{
-		GroupId:       projectID,
-		TenantName:    instanceName,
-		ProcessorName: processorName,
+	if desiredState != currentState {
+		var err error
+		switch desiredState {
+		case StartedState:
+			streamProcessorResp, err = startStreamProcessor(ctx, connV2.StreamsApi, requestParams)
+		case StoppedState:
+			streamProcessorResp, err = stopStreamProcessor(ctx, connV2.StreamsApi, requestParams)
+		default:
+			resp.Diagnostics.AddError("transitions to states other than STARTED or STOPPED are not supported", "")
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error changing state of stream processor", err.Error())
+			return
+		}
 	}
 
-	streamProcessorResp, err := WaitStateTransition(ctx, requestParams, connV2.StreamsApi, pendingStates, desiredState)
-	if err != nil {
-		resp.Diagnostics.AddError("Error changing state of stream processor", err.Error())
+	if streamProcessorResp == nil {
+		var err error
+		streamProcessorResp, _, err = connV2.StreamsApi.GetStreamProcessorWithParams(ctx, requestParams).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("error fetching resource", err.Error())
+			return
+		}
 	}
 
 	newStreamProcessorModel, diags := NewStreamProcessorWithStats(ctx, projectID, instanceName, streamProcessorResp)
@@ -221,6 +314,7 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamProcessorModel.ResumeFromCheckpoint = plan.ResumeFromCheckpoint
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
 }
 
@@ -266,11 +360,76 @@ func splitImportID(id string) (projectID, instanceName, processorName *string, e
 	return
 }
 
-func updatedStateOnly(plan, state *TFStreamProcessorRSModel) bool {
+func sameIdentity(plan, state *TFStreamProcessorRSModel) bool {
 	return plan.ProjectID.Equal(state.ProjectID) &&
 		plan.InstanceName.Equal(state.InstanceName) &&
-		plan.ProcessorName.Equal(state.ProcessorName) &&
-		plan.Pipeline.Equal(state.Pipeline) &&
-		(plan.Options.Equal(state.Options) || plan.Options.IsUnknown()) &&
-		!plan.State.Equal(state.State)
+		plan.ProcessorName.Equal(state.ProcessorName)
+}
+
+func updatedPipelineOrOptions(plan, state *TFStreamProcessorRSModel) bool {
+	return !plan.Pipeline.Equal(state.Pipeline) ||
+		(!plan.Options.Equal(state.Options) && !plan.Options.IsUnknown())
+}
+
+func startStreamProcessor(ctx context.Context, client admin.StreamsApi, requestParams *admin.GetStreamProcessorApiParams) (*admin.StreamsProcessorWithStats, error) {
+	_, _, err := client.StartStreamProcessorWithParams(ctx,
+		&admin.StartStreamProcessorApiParams{
+			GroupId:       requestParams.GroupId,
+			TenantName:    requestParams.TenantName,
+			ProcessorName: requestParams.ProcessorName,
+		},
+	).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return WaitStateTransition(ctx, requestParams, client, []string{CreatedState, StoppedState}, []string{StartedState})
+}
+
+func stopStreamProcessor(ctx context.Context, client admin.StreamsApi, requestParams *admin.GetStreamProcessorApiParams) (*admin.StreamsProcessorWithStats, error) {
+	_, _, err := client.StopStreamProcessorWithParams(ctx,
+		&admin.StopStreamProcessorApiParams{
+			GroupId:       requestParams.GroupId,
+			TenantName:    requestParams.TenantName,
+			ProcessorName: requestParams.ProcessorName,
+		},
+	).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return WaitStateTransition(ctx, requestParams, client, []string{CreatedState, StartedState}, []string{StoppedState})
+}
+
+// getMissingConnections returns the referenced connections not found in the stream instance, none are reported if the instance doesn't exist yet.
+func getMissingConnections(ctx context.Context, client admin.StreamsApi, projectID, instanceName string, referenced []string) ([]string, error) {
+	if len(referenced) == 0 {
+		return nil, nil
+	}
+	instanceNotFound := false
+	connections, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.StreamsConnection], *http.Response, error) {
+		resp, httpResp, err := client.ListStreamConnections(ctx, projectID, instanceName).PageNum(pageNum).Execute()
+		instanceNotFound = httpResp != nil && httpResp.StatusCode == http.StatusNotFound
+		return resp, httpResp, err
+	})
+	if err != nil {
+		if instanceNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	instanceConnections := make(map[string]bool, len(connections))
+	for i := range connections {
+		instanceConnections[connections[i].GetName()] = true
+	}
+	return MissingConnections(referenced, instanceConnections), nil
+}
+
+func checkReferencedConnections(ctx context.Context, client admin.StreamsApi, projectID, instanceName string, pipeline []any, dlq *admin.StreamsDLQ) error {
+	missing, err := getMissingConnections(ctx, client, projectID, instanceName, ReferencedConnections(pipeline, dlq.GetConnectionName()))
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf(missingConnectionsDetail, strings.Join(missing, ", "), instanceName)
+	}
+	return nil
 }
@@ -69,6 +69,16 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 			},
+			"referenced_connections": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Names of the connections referenced by the `pipeline` stages and the `options.dlq`, in order of appearance. Can be used to model dependencies on `mongodbatlas_stream_connection` resources.",
+			},
+			"resume_from_checkpoint": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Used when the `pipeline` or `options` of an existing stream processor are updated. When `true`, the modified stream processor resumes from its last checkpoint. When `false`, it starts fresh, discarding the checkpoint." +
+					" Defaults to resuming from the last checkpoint if not set.",
+			},
 			"stats": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The stats associated with the stream processor. Refer to the [MongoDB Atlas Docs](https://www.mongodb.com/docs/atlas/atlas-stream-processing/manage-stream-processor/#view-statistics-of-a-stream-processor) for more information.",
@@ -77,15 +87,24 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 	}
 }
 
+// dataSourceBaseSchema is the resource schema without the attributes that are only used when applying changes.
+func dataSourceBaseSchema(ctx context.Context) schema.Schema {
+	s := ResourceSchema(ctx)
+	delete(s.Attributes, "resume_from_checkpoint")
+	return s
+}
+
 type TFStreamProcessorRSModel struct {
-	InstanceName  types.String       `tfsdk:"instance_name"`
-	Options       types.Object       `tfsdk:"options"`
-	Pipeline      fwtypes.JSONString `tfsdk:"pipeline"`
-	ProcessorID   types.String       `tfsdk:"id"`
-	ProcessorName types.String       `tfsdk:"processor_name"`
-	ProjectID     types.String       `tfsdk:"project_id"`
-	State         types.String       `tfsdk:"state"`
-	Stats         types.String       `tfsdk:"stats"`
+	InstanceName          types.String       `tfsdk:"instance_name"`
+	Options               types.Object       `tfsdk:"options"`
+	Pipeline              fwtypes.JSONString `tfsdk:"pipeline"`
+	ProcessorID           types.String       `tfsdk:"id"`
+	ProcessorName         types.String       `tfsdk:"processor_name"`
+	ProjectID             types.String       `tfsdk:"project_id"`
+	State                 types.String       `tfsdk:"state"`
+	Stats                 types.String       `tfsdk:"stats"`
+	ResumeFromCheckpoint  types.Bool         `tfsdk:"resume_from_checkpoint"`
+	ReferencedConnections types.List         `tfsdk:"referenced_connections"`
 }
 
 type TFOptionsModel struct {
@@ -110,14 +129,15 @@ var DlqObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
 }
 
 type TFStreamProcessorDSModel struct {
-	ID            types.String `tfsdk:"id"`
-	InstanceName  types.String `tfsdk:"instance_name"`
-	Options       types.Object `tfsdk:"options"`
-	Pipeline      types.String `tfsdk:"pipeline"`
-	ProcessorName types.String `tfsdk:"processor_name"`
-	ProjectID     types.String `tfsdk:"project_id"`
-	State         types.String `tfsdk:"state"`
-	Stats         types.String `tfsdk:"stats"`
+	ID                    types.String `tfsdk:"id"`
+	InstanceName          types.String `tfsdk:"instance_name"`
+	Options               types.Object `tfsdk:"options"`
+	Pipeline              types.String `tfsdk:"pipeline"`
+	ProcessorName         types.String `tfsdk:"processor_name"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	State                 types.String `tfsdk:"state"`
+	Stats                 types.String `tfsdk:"stats"`
+	ReferencedConnections types.List   `tfsdk:"referenced_connections"`
 }
 
 type TFStreamProcessorsDSModel struct {
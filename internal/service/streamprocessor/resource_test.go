@@ -28,6 +28,7 @@ var (
 	resourceName         = "mongodbatlas_stream_processor.processor"
 	dataSourceName       = "data.mongodbatlas_stream_processor.test"
 	pluralDataSourceName = "data.mongodbatlas_stream_processors.test"
+	statsDataSourceName  = "data.mongodbatlas_stream_processor_stats.test"
 	connTypeSample       = "Sample"
 	connTypeCluster      = "Cluster"
 	connTypeKafka        = "Kafka"
@@ -55,7 +56,12 @@ func basicTestCase(t *testing.T) *resource.TestCase {
 		Steps: []resource.TestStep{
 			{
 				Config: config(t, projectID, instanceName, processorName, "", sampleSrcConfig, testLogDestConfig),
-				Check:  composeStreamProcessorChecks(projectID, instanceName, processorName, streamprocessor.CreatedState, false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					composeStreamProcessorChecks(projectID, instanceName, processorName, streamprocessor.CreatedState, false, false),
+					resource.TestCheckResourceAttr(resourceName, "referenced_connections.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "referenced_connections.0", "sample_stream_solar"),
+					resource.TestCheckResourceAttr(resourceName, "referenced_connections.1", "__testLog"),
+				),
 			},
 			{
 				Config: config(t, projectID, instanceName, processorName, streamprocessor.StartedState, sampleSrcConfig, testLogDestConfig),
@@ -185,13 +191,34 @@ func TestAccStreamProcessor_createErrors(t *testing.T) {
 		}})
 }
 
+func TestAccStreamProcessor_invalidPipeline(t *testing.T) {
+	var (
+		projectID    = acc.ProjectIDExecution(t)
+		instanceName = acc.RandomName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyStreamProcessor,
+		Steps: []resource.TestStep{
+			{
+				Config:      configPipeline(projectID, instanceName, `[{"$source":{"connectionName":"sample_stream_solar"}},{"$match":{"a":1}}]`),
+				ExpectError: regexp.MustCompile(`last stage must be one of \$emit, \$merge, got \$match`),
+			},
+			{
+				Config:      configPipeline(projectID, instanceName, `[{"$match":{"a":1}},{"$emit":{"connectionName":"__testLog"}}]`),
+				ExpectError: regexp.MustCompile(`first stage must be \$source, got \$match`),
+			},
+		}})
+}
+
 func TestAccStreamProcessor_updateErrors(t *testing.T) {
 	var (
 		processorName          = "new-processor"
 		instanceName           = acc.RandomName()
 		projectID, clusterName = acc.ClusterNameExecution(t)
 		src                    = connectionConfig{connectionType: connTypeCluster, clusterName: clusterName, pipelineStepIsSource: true, useAsDLQ: false}
-		srcWithOptions         = connectionConfig{connectionType: connTypeCluster, clusterName: clusterName, pipelineStepIsSource: true, useAsDLQ: true}
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -207,9 +234,38 @@ func TestAccStreamProcessor_updateErrors(t *testing.T) {
 				Config:      config(t, projectID, instanceName, processorName, streamprocessor.StoppedState, src, testLogDestConfig),
 				ExpectError: regexp.MustCompile(`Stream Processor must be in \w+ state to transition to \w+ state`),
 			},
+		}})
+}
+
+func TestAccStreamProcessor_updatePipelineAndOptions(t *testing.T) {
+	var (
+		processorName          = "new-processor"
+		instanceName           = acc.RandomName()
+		projectID, clusterName = acc.ClusterNameExecution(t)
+		src                    = connectionConfig{connectionType: connTypeCluster, clusterName: clusterName, pipelineStepIsSource: true, useAsDLQ: false}
+		srcWithOptions         = connectionConfig{connectionType: connTypeCluster, clusterName: clusterName, pipelineStepIsSource: true, useAsDLQ: true}
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyStreamProcessor,
+		Steps: []resource.TestStep{
+			{
+				Config: config(t, projectID, instanceName, processorName, streamprocessor.StartedState, src, testLogDestConfig),
+				Check:  composeStreamProcessorChecks(projectID, instanceName, processorName, streamprocessor.StartedState, true, false),
+			},
+			{
+				Config: config(t, projectID, instanceName, processorName, streamprocessor.StartedState, srcWithOptions, testLogDestConfig),
+				Check:  composeStreamProcessorChecks(projectID, instanceName, processorName, streamprocessor.StartedState, true, true),
+			},
+			{
+				Config: config(t, projectID, instanceName, processorName, streamprocessor.StoppedState, srcWithOptions, testLogDestConfig),
+				Check:  composeStreamProcessorChecks(projectID, instanceName, processorName, streamprocessor.StoppedState, true, true),
+			},
 			{
-				Config:      config(t, projectID, instanceName, processorName, streamprocessor.StartedState, srcWithOptions, testLogDestConfig),
-				ExpectError: regexp.MustCompile("updating a Stream Processor is not supported"),
+				Config:      config(t, projectID, instanceName, processorName, streamprocessor.StoppedState, src, testLogDestConfig),
+				ExpectError: regexp.MustCompile("removing the options of a Stream Processor is not supported"),
 			},
 		}})
 }
@@ -279,10 +335,12 @@ func composeStreamProcessorChecks(projectID, instanceName, processorName, state
 		"results.0.state":          state,
 		"results.0.instance_name":  instanceName,
 	})
+	checks = acc.AddAttrChecks(statsDataSourceName, checks, attributes)
 	if includeStats {
 		checks = acc.AddAttrSetChecks(resourceName, checks, "stats", "pipeline")
 		checks = acc.AddAttrSetChecks(dataSourceName, checks, "stats", "pipeline")
 		checks = acc.AddAttrSetChecks(pluralDataSourceName, checks, "results.0.stats", "results.0.pipeline")
+		checks = acc.AddAttrSetChecks(statsDataSourceName, checks, "status", "input_message_count", "output_message_count", "dlq_message_count", "operator_stats.0.name", "operator_stats.0.input_message_count")
 	}
 	if includeOptions {
 		checks = acc.AddAttrSetChecks(resourceName, checks, "options.dlq.db", "options.dlq.coll", "options.dlq.connection_name")
@@ -336,6 +394,13 @@ func config(t *testing.T, projectID, instanceName, processorName, state string,
 		instance_name = %[2]q
 		depends_on = [%3s]
 	}`, projectID, instanceName, resourceName)
+	dataSourceStats := fmt.Sprintf(`
+	data "mongodbatlas_stream_processor_stats" "test" {
+		project_id = %[1]q
+		instance_name = %[2]q
+		processor_name = %[3]q
+		depends_on = [%4s]
+	}`, projectID, instanceName, processorName, resourceName)
 
 	return fmt.Sprintf(`
 		resource "mongodbatlas_stream_instance" "instance" {
@@ -361,8 +426,20 @@ func config(t *testing.T, projectID, instanceName, processorName, state string,
 		}
 		%[10]s
 		%[11]s
+		%[12]s
 		
-	`, projectID, instanceName, connectionConfigSrc, connectionConfigDest, processorName, pipeline, stateConfig, optionsStr, dependsOnStr, dataSource, dataSourcePlural)
+	`, projectID, instanceName, connectionConfigSrc, connectionConfigDest, processorName, pipeline, stateConfig, optionsStr, dependsOnStr, dataSource, dataSourcePlural, dataSourceStats)
+}
+
+func configPipeline(projectID, instanceName, pipeline string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_stream_processor" "processor" {
+			project_id     = %[1]q
+			instance_name  = %[2]q
+			processor_name = "invalid-processor"
+			pipeline       = %[3]q
+		}
+	`, projectID, instanceName, pipeline)
 }
 
 func configConnection(t *testing.T, projectID string, config connectionConfig) (connectionConfig, resourceID, pipelineStep string) {
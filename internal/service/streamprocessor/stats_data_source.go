@@ -0,0 +1,220 @@
+package streamprocessor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+var _ datasource.DataSource = &streamProcessorStatsDS{}
+var _ datasource.DataSourceWithConfigure = &streamProcessorStatsDS{}
+
+func StatsDataSource() datasource.DataSource {
+	return &streamProcessorStatsDS{
+		DSCommon: config.DSCommon{
+			DataSourceName: fmt.Sprintf("%s_stats", StreamProcessorName),
+		},
+	}
+}
+
+type streamProcessorStatsDS struct {
+	config.DSCommon
+}
+
+func (d *streamProcessorStatsDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = StatsDataSourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *streamProcessorStatsDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var statsConfig TFStreamProcessorStatsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &statsConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := d.Client.AtlasV2
+	projectID := statsConfig.ProjectID.ValueString()
+	instanceName := statsConfig.InstanceName.ValueString()
+	processorName := statsConfig.ProcessorName.ValueString()
+	apiResp, _, err := connV2.StreamsApi.GetStreamProcessor(ctx, projectID, instanceName, processorName).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+
+	newStatsModel, diags := NewTFStreamProcessorStats(ctx, projectID, instanceName, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStatsModel)...)
+}
+
+func StatsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Returns the runtime statistics of a stream processor as typed attributes. Statistics are only available while the stream processor is running, otherwise the metrics are null.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"instance_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the stream instance.",
+			},
+			"processor_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the stream processor.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state of the stream processor. Commonly occurring states are 'CREATED', 'STARTED', 'STOPPED' and 'FAILED'.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Runtime status reported in the stream processor statistics, e.g. `running`.",
+			},
+			"input_message_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of documents ingested by the stream processor.",
+			},
+			"input_message_size": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size, in bytes, of the documents ingested by the stream processor.",
+			},
+			"output_message_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of documents emitted by the stream processor.",
+			},
+			"output_message_size": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size, in bytes, of the documents emitted by the stream processor.",
+			},
+			"dlq_message_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of documents sent to the dead letter queue.",
+			},
+			"dlq_message_size": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size, in bytes, of the documents sent to the dead letter queue.",
+			},
+			"memory_tracker_bytes": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Memory, in bytes, used by the stream processor.",
+			},
+			"state_size": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size, in bytes, of the state stored by windows.",
+			},
+			"watermark_lag_secs": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Difference, in seconds, between the event time of the latest processed change stream event and the latest event of the change stream. Only available for change stream sources.",
+			},
+			"operator_stats": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Statistics of each operator of the stream processor pipeline, in pipeline order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the operator.",
+						},
+						"input_message_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of documents ingested by the operator.",
+						},
+						"input_message_size": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size, in bytes, of the documents ingested by the operator.",
+						},
+						"output_message_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of documents emitted by the operator.",
+						},
+						"output_message_size": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size, in bytes, of the documents emitted by the operator.",
+						},
+						"dlq_message_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of documents sent by the operator to the dead letter queue.",
+						},
+						"dlq_message_size": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size, in bytes, of the documents sent by the operator to the dead letter queue.",
+						},
+						"state_size": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size, in bytes, of the state stored by the operator.",
+						},
+						"max_memory_usage": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Maximum memory, in bytes, used by the operator.",
+						},
+						"time_spent_millis": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Time, in milliseconds, spent by the operator processing documents.",
+						},
+						"execution_time_secs": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Time, in seconds, the operator has been executing.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TFStreamProcessorStatsModel struct {
+	ProjectID          types.String  `tfsdk:"project_id"`
+	InstanceName       types.String  `tfsdk:"instance_name"`
+	ProcessorName      types.String  `tfsdk:"processor_name"`
+	State              types.String  `tfsdk:"state"`
+	Status             types.String  `tfsdk:"status"`
+	InputMessageCount  types.Int64   `tfsdk:"input_message_count"`
+	InputMessageSize   types.Float64 `tfsdk:"input_message_size"`
+	OutputMessageCount types.Int64   `tfsdk:"output_message_count"`
+	OutputMessageSize  types.Float64 `tfsdk:"output_message_size"`
+	DlqMessageCount    types.Int64   `tfsdk:"dlq_message_count"`
+	DlqMessageSize     types.Float64 `tfsdk:"dlq_message_size"`
+	MemoryTrackerBytes types.Float64 `tfsdk:"memory_tracker_bytes"`
+	StateSize          types.Float64 `tfsdk:"state_size"`
+	WatermarkLagSecs   types.Float64 `tfsdk:"watermark_lag_secs"`
+	OperatorStats      types.List    `tfsdk:"operator_stats"`
+}
+
+type TFOperatorStatsModel struct {
+	Name               types.String  `tfsdk:"name"`
+	InputMessageCount  types.Int64   `tfsdk:"input_message_count"`
+	InputMessageSize   types.Float64 `tfsdk:"input_message_size"`
+	OutputMessageCount types.Int64   `tfsdk:"output_message_count"`
+	OutputMessageSize  types.Float64 `tfsdk:"output_message_size"`
+	DlqMessageCount    types.Int64   `tfsdk:"dlq_message_count"`
+	DlqMessageSize     types.Float64 `tfsdk:"dlq_message_size"`
+	StateSize          types.Float64 `tfsdk:"state_size"`
+	MaxMemoryUsage     types.Float64 `tfsdk:"max_memory_usage"`
+	TimeSpentMillis    types.Int64   `tfsdk:"time_spent_millis"`
+	ExecutionTimeSecs  types.Int64   `tfsdk:"execution_time_secs"`
+}
+
+var OperatorStatsObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":                 types.StringType,
+	"input_message_count":  types.Int64Type,
+	"input_message_size":   types.Float64Type,
+	"output_message_count": types.Int64Type,
+	"output_message_size":  types.Float64Type,
+	"dlq_message_count":    types.Int64Type,
+	"dlq_message_size":     types.Float64Type,
+	"state_size":           types.Float64Type,
+	"max_memory_usage":     types.Float64Type,
+	"time_spent_millis":    types.Int64Type,
+	"execution_time_secs":  types.Int64Type,
+}}
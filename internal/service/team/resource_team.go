@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
@@ -28,6 +29,7 @@ const (
 	errorTeamUpdate   = "error updating Team information: %s"
 	errorTeamDelete   = "error deleting Team (%s): %s"
 	errorTeamSetting  = "error setting `%s` for Team (%s): %s"
+	errorTeamInvite   = "error inviting pending users to the Team: %s"
 )
 
 func Resource() *schema.Resource {
@@ -60,6 +62,11 @@ func Resource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"pending_invitation_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{PendingInvitationPolicyFail, PendingInvitationPolicySkip, PendingInvitationPolicyInvite}, false),
+			},
 		},
 	}
 }
@@ -74,7 +81,18 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	connV2 := meta.(*config.MongoDBClient).AtlasV2
 	orgID := d.Get("org_id").(string)
 
+	policy := d.Get("pending_invitation_policy").(string)
+
 	usernames := conversion.ExpandStringListFromSetSchema(d.Get("usernames").(*schema.Set))
+	var pendingUsernames []string
+	if IsPendingInvitationTolerated(policy) {
+		orgUsers, err := listAllOrgUsers(ctx, connV2, orgID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(errorTeamCreate, err))
+		}
+		usernames, pendingUsernames = SplitPendingUsernames(orgUsers, usernames)
+	}
+
 	teamsResp, _, err := connV2.TeamsApi.CreateTeam(ctx, orgID,
 		&admin.Team{
 			Name:      d.Get("name").(string),
@@ -84,6 +102,12 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(fmt.Errorf(errorTeamCreate, err))
 	}
 
+	if policy == PendingInvitationPolicyInvite {
+		if err := InvitePendingUsers(ctx, connV2.OrganizationsApi, orgID, teamsResp.GetId(), pendingUsernames); err != nil {
+			return diag.FromErr(fmt.Errorf(errorTeamInvite, err))
+		}
+	}
+
 	d.SetId(conversion.EncodeStateID(map[string]string{
 		"org_id": orgID,
 		"id":     teamsResp.GetId(),
@@ -128,6 +152,16 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		usernames = append(usernames, teamUsers[i].GetUsername())
 	}
 
+	// keep configured users that are not part of the organization yet, they are added to the team once they join it
+	if IsPendingInvitationTolerated(d.Get("pending_invitation_policy").(string)) {
+		orgUsers, err := listAllOrgUsers(ctx, connV2, orgID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(errorTeamRead, err))
+		}
+		_, pendingUsernames := SplitPendingUsernames(orgUsers, conversion.ExpandStringListFromSetSchema(d.Get("usernames").(*schema.Set)))
+		usernames = append(usernames, pendingUsernames...)
+	}
+
 	if err := d.Set("usernames", usernames); err != nil {
 		return diag.FromErr(fmt.Errorf(errorTeamSetting, "usernames", teamID, err))
 	}
@@ -151,7 +185,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
-	if d.HasChange("usernames") {
+	if d.HasChanges("usernames", "pending_invitation_policy") {
 		existingUsers, err := listAllTeamUsers(ctx, connV2, orgID, teamID)
 
 		if err != nil {
@@ -159,10 +193,26 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 		newUsernames := conversion.ExpandStringList(d.Get("usernames").(*schema.Set).List())
 
+		policy := d.Get("pending_invitation_policy").(string)
+		var pendingUsernames []string
+		if IsPendingInvitationTolerated(policy) {
+			orgUsers, err := listAllOrgUsers(ctx, connV2, orgID)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf(errorTeamRead, err))
+			}
+			newUsernames, pendingUsernames = SplitPendingUsernames(orgUsers, newUsernames)
+		}
+
 		err = UpdateTeamUsers(connV2.TeamsApi, connV2.MongoDBCloudUsersApi, existingUsers, newUsernames, orgID, teamID)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error when updating usernames in team: %s", err))
 		}
+
+		if policy == PendingInvitationPolicyInvite {
+			if err := InvitePendingUsers(ctx, connV2.OrganizationsApi, orgID, teamID, pendingUsernames); err != nil {
+				return diag.FromErr(fmt.Errorf(errorTeamInvite, err))
+			}
+		}
 	}
 
 	return resourceRead(ctx, d, meta)
@@ -260,3 +310,11 @@ func listAllTeamUsers(ctx context.Context, connV2 *admin.APIClient, orgID, teamI
 		return request.Execute()
 	})
 }
+
+func listAllOrgUsers(ctx context.Context, connV2 *admin.APIClient, orgID string) ([]admin.CloudAppUser, error) {
+	return dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.CloudAppUser], *http.Response, error) {
+		request := connV2.OrganizationsApi.ListOrganizationUsers(ctx, orgID)
+		request = request.PageNum(pageNum)
+		return request.Execute()
+	})
+}
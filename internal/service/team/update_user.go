@@ -2,10 +2,19 @@ package team
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"go.mongodb.org/atlas-sdk/v20241113004/admin"
 )
 
+const (
+	PendingInvitationPolicyFail   = "fail"
+	PendingInvitationPolicySkip   = "skip"
+	PendingInvitationPolicyInvite = "invite"
+	orgMemberRole                 = "ORG_MEMBER"
+)
+
 func UpdateTeamUsers(teamsAPI admin.TeamsApi, usersAPI admin.MongoDBCloudUsersApi, existingTeamUsers []admin.CloudAppUser, newUsernames []string, orgID, teamID string) error {
 	validNewUsers, err := ValidateUsernames(usersAPI, newUsernames)
 	if err != nil {
@@ -82,3 +91,60 @@ func InitUserSet(users []admin.CloudAppUser) map[string]bool {
 	}
 	return usersSet
 }
+
+// IsPendingInvitationTolerated returns true when the policy allows usernames that are not members of the organization yet.
+func IsPendingInvitationTolerated(policy string) bool {
+	return policy == PendingInvitationPolicySkip || policy == PendingInvitationPolicyInvite
+}
+
+// SplitPendingUsernames separates the usernames that are members of the organization from the ones that are not,
+// e.g. users who have not accepted their invitation yet. Usernames are compared case-insensitively.
+func SplitPendingUsernames(orgUsers []admin.CloudAppUser, usernames []string) (members, pending []string) {
+	orgUsernames := make(map[string]bool, len(orgUsers))
+	for i := range orgUsers {
+		orgUsernames[strings.ToLower(orgUsers[i].GetUsername())] = true
+	}
+	for _, username := range usernames {
+		if orgUsernames[strings.ToLower(username)] {
+			members = append(members, username)
+		} else {
+			pending = append(pending, username)
+		}
+	}
+	return members, pending
+}
+
+// InvitePendingUsers makes sure every pending username has an organization invitation that adds the user to the team once accepted.
+func InvitePendingUsers(ctx context.Context, orgsAPI admin.OrganizationsApi, orgID, teamID string, pendingUsernames []string) error {
+	for _, username := range pendingUsernames {
+		invitations, _, err := orgsAPI.ListOrganizationInvitations(ctx, orgID).Username(username).Execute()
+		if err != nil {
+			return err
+		}
+		if len(invitations) == 0 {
+			_, _, err = orgsAPI.CreateOrganizationInvitation(ctx, orgID, &admin.OrganizationInvitationRequest{
+				Username: admin.PtrString(username),
+				Roles:    &[]string{orgMemberRole},
+				TeamIds:  &[]string{teamID},
+			}).Execute()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		invitation := invitations[0]
+		teamIDs := invitation.GetTeamIds()
+		if slices.Contains(teamIDs, teamID) {
+			continue
+		}
+		teamIDs = append(teamIDs, teamID)
+		_, _, err = orgsAPI.UpdateOrganizationInvitationById(ctx, orgID, invitation.GetId(), &admin.OrganizationInvitationUpdateRequest{
+			Roles:   invitation.Roles,
+			TeamIds: &teamIDs,
+		}).Execute()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
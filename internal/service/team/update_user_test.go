@@ -1,6 +1,7 @@
 package team_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -159,3 +160,80 @@ func TestUpdateTeamUsers(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitPendingUsernames(t *testing.T) {
+	orgUsers := []admin.CloudAppUser{
+		{Username: "member1@example.com"},
+		{Username: "Member2@example.com"},
+	}
+	members, pending := team.SplitPendingUsernames(orgUsers, []string{"member1@example.com", "member2@example.com", "invited@example.com"})
+	assert.Equal(t, []string{"member1@example.com", "member2@example.com"}, members)
+	assert.Equal(t, []string{"invited@example.com"}, pending)
+}
+
+func TestInvitePendingUsers(t *testing.T) {
+	const (
+		orgID        = "orgID"
+		teamID       = "teamID"
+		otherTeamID  = "otherTeamID"
+		invitationID = "invitationID"
+		username     = "invited@example.com"
+	)
+
+	testCases := map[string]struct {
+		mockFuncExpectations func(*mockadmin.OrganizationsApi)
+		expectError          require.ErrorAssertionFunc
+	}{
+		"creates invitation when there is none": {
+			mockFuncExpectations: func(mockOrgsAPI *mockadmin.OrganizationsApi) {
+				mockOrgsAPI.EXPECT().ListOrganizationInvitations(mock.Anything, orgID).Return(admin.ListOrganizationInvitationsApiRequest{ApiService: mockOrgsAPI})
+				mockOrgsAPI.EXPECT().ListOrganizationInvitationsExecute(mock.Anything).Return([]admin.OrganizationInvitation{}, nil, nil)
+				mockOrgsAPI.EXPECT().CreateOrganizationInvitation(mock.Anything, orgID, &admin.OrganizationInvitationRequest{
+					Username: admin.PtrString(username),
+					Roles:    &[]string{"ORG_MEMBER"},
+					TeamIds:  &[]string{teamID},
+				}).Return(admin.CreateOrganizationInvitationApiRequest{ApiService: mockOrgsAPI})
+				mockOrgsAPI.EXPECT().CreateOrganizationInvitationExecute(mock.Anything).Return(nil, nil, nil)
+			},
+			expectError: require.NoError,
+		},
+		"adds team to existing invitation": {
+			mockFuncExpectations: func(mockOrgsAPI *mockadmin.OrganizationsApi) {
+				mockOrgsAPI.EXPECT().ListOrganizationInvitations(mock.Anything, orgID).Return(admin.ListOrganizationInvitationsApiRequest{ApiService: mockOrgsAPI})
+				mockOrgsAPI.EXPECT().ListOrganizationInvitationsExecute(mock.Anything).Return([]admin.OrganizationInvitation{
+					{Id: admin.PtrString(invitationID), Roles: &[]string{"ORG_READ_ONLY"}, TeamIds: &[]string{otherTeamID}},
+				}, nil, nil)
+				mockOrgsAPI.EXPECT().UpdateOrganizationInvitationById(mock.Anything, orgID, invitationID, &admin.OrganizationInvitationUpdateRequest{
+					Roles:   &[]string{"ORG_READ_ONLY"},
+					TeamIds: &[]string{otherTeamID, teamID},
+				}).Return(admin.UpdateOrganizationInvitationByIdApiRequest{ApiService: mockOrgsAPI})
+				mockOrgsAPI.EXPECT().UpdateOrganizationInvitationByIdExecute(mock.Anything).Return(nil, nil, nil)
+			},
+			expectError: require.NoError,
+		},
+		"does nothing when invitation already includes the team": {
+			mockFuncExpectations: func(mockOrgsAPI *mockadmin.OrganizationsApi) {
+				mockOrgsAPI.EXPECT().ListOrganizationInvitations(mock.Anything, orgID).Return(admin.ListOrganizationInvitationsApiRequest{ApiService: mockOrgsAPI})
+				mockOrgsAPI.EXPECT().ListOrganizationInvitationsExecute(mock.Anything).Return([]admin.OrganizationInvitation{
+					{Id: admin.PtrString(invitationID), TeamIds: &[]string{teamID}},
+				}, nil, nil)
+			},
+			expectError: require.NoError,
+		},
+		"fails when invitations cannot be listed": {
+			mockFuncExpectations: func(mockOrgsAPI *mockadmin.OrganizationsApi) {
+				mockOrgsAPI.EXPECT().ListOrganizationInvitations(mock.Anything, orgID).Return(admin.ListOrganizationInvitationsApiRequest{ApiService: mockOrgsAPI})
+				mockOrgsAPI.EXPECT().ListOrganizationInvitationsExecute(mock.Anything).Return(nil, nil, errors.New("error"))
+			},
+			expectError: require.Error,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockOrgsAPI := mockadmin.NewOrganizationsApi(t)
+			testCase.mockFuncExpectations(mockOrgsAPI)
+			testCase.expectError(t, team.InvitePendingUsers(context.Background(), mockOrgsAPI, orgID, teamID, []string{username}))
+		})
+	}
+}
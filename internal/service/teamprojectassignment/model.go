@@ -0,0 +1,30 @@
+package teamprojectassignment
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+func NewTFModel(ctx context.Context, projectID string, apiResp *admin.TeamRole) (*TFModel, diag.Diagnostics) {
+	roleNames, diags := types.SetValueFrom(ctx, types.StringType, apiResp.GetRoleNames())
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &TFModel{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(apiResp.GetTeamId()),
+		RoleNames: roleNames,
+	}, nil
+}
+
+func NewAtlasReq(ctx context.Context, tfModel *TFModel) *admin.TeamRole {
+	roleNames := conversion.TypesSetToString(ctx, tfModel.RoleNames)
+	return &admin.TeamRole{
+		TeamId:    tfModel.TeamID.ValueStringPointer(),
+		RoleNames: &roleNames,
+	}
+}
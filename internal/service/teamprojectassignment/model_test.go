@@ -0,0 +1,79 @@
+package teamprojectassignment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/teamprojectassignment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+const (
+	projectID = "664619d870c247237f4b86a6"
+	teamID    = "664619d870c247237f4b86a7"
+)
+
+func TestNewTFModel(t *testing.T) {
+	roleNames, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"GROUP_OWNER", "GROUP_READ_ONLY"})
+	tfModel, diags := teamprojectassignment.NewTFModel(context.Background(), projectID, &admin.TeamRole{
+		TeamId:    admin.PtrString(teamID),
+		RoleNames: &[]string{"GROUP_OWNER", "GROUP_READ_ONLY"},
+	})
+	require.False(t, diags.HasError())
+	assert.Equal(t, &teamprojectassignment.TFModel{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(teamID),
+		RoleNames: roleNames,
+	}, tfModel)
+}
+
+func TestNewAtlasReq(t *testing.T) {
+	roleNames, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"GROUP_OWNER"})
+	atlasReq := teamprojectassignment.NewAtlasReq(context.Background(), &teamprojectassignment.TFModel{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(teamID),
+		RoleNames: roleNames,
+	})
+	assert.Equal(t, &admin.TeamRole{
+		TeamId:    admin.PtrString(teamID),
+		RoleNames: &[]string{"GROUP_OWNER"},
+	}, atlasReq)
+}
+
+func TestSplitImportID(t *testing.T) {
+	testCases := map[string]struct {
+		importID    string
+		projectID   string
+		teamID      string
+		expectError bool
+	}{
+		"valid": {
+			importID:  projectID + "-" + teamID,
+			projectID: projectID,
+			teamID:    teamID,
+		},
+		"missing team": {
+			importID:    projectID,
+			expectError: true,
+		},
+		"invalid project": {
+			importID:    "project-" + teamID,
+			expectError: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotProjectID, gotTeamID, err := teamprojectassignment.SplitImportID(tc.importID)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.projectID, gotProjectID)
+			assert.Equal(t, tc.teamID, gotTeamID)
+		})
+	}
+}
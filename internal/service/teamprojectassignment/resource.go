@@ -0,0 +1,181 @@
+package teamprojectassignment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+const (
+	resourceName     = "team_project_assignment"
+	fullResourceName = "mongodbatlas_" + resourceName
+	errorCreate      = "error creating resource " + fullResourceName
+	errorRead        = "error reading resource " + fullResourceName
+	errorUpdate      = "error updating resource " + fullResourceName
+	errorDelete      = "error deleting resource " + fullResourceName
+	errorImport      = "import format error: to import a team project assignment, use the format {project_id}-{team_id}"
+)
+
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
+		},
+	}
+}
+
+type rs struct {
+	config.RSCommon
+}
+
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	teamID := plan.TeamID.ValueString()
+	if _, _, err := connV2.TeamsApi.AddAllTeamsToProject(ctx, projectID, &[]admin.TeamRole{*NewAtlasReq(ctx, &plan)}).Execute(); err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+	teamRole, err := getProjectTeam(ctx, connV2, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+	if teamRole == nil {
+		resp.Diagnostics.AddError(errorCreate, fmt.Sprintf("team %s not found in project %s after assignment", teamID, projectID))
+		return
+	}
+	newModel, diags := NewTFModel(ctx, projectID, teamRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+	teamRole, err := getProjectTeam(ctx, r.Client.AtlasV2, projectID, state.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errorRead, err.Error())
+		return
+	}
+	if teamRole == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newModel, diags := NewTFModel(ctx, projectID, teamRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	teamID := plan.TeamID.ValueString()
+	connV2 := r.Client.AtlasV2
+	atlasReq := NewAtlasReq(ctx, &plan)
+	atlasReq.TeamId = nil
+	if _, _, err := connV2.TeamsApi.UpdateTeamRoles(ctx, projectID, teamID, atlasReq).Execute(); err != nil {
+		resp.Diagnostics.AddError(errorUpdate, err.Error())
+		return
+	}
+	teamRole, err := getProjectTeam(ctx, connV2, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorUpdate, err.Error())
+		return
+	}
+	if teamRole == nil {
+		resp.Diagnostics.AddError(errorUpdate, fmt.Sprintf("team %s not found in project %s after update", teamID, projectID))
+		return
+	}
+	newModel, diags := NewTFModel(ctx, projectID, teamRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	httpResp, err := r.Client.AtlasV2.TeamsApi.RemoveProjectTeam(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString()).Execute()
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		resp.Diagnostics.AddError(errorDelete, err.Error())
+	}
+}
+
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, teamID, err := SplitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error splitting import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+}
+
+func SplitImportID(id string) (projectID, teamID string, err error) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("%s, got: %s", errorImport, id)
+	}
+	if err := conversion.ValidateProjectID(parts[0]); err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+func getProjectTeam(ctx context.Context, connV2 *admin.APIClient, projectID, teamID string) (*admin.TeamRole, error) {
+	teams, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.TeamRole], *http.Response, error) {
+		request := connV2.TeamsApi.ListProjectTeams(ctx, projectID)
+		request = request.PageNum(pageNum)
+		return request.Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range teams {
+		if teams[i].GetTeamId() == teamID {
+			return &teams[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,122 @@
+package teamprojectassignment_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const resourceName = "mongodbatlas_team_project_assignment.test"
+
+func TestAccTeamProjectAssignment_basic(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+		orgID     = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		usernames = []string{os.Getenv("MONGODB_ATLAS_USERNAME")}
+		teamName  = acc.RandomName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckAtlasUsername(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(orgID, projectID, teamName, usernames, []string{"GROUP_READ_ONLY"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttrSet(resourceName, "team_id"),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "role_names.*", "GROUP_READ_ONLY"),
+				),
+			},
+			{
+				Config: configBasic(orgID, projectID, teamName, usernames, []string{"GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "role_names.*", "GROUP_DATA_ACCESS_READ_ONLY"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    importStateIDFunc(resourceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+			},
+		},
+	})
+}
+
+func configBasic(orgID, projectID, teamName string, usernames, roleNames []string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_team" "test" {
+			org_id    = %[1]q
+			name      = %[3]q
+			usernames = %[4]s
+		}
+
+		resource "mongodbatlas_team_project_assignment" "test" {
+			project_id = %[2]q
+			team_id    = mongodbatlas_team.test.team_id
+			role_names = %[5]s
+		}
+	`, orgID, projectID, teamName,
+		strings.ReplaceAll(fmt.Sprintf("%+q", usernames), " ", ","),
+		strings.ReplaceAll(fmt.Sprintf("%+q", roleNames), " ", ","),
+	)
+}
+
+func checkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if !exists(rs) {
+			return fmt.Errorf("team project assignment (%s) does not exist", rs.Primary.Attributes["team_id"])
+		}
+		return nil
+	}
+}
+
+func checkDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type == "mongodbatlas_team_project_assignment" && exists(rs) {
+			return fmt.Errorf("team project assignment (%s) still exists", rs.Primary.Attributes["team_id"])
+		}
+	}
+	return nil
+}
+
+func exists(rs *terraform.ResourceState) bool {
+	projectID := rs.Primary.Attributes["project_id"]
+	teamID := rs.Primary.Attributes["team_id"]
+	teams, _, err := acc.ConnV2().TeamsApi.ListProjectTeams(context.Background(), projectID).Execute()
+	if err != nil {
+		return false
+	}
+	for _, team := range teams.GetResults() {
+		if team.GetTeamId() == teamID {
+			return true
+		}
+	}
+	return false
+}
+
+func importStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["team_id"]), nil
+	}
+}
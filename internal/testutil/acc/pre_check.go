@@ -295,6 +295,14 @@ func PreCheckDataLakePipelineRun(tb testing.TB) {
 	PreCheckDataLakePipelineRuns(tb)
 }
 
+func PreCheckDataLakePipelineRunTrigger(tb testing.TB) {
+	tb.Helper()
+	if os.Getenv("MONGODB_ATLAS_DATA_LAKE_PIPELINE_SNAPSHOT_ID") == "" {
+		tb.Fatal("`MONGODB_ATLAS_DATA_LAKE_PIPELINE_SNAPSHOT_ID` must be set for Data Lake Pipeline run trigger acceptance testing")
+	}
+	PreCheckDataLakePipelineRuns(tb)
+}
+
 func PreCheckDataLakePipelineRuns(tb testing.TB) {
 	tb.Helper()
 	if os.Getenv("MONGODB_ATLAS_DATA_LAKE_PIPELINE_NAME") == "" {
@@ -355,6 +363,14 @@ func PreCheckS3Bucket(tb testing.TB) {
 	}
 }
 
+func PreCheckGCSBucket(tb testing.TB) {
+	tb.Helper()
+	if os.Getenv("GCP_GCS_BUCKET") == "" {
+		tb.Fatal("`GCP_GCS_BUCKET` must be set for GCS acceptance testing")
+	}
+	PreCheckBasic(tb)
+}
+
 func PreCheckAzureExportBucket(tb testing.TB) {
 	tb.Helper()
 	if os.Getenv("AZURE_SERVICE_URL") == "" ||
@@ -0,0 +1,108 @@
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// accessListKey returns the value identifying the entry in the path, IP addresses are stored as /32 CIDR blocks as Atlas does.
+func accessListKey(doc document) string {
+	if ip, _ := doc["ipAddress"].(string); ip != "" {
+		if _, ok := doc["cidrBlock"]; !ok && !strings.Contains(ip, ":") {
+			doc["cidrBlock"] = ip + "/32"
+		}
+		return ip
+	}
+	if cidr, _ := doc["cidrBlock"].(string); cidr != "" {
+		return cidr
+	}
+	group, _ := doc["awsSecurityGroup"].(string)
+	return group
+}
+
+// findAccessListEntry writes the not found error if the project or the entry of the path params don't exist, IP addresses also match their /32 CIDR block.
+func (s *Server) findAccessListEntry(w http.ResponseWriter, r *http.Request) (*project, string, bool) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return nil, "", false
+	}
+	value := r.PathValue("entryValue")
+	for _, key := range []string{value, strings.TrimSuffix(value, "/32")} {
+		if _, ok := p.accessList[key]; ok {
+			return p, key, true
+		}
+	}
+	for key, doc := range p.accessList {
+		if doc["cidrBlock"] == value {
+			return p, key, true
+		}
+	}
+	writeError(w, r, http.StatusNotFound, "ATLAS_NETWORK_PERMISSION_ENTRY_NOT_FOUND", fmt.Sprintf("IP Address %s not on Atlas access list for group %s.", value, p.doc["id"]))
+	return nil, "", false
+}
+
+func (s *Server) createAccessListEntries(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	var req []document
+	if !readBody(w, r, &req) {
+		return
+	}
+	for _, entry := range req {
+		doc := clone(entry)
+		key := accessListKey(doc)
+		if key == "" {
+			writeError(w, r, http.StatusBadRequest, "INVALID_ATTRIBUTE", "One of cidrBlock, ipAddress or awsSecurityGroup must be specified.")
+			return
+		}
+		doc["groupId"] = p.doc["id"]
+		p.accessList[key] = doc
+	}
+	writeAccessList(w, r, p, http.StatusCreated)
+}
+
+func (s *Server) listAccessListEntries(w http.ResponseWriter, r *http.Request) {
+	if p, ok := s.findProject(w, r); ok {
+		writeAccessList(w, r, p, http.StatusOK)
+	}
+}
+
+func (s *Server) getAccessListEntry(w http.ResponseWriter, r *http.Request) {
+	if p, key, ok := s.findAccessListEntry(w, r); ok {
+		writeJSON(w, r, http.StatusOK, p.accessList[key])
+	}
+}
+
+func (s *Server) getAccessListEntryStatus(w http.ResponseWriter, r *http.Request) {
+	if _, _, ok := s.findAccessListEntry(w, r); ok {
+		writeJSON(w, r, http.StatusOK, document{"STATUS": "ACTIVE"})
+	}
+}
+
+func (s *Server) deleteAccessListEntry(w http.ResponseWriter, r *http.Request) {
+	if p, key, ok := s.findAccessListEntry(w, r); ok {
+		delete(p.accessList, key)
+		writeJSON(w, r, http.StatusNoContent, nil)
+	}
+}
+
+// writeAccessList writes all the entries of the project, the create endpoint also returns the full list.
+func writeAccessList(w http.ResponseWriter, r *http.Request, p *project, status int) {
+	keys := sortedKeys(p.accessList)
+	results := make([]document, len(keys))
+	for i, key := range keys {
+		results[i] = p.accessList[key]
+	}
+	if status == http.StatusOK {
+		writePage(w, r, results)
+		return
+	}
+	writeJSON(w, r, status, document{
+		"links":      []any{},
+		"results":    results,
+		"totalCount": len(results),
+	})
+}
@@ -0,0 +1,289 @@
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	clusterStateField = "stateName"
+	tenantProvider    = "TENANT"
+	// multipleShardsVersion is the first API version returning a replication spec per shard.
+	multipleShardsVersion = "2024-08-05"
+	defaultZoneName       = "ZoneName managed by Terraform"
+)
+
+var defaultProcessArgs = document{
+	"changeStreamOptionsPreAndPostImagesExpireAfterSeconds": nil,
+	"chunkMigrationConcurrency":                             nil,
+	"customOpensslCipherConfigTls12":                        []any{},
+	"defaultMaxTimeMS":                                      nil,
+	"defaultWriteConcern":                                   nil,
+	"javascriptEnabled":                                     true,
+	"minimumEnabledTlsProtocol":                             "TLS1_2",
+	"noTableScan":                                           false,
+	"oplogMinRetentionHours":                                nil,
+	"oplogSizeMB":                                           nil,
+	"queryStatsLogVerbosity":                                1,
+	"sampleRefreshIntervalBIConnector":                      nil,
+	"sampleSizeBIConnector":                                 nil,
+	"tlsCipherConfigMode":                                   "DEFAULT",
+	"transactionLifetimeLimitSeconds":                       nil,
+}
+
+// cluster keeps the process args with the cluster so they are removed when the cluster is deleted.
+type cluster struct {
+	entity
+	processArgs document
+}
+
+// findCluster writes the not found error if the project or the cluster of the path params don't exist, a read of the cluster is counted for its transition.
+func (s *Server) findCluster(w http.ResponseWriter, r *http.Request) (*project, *cluster, bool) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	name := r.PathValue("clusterName")
+	c, ok := p.clusters[name]
+	if ok && !c.read() {
+		delete(p.clusters, name)
+		ok = false
+	}
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "CLUSTER_NOT_FOUND", fmt.Sprintf("No cluster named %s exists in group %s.", name, p.doc["id"]))
+	}
+	return p, c, ok
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	name, _ := req["name"].(string)
+	if name == "" {
+		writeError(w, r, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute name was not specified.")
+		return
+	}
+	if _, exists := p.clusters[name]; exists {
+		writeError(w, r, http.StatusBadRequest, "DUPLICATE_CLUSTER_NAME", fmt.Sprintf("A cluster named %s is already present in group %s.", name, p.doc["id"]))
+		return
+	}
+	doc := clone(req)
+	setDefaults(doc, document{
+		"backupEnabled":                    false,
+		"biConnector":                      document{"enabled": false, "readPreference": "secondary"},
+		"clusterType":                      "REPLICASET",
+		"diskWarmingMode":                  "FULLY_WARMED",
+		"encryptionAtRestProvider":         "NONE",
+		"featureCompatibilityVersion":      "8.0",
+		"globalClusterSelfManagedSharding": false,
+		"labels":                           []any{},
+		"mongoDBMajorVersion":              "8.0",
+		"paused":                           false,
+		"pitEnabled":                       false,
+		"redactClientLogData":              false,
+		"rootCertType":                     "ISRGROOTX1",
+		"tags":                             []any{},
+		"terminationProtectionEnabled":     false,
+		"versionReleaseSystem":             "LTS",
+	})
+	doc["id"] = s.newID()
+	doc["groupId"] = p.doc["id"]
+	doc["createDate"] = time.Now().UTC().Format(time.RFC3339)
+	doc["mongoDBVersion"] = fmt.Sprintf("%s.0", doc["mongoDBMajorVersion"])
+	doc["connectionStrings"] = document{
+		"standard":    fmt.Sprintf("mongodb://%s-shard-00-00.fake.mongodb.net:27017/?ssl=true&authSource=admin", name),
+		"standardSrv": fmt.Sprintf("mongodb+srv://%s.fake.mongodb.net", name),
+	}
+	s.setReplicationSpecDefaults(doc, nil)
+	s.addClusterContainers(p, doc)
+	c := &cluster{entity: entity{doc: doc}, processArgs: clone(defaultProcessArgs)}
+	s.startTransition(&c.entity, clusterStateField, "CREATING", "IDLE")
+	p.clusters[name] = c
+	writeJSON(w, r, http.StatusCreated, clusterResponse(r, c))
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	results := make([]document, 0, len(p.clusters))
+	for _, name := range sortedKeys(p.clusters) {
+		c := p.clusters[name]
+		if !c.read() {
+			delete(p.clusters, name)
+			continue
+		}
+		results = append(results, clusterResponse(r, c))
+	}
+	writePage(w, r, results)
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, r *http.Request) {
+	if _, c, ok := s.findCluster(w, r); ok {
+		writeJSON(w, r, http.StatusOK, clusterResponse(r, c))
+	}
+}
+
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request) {
+	p, c, ok := s.findCluster(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	previousSpecs, _ := c.doc["replicationSpecs"].([]any)
+	for _, key := range []string{"id", "groupId", "name", "createDate", "mongoDBVersion", "connectionStrings", clusterStateField} {
+		delete(req, key)
+	}
+	merge(c.doc, req)
+	s.setReplicationSpecDefaults(c.doc, previousSpecs)
+	s.addClusterContainers(p, c.doc)
+	s.startTransition(&c.entity, clusterStateField, "UPDATING", "IDLE")
+	writeJSON(w, r, http.StatusOK, clusterResponse(r, c))
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	_, c, ok := s.findCluster(w, r)
+	if !ok {
+		return
+	}
+	if c.doc["terminationProtectionEnabled"] == true {
+		writeError(w, r, http.StatusBadRequest, "CANNOT_TERMINATE_CLUSTER_WHEN_TERMINATION_PROTECTION_ENABLED", "Cannot terminate a cluster when termination protection is enabled.")
+		return
+	}
+	if !c.deleting() {
+		s.startTransition(&c.entity, clusterStateField, "DELETING", "")
+	}
+	writeJSON(w, r, http.StatusAccepted, nil)
+}
+
+func (s *Server) getProcessArgs(w http.ResponseWriter, r *http.Request) {
+	if _, c, ok := s.findCluster(w, r); ok {
+		writeJSON(w, r, http.StatusOK, c.processArgs)
+	}
+}
+
+func (s *Server) updateProcessArgs(w http.ResponseWriter, r *http.Request) {
+	_, c, ok := s.findCluster(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	merge(c.processArgs, req)
+	writeJSON(w, r, http.StatusOK, c.processArgs)
+}
+
+// setReplicationSpecDefaults sets the ids and the hardware specs computed by Atlas, ids of the previous specs are kept by position.
+func (s *Server) setReplicationSpecDefaults(doc document, previousSpecs []any) {
+	specs, _ := doc["replicationSpecs"].([]any)
+	zoneIDs := make(map[any]any)
+	for _, previous := range previousSpecs {
+		if spec, ok := previous.(map[string]any); ok {
+			zoneIDs[spec["zoneName"]] = spec["zoneId"]
+		}
+	}
+	for i, specAny := range specs {
+		spec, ok := specAny.(map[string]any)
+		if !ok {
+			continue
+		}
+		if _, ok := spec["zoneName"]; !ok {
+			spec["zoneName"] = defaultZoneName
+		}
+		if i < len(previousSpecs) {
+			if previous, ok := previousSpecs[i].(map[string]any); ok {
+				spec["id"] = previous["id"]
+			}
+		}
+		if spec["id"] == nil {
+			spec["id"] = s.newID()
+		}
+		if zoneIDs[spec["zoneName"]] == nil {
+			zoneIDs[spec["zoneName"]] = s.newID()
+		}
+		spec["zoneId"] = zoneIDs[spec["zoneName"]]
+		regionConfigs, _ := spec["regionConfigs"].([]any)
+		for _, regionConfigAny := range regionConfigs {
+			if regionConfig, ok := regionConfigAny.(map[string]any); ok {
+				setHardwareSpecDefaults(regionConfig)
+			}
+		}
+	}
+}
+
+// setHardwareSpecDefaults sets the read-only and analytics specs with 0 nodes like Atlas does for dedicated clusters.
+func setHardwareSpecDefaults(regionConfig map[string]any) {
+	electable, ok := regionConfig["electableSpecs"].(map[string]any)
+	if !ok || regionConfig["providerName"] == tenantProvider {
+		return
+	}
+	if regionConfig["providerName"] == "AWS" {
+		setDefaults(electable, document{"diskIOPS": 3000, "ebsVolumeType": "STANDARD"})
+	}
+	for _, name := range []string{"readOnlySpecs", "analyticsSpecs"} {
+		if _, ok := regionConfig[name]; ok {
+			continue
+		}
+		specs := clone(electable)
+		specs["nodeCount"] = 0
+		regionConfig[name] = specs
+	}
+}
+
+// clusterResponse returns the cluster in the format of the requested API version, versions before 2024-08-05 group the shards of a zone in a replication spec.
+func clusterResponse(r *http.Request, c *cluster) document {
+	resp := clone(c.doc)
+	if apiVersion(r) >= multipleShardsVersion {
+		return resp
+	}
+	specs, _ := resp["replicationSpecs"].([]any)
+	var zones []any
+	zoneIndex := make(map[any]int)
+	for _, specAny := range specs {
+		spec, ok := specAny.(map[string]any)
+		if !ok {
+			continue
+		}
+		removeDiskSize(spec, resp)
+		if i, ok := zoneIndex[spec["zoneName"]]; ok {
+			zone := zones[i].(map[string]any)
+			zone["numShards"] = zone["numShards"].(int) + 1
+			continue
+		}
+		spec["numShards"] = 1
+		zoneIndex[spec["zoneName"]] = len(zones)
+		zones = append(zones, spec)
+	}
+	resp["replicationSpecs"] = zones
+	return resp
+}
+
+// removeDiskSize moves the disk size of the hardware specs to the cluster level as in the legacy API versions.
+func removeDiskSize(spec map[string]any, doc document) {
+	regionConfigs, _ := spec["regionConfigs"].([]any)
+	for _, regionConfigAny := range regionConfigs {
+		regionConfig, ok := regionConfigAny.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, name := range []string{"electableSpecs", "readOnlySpecs", "analyticsSpecs"} {
+			if specs, ok := regionConfig[name].(map[string]any); ok && specs["diskSizeGB"] != nil {
+				doc["diskSizeGB"] = specs["diskSizeGB"]
+				delete(specs, "diskSizeGB")
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func databaseUserKey(databaseName, username string) string {
+	return databaseName + "/" + username
+}
+
+// findDatabaseUser writes the not found error if the project or the user of the path params don't exist.
+func (s *Server) findDatabaseUser(w http.ResponseWriter, r *http.Request) (*project, string, bool) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return nil, "", false
+	}
+	username := r.PathValue("username")
+	key := databaseUserKey(r.PathValue("databaseName"), username)
+	if _, ok := p.databaseUsers[key]; !ok {
+		writeError(w, r, http.StatusNotFound, "USERNAME_NOT_FOUND", fmt.Sprintf("No user with username %s exists.", username))
+		return nil, "", false
+	}
+	return p, key, true
+}
+
+func (s *Server) createDatabaseUser(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	username, _ := req["username"].(string)
+	databaseName, _ := req["databaseName"].(string)
+	if username == "" || databaseName == "" {
+		writeError(w, r, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attributes username and databaseName were not specified.")
+		return
+	}
+	key := databaseUserKey(databaseName, username)
+	if _, exists := p.databaseUsers[key]; exists {
+		writeError(w, r, http.StatusConflict, "USER_ALREADY_EXISTS", fmt.Sprintf("The specified database user %s already exists.", username))
+		return
+	}
+	doc := clone(req)
+	setDefaults(doc, document{
+		"awsIAMType":   "NONE",
+		"labels":       []any{},
+		"ldapAuthType": "NONE",
+		"oidcAuthType": "NONE",
+		"roles":        []any{},
+		"scopes":       []any{},
+		"x509Type":     "NONE",
+	})
+	doc["groupId"] = p.doc["id"]
+	p.databaseUsers[key] = doc
+	writeJSON(w, r, http.StatusCreated, databaseUserResponse(doc))
+}
+
+func (s *Server) listDatabaseUsers(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	keys := sortedKeys(p.databaseUsers)
+	results := make([]document, len(keys))
+	for i, key := range keys {
+		results[i] = databaseUserResponse(p.databaseUsers[key])
+	}
+	writePage(w, r, results)
+}
+
+func (s *Server) getDatabaseUser(w http.ResponseWriter, r *http.Request) {
+	if p, key, ok := s.findDatabaseUser(w, r); ok {
+		writeJSON(w, r, http.StatusOK, databaseUserResponse(p.databaseUsers[key]))
+	}
+}
+
+func (s *Server) updateDatabaseUser(w http.ResponseWriter, r *http.Request) {
+	p, key, ok := s.findDatabaseUser(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	for _, attr := range []string{"username", "databaseName", "groupId"} {
+		delete(req, attr)
+	}
+	doc := p.databaseUsers[key]
+	merge(doc, req)
+	writeJSON(w, r, http.StatusOK, databaseUserResponse(doc))
+}
+
+func (s *Server) deleteDatabaseUser(w http.ResponseWriter, r *http.Request) {
+	if p, key, ok := s.findDatabaseUser(w, r); ok {
+		delete(p.databaseUsers, key)
+		writeJSON(w, r, http.StatusNoContent, nil)
+	}
+}
+
+// databaseUserResponse removes the password as Atlas never returns it.
+func databaseUserResponse(doc document) document {
+	resp := clone(doc)
+	delete(resp, "password")
+	return resp
+}
@@ -0,0 +1,66 @@
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const defaultAtlasCIDRBlock = "192.168.248.0/21"
+
+// addClusterContainers creates the network containers Atlas provisions for the regions of a dedicated cluster,
+// there is one container per region in AWS and Azure and a single one per project in GCP.
+func (s *Server) addClusterContainers(p *project, doc document) {
+	specs, _ := doc["replicationSpecs"].([]any)
+	for _, specAny := range specs {
+		spec, _ := specAny.(map[string]any)
+		regionConfigs, _ := spec["regionConfigs"].([]any)
+		for _, regionConfigAny := range regionConfigs {
+			regionConfig, _ := regionConfigAny.(map[string]any)
+			providerName, _ := regionConfig["providerName"].(string)
+			regionName, _ := regionConfig["regionName"].(string)
+			if providerName == "" || providerName == tenantProvider {
+				continue
+			}
+			key := providerName + ":" + regionName
+			if providerName == "GCP" {
+				key = providerName
+			}
+			if _, exists := p.containers[key]; exists {
+				continue
+			}
+			container := document{
+				"id":             s.newID(),
+				"providerName":   providerName,
+				"atlasCidrBlock": defaultAtlasCIDRBlock,
+				"provisioned":    true,
+			}
+			switch providerName {
+			case "AWS":
+				container["regionName"] = regionName
+				container["vpcId"] = fmt.Sprintf("vpc-%s", container["id"])
+			case "AZURE":
+				container["region"] = regionName
+			}
+			p.containers[key] = container
+		}
+	}
+}
+
+func (s *Server) listContainers(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	providerName := r.URL.Query().Get("providerName")
+	if providerName == "" {
+		writeError(w, r, http.StatusBadRequest, "MISSING_QUERY_PARAMETER", "The required query parameter providerName was not specified.")
+		return
+	}
+	results := []document{}
+	for _, key := range sortedKeys(p.containers) {
+		if container := p.containers[key]; container["providerName"] == providerName {
+			results = append(results, container)
+		}
+	}
+	writePage(w, r, results)
+}
@@ -0,0 +1,239 @@
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+type project struct {
+	doc           document
+	settings      document
+	clusters      map[string]*cluster
+	databaseUsers map[string]document
+	accessList    map[string]document
+	searchIndexes map[string]*searchIndex
+	containers    map[string]document
+	teams         []any
+	slowMsEnabled bool
+}
+
+var defaultProjectSettings = document{
+	"isCollectDatabaseSpecificsStatisticsEnabled": true,
+	"isDataExplorerEnabled":                       true,
+	"isExtendedStorageSizesEnabled":               false,
+	"isPerformanceAdvisorEnabled":                 true,
+	"isRealtimePerformancePanelEnabled":           true,
+	"isSchemaAdvisorEnabled":                      true,
+}
+
+// findProject writes the not found error if the project of the groupId path param doesn't exist.
+func (s *Server) findProject(w http.ResponseWriter, r *http.Request) (*project, bool) {
+	groupID := r.PathValue("groupId")
+	p, ok := s.projects[groupID]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "GROUP_NOT_FOUND", fmt.Sprintf("No group with ID %s exists.", groupID))
+	}
+	return p, ok
+}
+
+func (s *Server) projectResponse(p *project) document {
+	resp := clone(p.doc)
+	resp["clusterCount"] = len(p.clusters)
+	return resp
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	name, _ := req["name"].(string)
+	if name == "" {
+		writeError(w, r, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute name was not specified.")
+		return
+	}
+	for _, p := range s.projects {
+		if p.doc["name"] == name {
+			writeError(w, r, http.StatusConflict, "GROUP_ALREADY_EXISTS", fmt.Sprintf("A group with name %q already exists.", name))
+			return
+		}
+	}
+	doc := clone(req)
+	setDefaults(doc, document{
+		"orgId":                     s.config.OrgID,
+		"tags":                      []any{},
+		"withDefaultAlertsSettings": true,
+	})
+	doc["id"] = s.newID()
+	doc["created"] = time.Now().UTC().Format(time.RFC3339)
+	p := &project{
+		doc:           doc,
+		settings:      clone(defaultProjectSettings),
+		clusters:      make(map[string]*cluster),
+		databaseUsers: make(map[string]document),
+		accessList:    make(map[string]document),
+		searchIndexes: make(map[string]*searchIndex),
+		containers:    make(map[string]document),
+		teams:         []any{},
+	}
+	s.projects[doc["id"].(string)] = p
+	writeJSON(w, r, http.StatusOK, s.projectResponse(p))
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	ids := sortedKeys(s.projects)
+	results := make([]document, len(ids))
+	for i, id := range ids {
+		results[i] = s.projectResponse(s.projects[id])
+	}
+	writePage(w, r, results)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	if p, ok := s.findProject(w, r); ok {
+		writeJSON(w, r, http.StatusOK, s.projectResponse(p))
+	}
+}
+
+func (s *Server) getProjectByName(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("groupName")
+	for _, p := range s.projects {
+		if p.doc["name"] == name {
+			writeJSON(w, r, http.StatusOK, s.projectResponse(p))
+			return
+		}
+	}
+	writeError(w, r, http.StatusNotFound, "GROUP_NAME_NOT_FOUND", fmt.Sprintf("No group with name %s exists.", name))
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	for _, key := range []string{"name", "tags"} {
+		if value, ok := req[key]; ok {
+			p.doc[key] = value
+		}
+	}
+	writeJSON(w, r, http.StatusOK, s.projectResponse(p))
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	if len(p.clusters) > 0 {
+		writeError(w, r, http.StatusConflict, "CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS", "There are active clusters in this project. Please terminate all clusters before deleting the project.")
+		return
+	}
+	delete(s.projects, p.doc["id"].(string))
+	writeJSON(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) getProjectSettings(w http.ResponseWriter, r *http.Request) {
+	if p, ok := s.findProject(w, r); ok {
+		writeJSON(w, r, http.StatusOK, p.settings)
+	}
+}
+
+func (s *Server) updateProjectSettings(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	for key, value := range req {
+		if _, ok := defaultProjectSettings[key]; ok && value != nil {
+			p.settings[key] = value
+		}
+	}
+	writeJSON(w, r, http.StatusOK, p.settings)
+}
+
+func (s *Server) listProjectTeams(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	results := make([]document, 0, len(p.teams))
+	for _, team := range p.teams {
+		if doc, ok := team.(map[string]any); ok {
+			results = append(results, doc)
+		}
+	}
+	writePage(w, r, results)
+}
+
+func (s *Server) addProjectTeams(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	var req []any
+	if !readBody(w, r, &req) {
+		return
+	}
+	p.teams = append(p.teams, req...)
+	s.listProjectTeams(w, r)
+}
+
+func (s *Server) listProjectLimits(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.findProject(w, r); ok {
+		writeJSON(w, r, http.StatusOK, []any{})
+	}
+}
+
+func (s *Server) getProjectIPAddresses(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	clusters := make([]document, 0, len(p.clusters))
+	for _, name := range sortedKeys(p.clusters) {
+		clusters = append(clusters, document{"clusterName": name, "inbound": []any{}, "outbound": []any{}})
+	}
+	writeJSON(w, r, http.StatusOK, document{
+		"groupId":  p.doc["id"],
+		"services": document{"clusters": clusters},
+	})
+}
+
+func (s *Server) getManagedSlowMs(w http.ResponseWriter, r *http.Request) {
+	if p, ok := s.findProject(w, r); ok {
+		writeJSON(w, r, http.StatusOK, p.slowMsEnabled)
+	}
+}
+
+func (s *Server) enableManagedSlowMs(w http.ResponseWriter, r *http.Request) {
+	if p, ok := s.findProject(w, r); ok {
+		p.slowMsEnabled = true
+		writeJSON(w, r, http.StatusNoContent, nil)
+	}
+}
+
+func (s *Server) disableManagedSlowMs(w http.ResponseWriter, r *http.Request) {
+	if p, ok := s.findProject(w, r); ok {
+		p.slowMsEnabled = false
+		writeJSON(w, r, http.StatusNoContent, nil)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
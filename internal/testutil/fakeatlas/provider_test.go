@@ -0,0 +1,126 @@
+package fakeatlas_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/fakeatlas"
+)
+
+const (
+	projectResourceName = "mongodbatlas_project.test"
+	clusterResourceName = "mongodbatlas_advanced_cluster.test"
+)
+
+func TestProviderProjectAndCluster(t *testing.T) {
+	server := fakeatlas.NewServer(t, fakeatlas.Config{TransitionReads: 2})
+	client := server.Client(t).AtlasV2
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyProjectAndCluster(client),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + configProjectAndCluster(t, "project0", "cluster0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(projectResourceName, "id"),
+					resource.TestCheckResourceAttr(projectResourceName, "org_id", fakeatlas.DefaultOrgID),
+					resource.TestCheckResourceAttrPair(clusterResourceName, "project_id", projectResourceName, "id"),
+					// the cluster is CREATING in the first reads, the apply only finishes once it's IDLE
+					resource.TestCheckResourceAttr(clusterResourceName, "state_name", "IDLE"),
+					checkClusterState(client, "IDLE"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderInvalidCredentials(t *testing.T) {
+	server := fakeatlas.NewServer(t, fakeatlas.Config{})
+	providerConfig := fmt.Sprintf(`
+provider "mongodbatlas" {
+  base_url    = %[1]q
+  public_key  = %[2]q
+  private_key = "wrong-private-key"
+}
+`, server.BaseURL(), fakeatlas.DefaultPublicKey)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + configProjectAndCluster(t, "project0", "cluster0"),
+				ExpectError: regexp.MustCompile("You are not authorized for this resource"),
+			},
+		},
+	})
+}
+
+func configProjectAndCluster(t *testing.T, projectName, clusterName string) string {
+	t.Helper()
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  name   = %[1]q
+  org_id = %[2]q
+}
+`, projectName, fakeatlas.DefaultOrgID) + acc.ConvertAdvancedClusterToSchemaV2(t, true, fmt.Sprintf(`
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id   = mongodbatlas_project.test.id
+  name         = %[1]q
+  cluster_type = "REPLICASET"
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = "M10"
+        node_count    = 3
+      }
+      provider_name = "AWS"
+      region_name   = "US_EAST_1"
+      priority      = 7
+    }
+  }
+}
+`, clusterName))
+}
+
+func checkClusterState(client *admin.APIClient, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[clusterResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", clusterResourceName)
+		}
+		cluster, _, err := client.ClustersApi.GetCluster(context.Background(), rs.Primary.Attributes["project_id"], rs.Primary.Attributes["name"]).Execute()
+		if err != nil {
+			return err
+		}
+		if cluster.GetStateName() != expected {
+			return fmt.Errorf("cluster %s is %s in the fake Atlas server, expected %s", cluster.GetName(), cluster.GetStateName(), expected)
+		}
+		return nil
+	}
+}
+
+func checkDestroyProjectAndCluster(client *admin.APIClient) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			projectID := rs.Primary.Attributes["project_id"]
+			switch rs.Type {
+			case "mongodbatlas_project":
+				if _, _, err := client.ProjectsApi.GetProject(context.Background(), rs.Primary.ID).Execute(); !admin.IsErrorCode(err, "GROUP_NOT_FOUND") {
+					return fmt.Errorf("project %s still exists", rs.Primary.ID)
+				}
+			case "mongodbatlas_advanced_cluster":
+				if _, _, err := client.ClustersApi.GetCluster(context.Background(), projectID, rs.Primary.Attributes["name"]).Execute(); err == nil {
+					return fmt.Errorf("cluster %s still exists", rs.Primary.Attributes["name"])
+				}
+			}
+		}
+		return nil
+	}
+}
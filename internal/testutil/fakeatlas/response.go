@@ -0,0 +1,107 @@
+package fakeatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+)
+
+const (
+	defaultItemsPerPage = 100
+	defaultAPIVersion   = "2023-01-01"
+)
+
+var versionedMediaType = regexp.MustCompile(`application/vnd\.atlas\.(\d{4}-\d{2}-\d{2})\+json`)
+
+// document is the JSON representation of a resource, documents are kept as maps so requests of any API version can be stored.
+type document map[string]any
+
+// apiVersion returns the version requested in the Accept header, e.g. 2024-08-05 for application/vnd.atlas.2024-08-05+json.
+func apiVersion(r *http.Request) string {
+	if match := versionedMediaType.FindStringSubmatch(r.Header.Get("Accept")); match != nil {
+		return match[1]
+	}
+	return defaultAPIVersion
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, body any) {
+	w.Header().Set("Content-Type", fmt.Sprintf("application/vnd.atlas.%s+json", apiVersion(r)))
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+// writeError uses the format of the Atlas errors so the SDK returns them as GenericOpenAPIError with the error code.
+func writeError(w http.ResponseWriter, r *http.Request, status int, errorCode, detail string) {
+	body := document{
+		"detail":     detail,
+		"error":      status,
+		"parameters": []any{},
+		"reason":     http.StatusText(status),
+	}
+	if errorCode != "" {
+		body["errorCode"] = errorCode
+	}
+	writeJSON(w, r, status, body)
+}
+
+// readBody decodes the request body, it writes a bad request error if the body is not valid JSON.
+func readBody(w http.ResponseWriter, r *http.Request, body any) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeError(w, r, http.StatusBadRequest, "INVALID_JSON", fmt.Sprintf("Invalid JSON body: %s", err))
+		return false
+	}
+	return true
+}
+
+// writePage writes the page of results requested with the pageNum and itemsPerPage query params.
+func writePage(w http.ResponseWriter, r *http.Request, results []document) {
+	pageNum := queryInt(r, "pageNum", 1)
+	itemsPerPage := queryInt(r, "itemsPerPage", defaultItemsPerPage)
+	start := min((pageNum-1)*itemsPerPage, len(results))
+	end := min(start+itemsPerPage, len(results))
+	page := results[start:end]
+	if page == nil {
+		page = []document{}
+	}
+	writeJSON(w, r, http.StatusOK, document{
+		"links":      []any{},
+		"results":    page,
+		"totalCount": len(results),
+	})
+}
+
+func queryInt(r *http.Request, name string, defaultValue int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil || value < 1 {
+		return defaultValue
+	}
+	return value
+}
+
+// merge sets the values of the patch in the document, nested objects are replaced as in the Atlas PATCH endpoints.
+func merge(doc, patch document) {
+	for key, value := range patch {
+		doc[key] = value
+	}
+}
+
+// setDefaults sets the values of the defaults that are not defined in the document.
+func setDefaults(doc, defaults document) {
+	for key, value := range defaults {
+		if _, ok := doc[key]; !ok {
+			doc[key] = value
+		}
+	}
+}
+
+// clone returns a deep copy of the document so responses can be modified without changing the stored resource.
+func clone(doc document) document {
+	data, _ := json.Marshal(doc)
+	var result document
+	_ = json.Unmarshal(data, &result)
+	return result
+}
@@ -0,0 +1,135 @@
+package fakeatlas
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	searchIndexStatusField = "status"
+	defaultSearchIndexType = "search"
+)
+
+type searchIndex struct {
+	entity
+	clusterName string
+}
+
+// findSearchIndex writes the not found error if the project, the cluster or the index of the path params don't exist.
+func (s *Server) findSearchIndex(w http.ResponseWriter, r *http.Request) (*project, *searchIndex, bool) {
+	p, _, ok := s.findCluster(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	indexID := r.PathValue("indexId")
+	index, ok := p.searchIndexes[indexID]
+	if !ok || index.clusterName != r.PathValue("clusterName") {
+		writeError(w, r, http.StatusNotFound, "ATLAS_SEARCH_INDEX_NOT_FOUND", fmt.Sprintf("Search index with ID %s not found.", indexID))
+		return nil, nil, false
+	}
+	index.read()
+	return p, index, true
+}
+
+func (s *Server) createSearchIndex(w http.ResponseWriter, r *http.Request) {
+	p, _, ok := s.findCluster(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	clusterName := r.PathValue("clusterName")
+	for _, index := range p.searchIndexes {
+		if index.clusterName == clusterName && index.doc["name"] == req["name"] && index.doc["database"] == req["database"] && index.doc["collectionName"] == req["collectionName"] {
+			writeError(w, r, http.StatusBadRequest, "DUPLICATE_SEARCH_INDEX_NAME", fmt.Sprintf("Index %s already exists.", req["name"]))
+			return
+		}
+	}
+	doc := document{
+		"collectionName":          req["collectionName"],
+		"database":                req["database"],
+		"indexID":                 s.newID(),
+		"latestDefinition":        req["definition"],
+		"latestDefinitionVersion": document{"version": 0},
+		"name":                    req["name"],
+		"queryable":               false,
+		"type":                    req["type"],
+	}
+	if doc["type"] == nil {
+		doc["type"] = defaultSearchIndexType
+	}
+	index := &searchIndex{entity: entity{doc: doc}, clusterName: clusterName}
+	s.startTransition(&index.entity, searchIndexStatusField, "IN_PROGRESS", "READY")
+	p.searchIndexes[doc["indexID"].(string)] = index
+	writeJSON(w, r, http.StatusCreated, searchIndexResponse(index))
+}
+
+func (s *Server) listClusterSearchIndexes(w http.ResponseWriter, r *http.Request) {
+	s.listSearchIndexes(w, r, func(doc document) bool { return true })
+}
+
+func (s *Server) listCollectionSearchIndexes(w http.ResponseWriter, r *http.Request) {
+	s.listSearchIndexes(w, r, func(doc document) bool {
+		return doc["database"] == r.PathValue("databaseName") && doc["collectionName"] == r.PathValue("collectionName")
+	})
+}
+
+// listSearchIndexes writes the indexes of the cluster as a plain array, the search index list endpoints are not paginated.
+func (s *Server) listSearchIndexes(w http.ResponseWriter, r *http.Request, filter func(doc document) bool) {
+	p, _, ok := s.findCluster(w, r)
+	if !ok {
+		return
+	}
+	clusterName := r.PathValue("clusterName")
+	results := []document{}
+	for _, id := range sortedKeys(p.searchIndexes) {
+		index := p.searchIndexes[id]
+		if index.clusterName != clusterName || !filter(index.doc) {
+			continue
+		}
+		index.read()
+		results = append(results, searchIndexResponse(index))
+	}
+	writeJSON(w, r, http.StatusOK, results)
+}
+
+func (s *Server) getSearchIndex(w http.ResponseWriter, r *http.Request) {
+	if _, index, ok := s.findSearchIndex(w, r); ok {
+		writeJSON(w, r, http.StatusOK, searchIndexResponse(index))
+	}
+}
+
+func (s *Server) updateSearchIndex(w http.ResponseWriter, r *http.Request) {
+	_, index, ok := s.findSearchIndex(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	if definition, ok := req["definition"]; ok {
+		index.doc["latestDefinition"] = definition
+		version, _ := index.doc["latestDefinitionVersion"].(document)
+		index.doc["latestDefinitionVersion"] = document{"version": version["version"].(int) + 1}
+	}
+	s.startTransition(&index.entity, searchIndexStatusField, "IN_PROGRESS", "READY")
+	writeJSON(w, r, http.StatusOK, searchIndexResponse(index))
+}
+
+func (s *Server) deleteSearchIndex(w http.ResponseWriter, r *http.Request) {
+	if p, index, ok := s.findSearchIndex(w, r); ok {
+		delete(p.searchIndexes, index.doc["indexID"].(string))
+		writeJSON(w, r, http.StatusNoContent, nil)
+	}
+}
+
+// searchIndexResponse marks the index as queryable once it has been built for the first time, updates keep serving the previous definition.
+func searchIndexResponse(index *searchIndex) document {
+	if index.doc[searchIndexStatusField] == "READY" {
+		index.doc["queryable"] = true
+	}
+	return clone(index.doc)
+}
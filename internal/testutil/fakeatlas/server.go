@@ -0,0 +1,179 @@
+// Package fakeatlas provides a stateful in-memory Atlas Admin API server so provider tests can run plan, apply and destroy cycles offline.
+// Only the core v2 endpoints of projects, clusters, database users, access lists and search indexes are implemented,
+// together with the listing of the network containers created for the clusters.
+package fakeatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	DefaultPublicKey       = "fake-public-key"
+	DefaultPrivateKey      = "fake-private-key"
+	DefaultOrgID           = "65f000000000000000000000"
+	defaultTransitionReads = 1
+	apiPrefix              = "/api/atlas/v2"
+)
+
+type Config struct {
+	PublicKey  string
+	PrivateKey string
+	OrgID      string
+	// TransitionReads is the number of reads returning a transitional state (e.g. CREATING) before the resource reaches its final state (e.g. IDLE), 0 uses the default of 1.
+	TransitionReads int
+}
+
+// Server keeps all the resources in memory, requests are served one at a time so concurrent provider operations see a consistent state.
+type Server struct {
+	httpServer *httptest.Server
+	auth       *digestAuth
+	projects   map[string]*project
+	config     Config
+	mu         sync.Mutex
+	lastID     int
+}
+
+// NewServer starts a server that is closed when the test finishes, empty values of the config use the defaults.
+func NewServer(t testing.TB, cfg Config) *Server {
+	t.Helper()
+	if cfg.PublicKey == "" {
+		cfg.PublicKey = DefaultPublicKey
+	}
+	if cfg.PrivateKey == "" {
+		cfg.PrivateKey = DefaultPrivateKey
+	}
+	if cfg.OrgID == "" {
+		cfg.OrgID = DefaultOrgID
+	}
+	if cfg.TransitionReads == 0 {
+		cfg.TransitionReads = defaultTransitionReads
+	}
+	s := &Server{
+		config:   cfg,
+		auth:     newDigestAuth(cfg.PublicKey, cfg.PrivateKey),
+		projects: make(map[string]*project),
+	}
+	s.httpServer = httptest.NewServer(s.auth.middleware(s.routes()))
+	t.Cleanup(s.httpServer.Close)
+	return s
+}
+
+// BaseURL is the value of the base_url provider attribute, it includes the trailing slash expected by the clients.
+func (s *Server) BaseURL() string {
+	return s.httpServer.URL + "/"
+}
+
+// ProviderConfig returns the provider block using the server, it can be prepended to the test configs.
+// Unlike SetProviderEnv it can be used in parallel tests.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "mongodbatlas" {
+  base_url    = %[1]q
+  public_key  = %[2]q
+  private_key = %[3]q
+}
+`, s.BaseURL(), s.config.PublicKey, s.config.PrivateKey)
+}
+
+// SetProviderEnv sets the env vars used by the provider so existing test configs without a provider block use the server.
+func (s *Server) SetProviderEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("MONGODB_ATLAS_BASE_URL", s.BaseURL())
+	t.Setenv("MONGODB_ATLAS_PUBLIC_KEY", s.config.PublicKey)
+	t.Setenv("MONGODB_ATLAS_PRIVATE_KEY", s.config.PrivateKey)
+}
+
+// Client returns the same clients used by the provider, e.g. to check the resources in test check functions.
+func (s *Server) Client(t testing.TB) *config.MongoDBClient {
+	t.Helper()
+	cfg := config.Config{
+		PublicKey:  s.config.PublicKey,
+		PrivateKey: s.config.PrivateKey,
+		BaseURL:    s.BaseURL(),
+	}
+	client, err := cfg.NewClient(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client for fake Atlas server: %s", err)
+	}
+	return client.(*config.MongoDBClient)
+}
+
+func (s *Server) routes() http.Handler {
+	// byName conflicts with project subresource patterns like {groupId}/settings so it's served by a root mux
+	root := http.NewServeMux()
+	mux := http.NewServeMux()
+	root.Handle("/", mux)
+	handleOn := func(m *http.ServeMux, pattern string, handler func(w http.ResponseWriter, r *http.Request)) {
+		m.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			handler(w, r)
+		})
+	}
+	handle := func(pattern string, handler func(w http.ResponseWriter, r *http.Request)) {
+		handleOn(mux, pattern, handler)
+	}
+
+	handle("POST "+apiPrefix+"/groups", s.createProject)
+	handle("GET "+apiPrefix+"/groups", s.listProjects)
+	handle("GET "+apiPrefix+"/groups/{groupId}", s.getProject)
+	handleOn(root, "GET "+apiPrefix+"/groups/byName/{groupName}", s.getProjectByName)
+	handle("PATCH "+apiPrefix+"/groups/{groupId}", s.updateProject)
+	handle("DELETE "+apiPrefix+"/groups/{groupId}", s.deleteProject)
+	handle("GET "+apiPrefix+"/groups/{groupId}/settings", s.getProjectSettings)
+	handle("PATCH "+apiPrefix+"/groups/{groupId}/settings", s.updateProjectSettings)
+	handle("GET "+apiPrefix+"/groups/{groupId}/teams", s.listProjectTeams)
+	handle("POST "+apiPrefix+"/groups/{groupId}/teams", s.addProjectTeams)
+	handle("GET "+apiPrefix+"/groups/{groupId}/limits", s.listProjectLimits)
+	handle("GET "+apiPrefix+"/groups/{groupId}/ipAddresses", s.getProjectIPAddresses)
+	handle("GET "+apiPrefix+"/groups/{groupId}/managedSlowMs", s.getManagedSlowMs)
+	handle("POST "+apiPrefix+"/groups/{groupId}/managedSlowMs/enable", s.enableManagedSlowMs)
+	handle("DELETE "+apiPrefix+"/groups/{groupId}/managedSlowMs/disable", s.disableManagedSlowMs)
+
+	handle("POST "+apiPrefix+"/groups/{groupId}/clusters", s.createCluster)
+	handle("GET "+apiPrefix+"/groups/{groupId}/clusters", s.listClusters)
+	handle("GET "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}", s.getCluster)
+	handle("PATCH "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}", s.updateCluster)
+	handle("DELETE "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}", s.deleteCluster)
+	handle("GET "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}/processArgs", s.getProcessArgs)
+	handle("PATCH "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}/processArgs", s.updateProcessArgs)
+
+	handle("POST "+apiPrefix+"/groups/{groupId}/databaseUsers", s.createDatabaseUser)
+	handle("GET "+apiPrefix+"/groups/{groupId}/databaseUsers", s.listDatabaseUsers)
+	handle("GET "+apiPrefix+"/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.getDatabaseUser)
+	handle("PATCH "+apiPrefix+"/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.updateDatabaseUser)
+	handle("DELETE "+apiPrefix+"/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.deleteDatabaseUser)
+
+	handle("POST "+apiPrefix+"/groups/{groupId}/accessList", s.createAccessListEntries)
+	handle("GET "+apiPrefix+"/groups/{groupId}/accessList", s.listAccessListEntries)
+	handle("GET "+apiPrefix+"/groups/{groupId}/accessList/{entryValue}", s.getAccessListEntry)
+	handle("GET "+apiPrefix+"/groups/{groupId}/accessList/{entryValue}/status", s.getAccessListEntryStatus)
+	handle("DELETE "+apiPrefix+"/groups/{groupId}/accessList/{entryValue}", s.deleteAccessListEntry)
+
+	handle("POST "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}/search/indexes", s.createSearchIndex)
+	handle("GET "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}/search/indexes", s.listClusterSearchIndexes)
+	handle("GET "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}/search/indexes/{indexId}", s.getSearchIndex)
+	handle("PATCH "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}/search/indexes/{indexId}", s.updateSearchIndex)
+	handle("DELETE "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}/search/indexes/{indexId}", s.deleteSearchIndex)
+	handle("GET "+apiPrefix+"/groups/{groupId}/clusters/{clusterName}/search/indexes/{databaseName}/{collectionName}", s.listCollectionSearchIndexes)
+
+	handle("GET "+apiPrefix+"/groups/{groupId}/containers", s.listContainers)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, r, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("%s %s is not supported by the fake Atlas server.", r.Method, r.URL.Path))
+	})
+	return root
+}
+
+// newID returns a unique 24 hex characters id like the ones generated by Atlas, ids are increasing so they keep the creation order.
+func (s *Server) newID() string {
+	s.lastID++
+	return fmt.Sprintf("65f1%020x", s.lastID)
+}
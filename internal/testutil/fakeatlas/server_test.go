@@ -0,0 +1,303 @@
+package fakeatlas_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/fakeatlas"
+)
+
+const clusterName = "cluster0"
+
+func TestProjectLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := fakeatlas.NewServer(t, fakeatlas.Config{}).Client(t).AtlasV2
+	project, _, err := client.ProjectsApi.CreateProject(ctx, &admin.Group{Name: "project0", OrgId: fakeatlas.DefaultOrgID}).Execute()
+	require.NoError(t, err)
+	assert.Len(t, project.GetId(), 24)
+	assert.Equal(t, int64(0), project.GetClusterCount())
+
+	_, _, err = client.ProjectsApi.CreateProject(ctx, &admin.Group{Name: "project0", OrgId: fakeatlas.DefaultOrgID}).Execute()
+	assert.True(t, admin.IsErrorCode(err, "GROUP_ALREADY_EXISTS"))
+
+	byName, _, err := client.ProjectsApi.GetProjectByName(ctx, "project0").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, project.GetId(), byName.GetId())
+
+	updated, _, err := client.ProjectsApi.UpdateProject(ctx, project.GetId(), &admin.GroupUpdate{Name: conversion.StringPtr("project1")}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "project1", updated.GetName())
+
+	settings, _, err := client.ProjectsApi.UpdateProjectSettings(ctx, project.GetId(), &admin.GroupSettings{IsDataExplorerEnabled: conversion.Pointer(false)}).Execute()
+	require.NoError(t, err)
+	assert.False(t, settings.GetIsDataExplorerEnabled())
+	assert.True(t, settings.GetIsSchemaAdvisorEnabled())
+
+	_, _, err = client.ProjectsApi.DeleteProject(ctx, project.GetId()).Execute()
+	require.NoError(t, err)
+	_, _, err = client.ProjectsApi.GetProject(ctx, project.GetId()).Execute()
+	assert.True(t, admin.IsErrorCode(err, "GROUP_NOT_FOUND"))
+}
+
+func TestClusterTransitions(t *testing.T) {
+	ctx := context.Background()
+	client, projectID := newProject(t, fakeatlas.Config{TransitionReads: 2})
+	created := createCluster(t, client, projectID)
+	assert.Equal(t, "CREATING", created.GetStateName())
+	assert.NotEmpty(t, created.ConnectionStrings.GetStandardSrv())
+	regionConfig := created.GetReplicationSpecs()[0].GetRegionConfigs()[0]
+	assert.Equal(t, 0, regionConfig.ReadOnlySpecs.GetNodeCount())
+	assert.Equal(t, 3000, regionConfig.ElectableSpecs.GetDiskIOPS())
+
+	for _, expected := range []string{"CREATING", "CREATING", "IDLE", "IDLE"} {
+		cluster, _, err := client.ClustersApi.GetCluster(ctx, projectID, clusterName).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, expected, cluster.GetStateName())
+	}
+
+	updated, _, err := client.ClustersApi.UpdateCluster(ctx, projectID, clusterName, &admin.ClusterDescription20240805{Paused: conversion.Pointer(true)}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "UPDATING", updated.GetStateName())
+	assert.True(t, updated.GetPaused())
+	assert.Equal(t, created.GetReplicationSpecs()[0].GetId(), updated.GetReplicationSpecs()[0].GetId())
+
+	_, _, err = client.ProjectsApi.DeleteProject(ctx, projectID).Execute()
+	assert.True(t, admin.IsErrorCode(err, "CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS"))
+
+	_, err = client.ClustersApi.DeleteCluster(ctx, projectID, clusterName).Execute()
+	require.NoError(t, err)
+	for range 2 {
+		cluster, _, err := client.ClustersApi.GetCluster(ctx, projectID, clusterName).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, "DELETING", cluster.GetStateName())
+	}
+	_, resp, err := client.ClustersApi.GetCluster(ctx, projectID, clusterName).Execute()
+	assert.True(t, admin.IsErrorCode(err, "CLUSTER_NOT_FOUND"))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	_, _, err = client.ProjectsApi.DeleteProject(ctx, projectID).Execute()
+	require.NoError(t, err)
+}
+
+func TestClusterLegacyAPIVersion(t *testing.T) {
+	ctx := context.Background()
+	srv := fakeatlas.NewServer(t, fakeatlas.Config{})
+	clients := srv.Client(t)
+	project, _, err := clients.AtlasV2.ProjectsApi.CreateProject(ctx, &admin.Group{Name: "project0", OrgId: fakeatlas.DefaultOrgID}).Execute()
+	require.NoError(t, err)
+	createCluster(t, clients.AtlasV2, project.GetId(), newReplicationSpec(), newReplicationSpec())
+
+	cluster, _, err := clients.AtlasV220240530.ClustersApi.GetCluster(ctx, project.GetId(), clusterName).Execute()
+	require.NoError(t, err)
+	require.Len(t, cluster.GetReplicationSpecs(), 1)
+	assert.Equal(t, 2, cluster.GetReplicationSpecs()[0].GetNumShards())
+	assert.InDelta(t, 10.0, cluster.GetDiskSizeGB(), 0)
+}
+
+func TestProcessArgs(t *testing.T) {
+	ctx := context.Background()
+	client, projectID := newProject(t, fakeatlas.Config{})
+	createCluster(t, client, projectID)
+	args, _, err := client.ClustersApi.GetClusterAdvancedConfiguration(ctx, projectID, clusterName).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "TLS1_2", args.GetMinimumEnabledTlsProtocol())
+
+	args, _, err = client.ClustersApi.UpdateClusterAdvancedConfiguration(ctx, projectID, clusterName, &admin.ClusterDescriptionProcessArgs20240805{JavascriptEnabled: conversion.Pointer(false)}).Execute()
+	require.NoError(t, err)
+	assert.False(t, args.GetJavascriptEnabled())
+	assert.Equal(t, "TLS1_2", args.GetMinimumEnabledTlsProtocol())
+}
+
+func TestClusterContainers(t *testing.T) {
+	ctx := context.Background()
+	client, projectID := newProject(t, fakeatlas.Config{})
+	containers, _, err := client.NetworkPeeringApi.ListPeeringContainerByCloudProvider(ctx, projectID).ProviderName("AWS").Execute()
+	require.NoError(t, err)
+	assert.Empty(t, containers.GetResults())
+
+	createCluster(t, client, projectID, newReplicationSpec(), newReplicationSpec())
+	containers, _, err = client.NetworkPeeringApi.ListPeeringContainerByCloudProvider(ctx, projectID).ProviderName("AWS").Execute()
+	require.NoError(t, err)
+	require.Len(t, containers.GetResults(), 1)
+	assert.Equal(t, "US_EAST_1", containers.GetResults()[0].GetRegionName())
+	assert.True(t, containers.GetResults()[0].GetProvisioned())
+
+	containers, _, err = client.NetworkPeeringApi.ListPeeringContainerByCloudProvider(ctx, projectID).ProviderName("GCP").Execute()
+	require.NoError(t, err)
+	assert.Empty(t, containers.GetResults())
+}
+
+func TestDatabaseUsers(t *testing.T) {
+	ctx := context.Background()
+	client, projectID := newProject(t, fakeatlas.Config{})
+	user := &admin.CloudDatabaseUser{
+		GroupId:      projectID,
+		DatabaseName: "admin",
+		Username:     "user0",
+		Password:     conversion.StringPtr("secret"),
+		Roles:        &[]admin.DatabaseUserRole{{RoleName: "readAnyDatabase", DatabaseName: "admin"}},
+	}
+	created, _, err := client.DatabaseUsersApi.CreateDatabaseUser(ctx, projectID, user).Execute()
+	require.NoError(t, err)
+	assert.False(t, created.HasPassword())
+	assert.Equal(t, "NONE", created.GetX509Type())
+
+	_, _, err = client.DatabaseUsersApi.CreateDatabaseUser(ctx, projectID, user).Execute()
+	assert.True(t, admin.IsErrorCode(err, "USER_ALREADY_EXISTS"))
+
+	updated, _, err := client.DatabaseUsersApi.UpdateDatabaseUser(ctx, projectID, "admin", "user0", &admin.CloudDatabaseUser{
+		Roles: &[]admin.DatabaseUserRole{{RoleName: "atlasAdmin", DatabaseName: "admin"}},
+	}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "atlasAdmin", updated.GetRoles()[0].RoleName)
+
+	users, _, err := client.DatabaseUsersApi.ListDatabaseUsers(ctx, projectID).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 1, users.GetTotalCount())
+
+	_, _, err = client.DatabaseUsersApi.DeleteDatabaseUser(ctx, projectID, "admin", "user0").Execute()
+	require.NoError(t, err)
+	_, _, err = client.DatabaseUsersApi.GetDatabaseUser(ctx, projectID, "admin", "user0").Execute()
+	assert.True(t, admin.IsErrorCode(err, "USERNAME_NOT_FOUND"))
+}
+
+func TestAccessList(t *testing.T) {
+	ctx := context.Background()
+	client, projectID := newProject(t, fakeatlas.Config{})
+	entries := []admin.NetworkPermissionEntry{
+		{CidrBlock: conversion.StringPtr("10.0.0.0/16")},
+		{IpAddress: conversion.StringPtr("192.168.1.1")},
+	}
+	created, _, err := client.ProjectIPAccessListApi.CreateProjectIpAccessList(ctx, projectID, &entries).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 2, created.GetTotalCount())
+
+	entry, _, err := client.ProjectIPAccessListApi.GetProjectIpList(ctx, projectID, "10.0.0.0/16").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, projectID, entry.GetGroupId())
+
+	for _, value := range []string{"192.168.1.1", "192.168.1.1/32"} {
+		entry, _, err = client.ProjectIPAccessListApi.GetProjectIpList(ctx, projectID, value).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, "192.168.1.1/32", entry.GetCidrBlock())
+	}
+	status, _, err := client.ProjectIPAccessListApi.GetProjectIpAccessListStatus(ctx, projectID, "192.168.1.1").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVE", status.GetSTATUS())
+
+	_, _, err = client.ProjectIPAccessListApi.DeleteProjectIpAccessList(ctx, projectID, "10.0.0.0/16").Execute()
+	require.NoError(t, err)
+	_, _, err = client.ProjectIPAccessListApi.GetProjectIpList(ctx, projectID, "10.0.0.0/16").Execute()
+	assert.True(t, admin.IsErrorCode(err, "ATLAS_NETWORK_PERMISSION_ENTRY_NOT_FOUND"))
+}
+
+func TestSearchIndexes(t *testing.T) {
+	ctx := context.Background()
+	client, projectID := newProject(t, fakeatlas.Config{})
+	createCluster(t, client, projectID)
+	req := &admin.SearchIndexCreateRequest{
+		CollectionName: "collection0",
+		Database:       "db0",
+		Name:           "index0",
+		Definition:     &admin.BaseSearchIndexCreateRequestDefinition{Analyzer: conversion.StringPtr("lucene.standard")},
+	}
+	created, _, err := client.AtlasSearchApi.CreateAtlasSearchIndex(ctx, projectID, clusterName, req).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "IN_PROGRESS", created.GetStatus())
+	assert.Equal(t, "search", created.GetType())
+	assert.False(t, created.GetQueryable())
+
+	for _, expected := range []string{"IN_PROGRESS", "READY"} {
+		index, _, err := client.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, clusterName, created.GetIndexID()).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, expected, index.GetStatus())
+	}
+
+	updated, _, err := client.AtlasSearchApi.UpdateAtlasSearchIndex(ctx, projectID, clusterName, created.GetIndexID(), &admin.SearchIndexUpdateRequest{
+		Definition: admin.SearchIndexUpdateRequestDefinition{Analyzer: conversion.StringPtr("lucene.simple")},
+	}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "IN_PROGRESS", updated.GetStatus())
+	assert.True(t, updated.GetQueryable())
+	assert.Equal(t, "lucene.simple", updated.LatestDefinition.GetAnalyzer())
+
+	indexes, _, err := client.AtlasSearchApi.ListAtlasSearchIndexes(ctx, projectID, clusterName, "collection0", "db0").Execute()
+	require.NoError(t, err)
+	assert.Len(t, indexes, 1)
+	indexes, _, err = client.AtlasSearchApi.ListAtlasSearchIndexes(ctx, projectID, clusterName, "other", "db0").Execute()
+	require.NoError(t, err)
+	assert.Empty(t, indexes)
+
+	_, _, err = client.AtlasSearchApi.DeleteAtlasSearchIndex(ctx, projectID, clusterName, created.GetIndexID()).Execute()
+	require.NoError(t, err)
+	_, _, err = client.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, clusterName, created.GetIndexID()).Execute()
+	assert.True(t, admin.IsErrorCode(err, "ATLAS_SEARCH_INDEX_NOT_FOUND"))
+}
+
+func TestPagination(t *testing.T) {
+	ctx := context.Background()
+	client, projectID := newProject(t, fakeatlas.Config{})
+	for _, username := range []string{"user0", "user1", "user2"} {
+		_, _, err := client.DatabaseUsersApi.CreateDatabaseUser(ctx, projectID, &admin.CloudDatabaseUser{GroupId: projectID, DatabaseName: "admin", Username: username}).Execute()
+		require.NoError(t, err)
+	}
+	page, _, err := client.DatabaseUsersApi.ListDatabaseUsers(ctx, projectID).PageNum(2).ItemsPerPage(2).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 3, page.GetTotalCount())
+	require.Len(t, page.GetResults(), 1)
+	assert.Equal(t, "user2", page.GetResults()[0].Username)
+}
+
+func TestDigestAuth(t *testing.T) {
+	srv := fakeatlas.NewServer(t, fakeatlas.Config{})
+	cfg := config.Config{PublicKey: fakeatlas.DefaultPublicKey, PrivateKey: "wrong-private-key", BaseURL: srv.BaseURL()}
+	client, err := cfg.NewClient(context.Background())
+	require.NoError(t, err)
+	_, resp, err := client.(*config.MongoDBClient).AtlasV2.ProjectsApi.ListProjects(context.Background()).Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func newProject(t *testing.T, cfg fakeatlas.Config) (client *admin.APIClient, projectID string) {
+	t.Helper()
+	client = fakeatlas.NewServer(t, cfg).Client(t).AtlasV2
+	project, _, err := client.ProjectsApi.CreateProject(context.Background(), &admin.Group{Name: "project0", OrgId: fakeatlas.DefaultOrgID}).Execute()
+	require.NoError(t, err)
+	return client, project.GetId()
+}
+
+func createCluster(t *testing.T, client *admin.APIClient, projectID string, specs ...admin.ReplicationSpec20240805) *admin.ClusterDescription20240805 {
+	t.Helper()
+	if len(specs) == 0 {
+		specs = []admin.ReplicationSpec20240805{newReplicationSpec()}
+	}
+	cluster, _, err := client.ClustersApi.CreateCluster(context.Background(), projectID, &admin.ClusterDescription20240805{
+		Name:             conversion.StringPtr(clusterName),
+		ClusterType:      conversion.StringPtr("SHARDED"),
+		ReplicationSpecs: &specs,
+	}).Execute()
+	require.NoError(t, err)
+	return cluster
+}
+
+func newReplicationSpec() admin.ReplicationSpec20240805 {
+	return admin.ReplicationSpec20240805{
+		RegionConfigs: &[]admin.CloudRegionConfig20240805{{
+			ProviderName: conversion.StringPtr("AWS"),
+			RegionName:   conversion.StringPtr("US_EAST_1"),
+			Priority:     conversion.Pointer(7),
+			ElectableSpecs: &admin.HardwareSpec20240805{
+				InstanceSize: conversion.StringPtr("M10"),
+				NodeCount:    conversion.Pointer(3),
+				DiskSizeGB:   conversion.Pointer(10.0),
+			},
+		}},
+	}
+}
@@ -0,0 +1,40 @@
+package fakeatlas
+
+// entity is a resource that can be in a transitional state, e.g. a cluster being created.
+type entity struct {
+	doc     document
+	pending *transition
+}
+
+// transition changes the state field of the entity after a number of reads, an empty target state removes the entity.
+type transition struct {
+	field       string
+	targetState string
+	readsLeft   int
+}
+
+func (s *Server) startTransition(e *entity, field, state, targetState string) {
+	e.doc[field] = state
+	e.pending = &transition{field: field, targetState: targetState, readsLeft: s.config.TransitionReads}
+}
+
+// read returns false if the entity is removed, the transitional state is returned in the reads defined by Config.TransitionReads.
+func (e *entity) read() bool {
+	if e.pending == nil {
+		return true
+	}
+	if e.pending.readsLeft > 0 {
+		e.pending.readsLeft--
+		return true
+	}
+	if e.pending.targetState == "" {
+		return false
+	}
+	e.doc[e.pending.field] = e.pending.targetState
+	e.pending = nil
+	return true
+}
+
+func (e *entity) deleting() bool {
+	return e.pending != nil && e.pending.targetState == ""
+}
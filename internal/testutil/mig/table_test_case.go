@@ -0,0 +1,243 @@
+package mig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const sourceProviderAddress = "registry.terraform.io/mongodb/mongodbatlas"
+
+// TableTest is a table-driven migration test of a resource type.
+// Configs are applied with the previous provider version and planned with the current one expecting an empty plan, they need live Atlas.
+// States are recorded states of the previous provider version sent to the UpgradeState or MoveState handlers of the current one, they run offline.
+type TableTest struct {
+	PreCheck     func()
+	CheckDestroy resource.TestCheckFunc
+	Configs      map[string]ConfigTest
+	States       map[string]StateTest
+	ResourceType string // e.g. mongodbatlas_event_trigger
+}
+
+type ConfigTest struct {
+	// Attrs are checked in all the resources of ResourceType after applying Config with the previous provider version.
+	Attrs  map[string]string
+	Config string
+	// MinVersion skips the test when the previous provider version is below it, e.g. when Config uses attributes added later.
+	MinVersion string
+}
+
+type StateTest struct {
+	// ExpectError matches the error diagnostics of the upgrade or move, Attrs are not checked when set.
+	ExpectError *regexp.Regexp
+	// Attrs are the expected attributes of the resulting state with the same format as resource.TestCheckResourceAttr,
+	// e.g. replication_specs.# or replication_specs.0.zone_name. Null attributes are not in the state.
+	Attrs map[string]string
+	// RawState is the JSON of the attributes of the resource instance in the state file written by the previous provider version.
+	RawState string
+	// SourceType uses MoveState from this resource type instead of UpgradeState, e.g. mongodbatlas_cluster.
+	SourceType string
+	// Version is the schema_version of the resource instance in the state file.
+	Version int64
+}
+
+// RunTableTest runs each config and state as a subtest, config tests are skipped in unit tests.
+func RunTableTest(t *testing.T, test *TableTest) {
+	t.Helper()
+	for name, configTest := range test.Configs {
+		t.Run(name, func(t *testing.T) {
+			acc.SkipInUnitTest(t)
+			if configTest.MinVersion != "" {
+				SkipIfVersionBelow(t, configTest.MinVersion)
+			}
+			CreateAndRunTest(t, &resource.TestCase{
+				PreCheck:     test.PreCheck,
+				CheckDestroy: test.CheckDestroy,
+				Steps: []resource.TestStep{
+					{
+						Config: configTest.Config,
+						Check:  checkResourceTypeAttrs(test.ResourceType, configTest.Attrs),
+					},
+				},
+			})
+		})
+	}
+	for name, stateTest := range test.States {
+		t.Run(name, func(t *testing.T) {
+			attrs, err := UpgradeOrMoveState(context.Background(), test.ResourceType, &stateTest)
+			if stateTest.ExpectError != nil {
+				require.Error(t, err)
+				assert.Regexp(t, stateTest.ExpectError, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			for name, expected := range stateTest.Attrs {
+				if assert.Contains(t, attrs, name, "attribute not found in state") {
+					assert.Equal(t, expected, attrs[name], "unexpected value of attribute %s", name)
+				}
+			}
+		})
+	}
+}
+
+// UpgradeOrMoveState sends the recorded state through the protocol server of the current provider and returns the attributes of the resulting state.
+func UpgradeOrMoveState(ctx context.Context, resourceType string, test *StateTest) (map[string]string, error) {
+	server, err := acc.TestAccProviderV6Factories[acc.ProviderNameMongoDBAtlas]()
+	if err != nil {
+		return nil, err
+	}
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resourceSchema, ok := schemaResp.ResourceSchemas[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource type %s not found in provider schema", resourceType)
+	}
+	rawState := &tfprotov6.RawState{JSON: []byte(test.RawState)}
+	var (
+		state *tfprotov6.DynamicValue
+		diags []*tfprotov6.Diagnostic
+	)
+	if test.SourceType == "" {
+		resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+			TypeName: resourceType,
+			Version:  test.Version,
+			RawState: rawState,
+		})
+		if err != nil {
+			return nil, err
+		}
+		state, diags = resp.UpgradedState, resp.Diagnostics
+	} else {
+		resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+			SourceProviderAddress: sourceProviderAddress,
+			SourceTypeName:        test.SourceType,
+			SourceSchemaVersion:   test.Version,
+			SourceState:           rawState,
+			TargetTypeName:        resourceType,
+		})
+		if err != nil {
+			return nil, err
+		}
+		state, diags = resp.TargetState, resp.Diagnostics
+	}
+	if err := diagnosticsError(diags); err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, fmt.Errorf("no state returned for resource type %s", resourceType)
+	}
+	value, err := state.Unmarshal(resourceSchema.ValueType())
+	if err != nil {
+		return nil, err
+	}
+	attrs := map[string]string{}
+	flattenValue(attrs, "", value)
+	return attrs, nil
+}
+
+func checkResourceTypeAttrs(resourceType string, attrs map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		found := false
+		for address, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			found = true
+			for name, expected := range attrs {
+				if got := rs.Primary.Attributes[name]; got != expected {
+					return fmt.Errorf("%s: attribute %s expected %q, got %q", address, name, expected, got)
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("no resources of type %s found in state", resourceType)
+		}
+		return nil
+	}
+}
+
+func diagnosticsError(diags []*tfprotov6.Diagnostic) error {
+	var errs []error
+	for _, diag := range diags {
+		if diag.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", diag.Summary, diag.Detail))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// flattenValue uses the flatmap format of the terraform state, lists and sets have a .# attribute with the number of elements and maps a .% attribute.
+func flattenValue(attrs map[string]string, path string, value tftypes.Value) {
+	if value.IsNull() || !value.IsKnown() {
+		return
+	}
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elems []tftypes.Value
+		if err := value.As(&elems); err != nil {
+			return
+		}
+		attrs[path+".#"] = strconv.Itoa(len(elems))
+		for i, elem := range elems {
+			flattenValue(attrs, joinPath(path, strconv.Itoa(i)), elem)
+		}
+	case tftypes.Map:
+		var elems map[string]tftypes.Value
+		if err := value.As(&elems); err != nil {
+			return
+		}
+		attrs[path+".%"] = strconv.Itoa(len(elems))
+		for name, elem := range elems {
+			flattenValue(attrs, joinPath(path, name), elem)
+		}
+	case tftypes.Object:
+		var elems map[string]tftypes.Value
+		if err := value.As(&elems); err != nil {
+			return
+		}
+		for name, elem := range elems {
+			flattenValue(attrs, joinPath(path, name), elem)
+		}
+	default:
+		attrs[path] = primitiveString(value)
+	}
+}
+
+func primitiveString(value tftypes.Value) string {
+	switch {
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return strconv.FormatBool(b)
+	case value.Type().Is(tftypes.Number):
+		var n big.Float
+		_ = value.As(&n)
+		return n.Text('f', -1)
+	default:
+		var s string
+		_ = value.As(&s)
+		return s
+	}
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
@@ -0,0 +1,74 @@
+package mig_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/mig"
+	"github.com/stretchr/testify/assert"
+)
+
+const eventTriggerStateV0 = `{
+	"id": "664619d870c247237f4b86a6-6646fd8d1a4e4e8b5f7d4a21-6646fd8d1a4e4e8b5f7d4a22",
+	"project_id": "664619d870c247237f4b86a6",
+	"app_id": "6646fd8d1a4e4e8b5f7d4a21",
+	"trigger_id": "6646fd8d1a4e4e8b5f7d4a22",
+	"name": "test-trigger",
+	"type": "DATABASE",
+	"function_id": "6646fd8d1a4e4e8b5f7d4a23",
+	"function_name": "func",
+	"disabled": true,
+	"config_operation_types": ["INSERT", "UPDATE"],
+	"config_operation_type": "",
+	"config_providers": [],
+	"config_database": "sample_airbnb",
+	"config_collection": "listingsAndReviews",
+	"config_service_id": "6646fd8d1a4e4e8b5f7d4a24",
+	"config_match": "{\"updateDescription.updatedFields\":{\"status\":\"blocked\"}}",
+	"config_project": "",
+	"config_full_document": false,
+	"config_full_document_before": false,
+	"config_schedule": "",
+	"config_schedule_type": "",
+	"unordered": false,
+	"event_processors": []
+}`
+
+func TestRunTableTestStates(t *testing.T) {
+	mig.RunTableTest(t, &mig.TableTest{
+		ResourceType: "mongodbatlas_event_trigger",
+		Configs: map[string]mig.ConfigTest{
+			"skipped in unit tests": {Config: "someTerraformConfig"},
+		},
+		States: map[string]mig.StateTest{
+			"upgrade from flat config": {
+				RawState: eventTriggerStateV0,
+				Version:  0,
+				Attrs: map[string]string{
+					"project_id":                 "664619d870c247237f4b86a6",
+					"disabled":                   "true",
+					"database.database":          "sample_airbnb",
+					"database.collection":        "listingsAndReviews",
+					"database.operation_types.#": "2",
+					"database.operation_types.0": "INSERT",
+				},
+			},
+			"unsupported version": {
+				RawState:    eventTriggerStateV0,
+				Version:     5,
+				ExpectError: regexp.MustCompile("Unable to Upgrade Resource State"),
+			},
+			"move not supported": {
+				RawState:    eventTriggerStateV0,
+				SourceType:  "mongodbatlas_database_user",
+				ExpectError: regexp.MustCompile("Unable to Move Resource State"),
+			},
+		},
+	})
+}
+
+func TestUpgradeOrMoveStateUnknownResourceType(t *testing.T) {
+	_, err := mig.UpgradeOrMoveState(context.Background(), "mongodbatlas_unknown", &mig.StateTest{RawState: "{}"})
+	assert.ErrorContains(t, err, "resource type mongodbatlas_unknown not found in provider schema")
+}
@@ -0,0 +1,27 @@
+package replay
+
+import (
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+const projectIDVariable = "groupId"
+
+// ManageProjectID returns the project id from projectIDProvider unless in simulate mode,
+// where the project id used when the requests were captured is read from the mock data file of the test.
+func ManageProjectID(t *testing.T, projectIDProvider func(t testing.TB) string) string {
+	t.Helper()
+	if !IsInSimulateMode() {
+		return projectIDProvider(t)
+	}
+	data, err := unit.ParseTestDataConfigYAML(unit.MockConfigFilePath(t))
+	if err != nil {
+		t.Fatalf("Failed to read mock data file during simulate mode: %s", err)
+	}
+	projectID, ok := data.Variables[projectIDVariable]
+	if !ok {
+		t.Fatalf("Variable %s not found in mock data file %s", projectIDVariable, unit.MockConfigFilePath(t))
+	}
+	return projectID
+}
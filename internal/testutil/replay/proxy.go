@@ -0,0 +1,199 @@
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var errNoTransport = errors.New("replay proxy has no transport, use replay.ParallelTest or replay.Test to run the test case")
+
+// Proxy is an HTTP proxy terminating the TLS connections of the provider clients so requests can be captured or replayed with the http mocker data format.
+// Clients must skip the TLS verification of the proxy certificate, see ProxyPort in config.Config.
+type Proxy struct {
+	transport http.RoundTripper
+	listener  net.Listener
+	server    *http.Server
+	tlsConfig *tls.Config
+	mu        sync.RWMutex
+}
+
+// NewProxy starts a proxy listening in a random local port, it is closed when the test finishes.
+// Requests are sent with transport, that can be changed later with SetTransport.
+func NewProxy(t *testing.T, transport http.RoundTripper) *Proxy {
+	t.Helper()
+	cert, err := selfSignedCertificate()
+	if err != nil {
+		t.Fatalf("Failed to create replay proxy certificate: %s", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to start replay proxy: %s", err)
+	}
+	p := &Proxy{
+		transport: transport,
+		listener:  listener,
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{*cert}, MinVersion: tls.VersionTLS12},
+	}
+	p.server = &http.Server{Handler: p, ReadHeaderTimeout: time.Minute}
+	go func() {
+		if err := p.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.Logf("Replay proxy stopped: %s", err)
+		}
+	}()
+	t.Cleanup(func() { p.server.Close() })
+	return p
+}
+
+func (p *Proxy) Port() int {
+	return p.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (p *Proxy) SetTransport(transport http.RoundTripper) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.transport = transport
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect {
+		p.serveTunnel(w, r)
+		return
+	}
+	// plain http requests are received with the absolute url
+	writeResponse(w, p.roundTrip(r, r.URL.Scheme, r.URL.Host))
+}
+
+// serveTunnel answers the CONNECT request and reads the requests sent inside the TLS tunnel instead of forwarding the encrypted bytes.
+func (p *Proxy) serveTunnel(w http.ResponseWriter, r *http.Request) {
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "connection can't be hijacked", http.StatusInternalServerError)
+		return
+	}
+	conn, _, err := hijacker.Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer conn.Close()
+	if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+		return
+	}
+	tlsConn := tls.Server(conn, p.tlsConfig)
+	defer tlsConn.Close()
+	reader := bufio.NewReader(tlsConn)
+	for {
+		req, err := http.ReadRequest(reader)
+		if err != nil {
+			return // client closed the connection
+		}
+		resp := p.roundTrip(req, "https", r.Host)
+		err = writeTunnelResponse(tlsConn, resp)
+		req.Body.Close()
+		if err != nil || resp.Close || req.Close {
+			return
+		}
+	}
+}
+
+// writeTunnelResponse sets the length of the body so the connection can be reused, responses from mocked transports don't always have it.
+func writeTunnelResponse(w io.Writer, resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.ProtoMajor, resp.ProtoMinor = 1, 1
+	resp.TransferEncoding = nil
+	resp.ContentLength = int64(len(body))
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp.Write(w)
+}
+
+func (p *Proxy) roundTrip(req *http.Request, scheme, host string) *http.Response {
+	p.mu.RLock()
+	transport := p.transport
+	p.mu.RUnlock()
+	if transport == nil {
+		return errorResponse(req, errNoTransport)
+	}
+	outReq := req.Clone(req.Context())
+	outReq.RequestURI = ""
+	outReq.URL.Scheme = scheme
+	outReq.URL.Host = host
+	outReq.Host = host
+	outReq.Header.Del("Proxy-Connection")
+	outReq.Header.Del("Proxy-Authorization")
+	resp, err := transport.RoundTrip(outReq)
+	if err != nil {
+		return errorResponse(req, err)
+	}
+	return resp
+}
+
+func errorResponse(req *http.Request, err error) *http.Response {
+	body := fmt.Sprintf("replay proxy error for %s %s: %s", req.Method, req.URL.Path, err)
+	return &http.Response{
+		StatusCode:    http.StatusBadGateway,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"text/plain"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func writeResponse(w http.ResponseWriter, resp *http.Response) {
+	defer resp.Body.Close()
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// selfSignedCertificate is used for all hosts, the proxy only runs in tests and clients don't verify it.
+func selfSignedCertificate() (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{Organization: []string{"Terraform Provider MongoDB Atlas replay proxy"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
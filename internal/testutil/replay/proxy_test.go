@@ -0,0 +1,84 @@
+package replay_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/replay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newUpstream(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/vnd.atlas.2023-01-01+json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"method": %q, "path": %q, "body": %q}`, r.Method, r.URL.Path, body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func proxyClient(t *testing.T, port int) *http.Client {
+	t.Helper()
+	proxyURL, err := url.Parse(fmt.Sprintf("http://localhost:%d", port))
+	require.NoError(t, err)
+	return &http.Client{Transport: &http.Transport{
+		Proxy:           http.ProxyURL(proxyURL),
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // replay proxy uses a self-signed certificate
+	}}
+}
+
+func TestProxyForwardsRequestsInsideTunnel(t *testing.T) {
+	upstream := newUpstream(t)
+	proxy := replay.NewProxy(t, upstream.Client().Transport)
+	client := proxyClient(t, proxy.Port())
+	for range 2 { // same connection is reused
+		resp, err := client.Post(upstream.URL+"/api/atlas/v2/groups", "application/json", strings.NewReader(`{"name": "test"}`))
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.JSONEq(t, `{"method": "POST", "path": "/api/atlas/v2/groups", "body": "{\"name\": \"test\"}"}`, string(body))
+	}
+}
+
+func TestProxyWithoutTransport(t *testing.T) {
+	upstream := newUpstream(t)
+	proxy := replay.NewProxy(t, nil)
+	resp, err := proxyClient(t, proxy.Port()).Get(upstream.URL + "/api/atlas/v2/groups")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Contains(t, string(body), "replay proxy has no transport")
+}
+
+func TestProxyWithProviderClient(t *testing.T) {
+	upstream := newUpstream(t)
+	proxy := replay.NewProxy(t, upstream.Client().Transport)
+	port := proxy.Port()
+	cfg := config.Config{
+		PublicKey:  "publicKey",
+		PrivateKey: "privateKey",
+		BaseURL:    upstream.URL + "/",
+		ProxyPort:  &port,
+	}
+	client, err := cfg.NewClient(context.Background())
+	require.NoError(t, err)
+	_, resp, err := client.(*config.MongoDBClient).AtlasV2.ProjectsApi.GetProject(context.Background(), "664619d870c247237f4b86a6").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
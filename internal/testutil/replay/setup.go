@@ -0,0 +1,92 @@
+package replay
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+// proxies has the proxy started by SetupReplayProxy for each test.
+var proxies sync.Map
+
+func IsInCaptureMode() bool {
+	return os.Getenv("REPLAY_MODE") == "capture"
+}
+
+func IsInSimulateMode() bool {
+	return os.Getenv("REPLAY_MODE") == "simulate"
+}
+
+// SetupReplayProxy starts a proxy for the test and returns its port, or nil if no replay mode is configured.
+// The test case must be run with ParallelTest or Test so requests are captured or replayed step by step in the mock data file of the test,
+// the same format used by unit.CaptureOrMockTestCaseAndRun.
+func SetupReplayProxy(t *testing.T) *int {
+	t.Helper()
+	if !IsInCaptureMode() && !IsInSimulateMode() {
+		log.Printf("No replay mode was configured")
+		return nil
+	}
+	var transport http.RoundTripper
+	if IsInCaptureMode() {
+		// requests not belonging to a test case run with ParallelTest or Test are sent without being captured, e.g. migration tests
+		transport = upstreamTransport()
+	}
+	proxy := NewProxy(t, transport)
+	proxies.Store(t, proxy)
+	t.Cleanup(func() { proxies.Delete(t) })
+	port := proxy.Port()
+	return &port
+}
+
+// ParallelTest is like resource.ParallelTest but capturing or replaying the requests sent through the proxy of the test.
+func ParallelTest(t *testing.T, testCase resource.TestCase) { //nolint: gocritic // Same signature as resource.ParallelTest
+	t.Helper()
+	useProxyForTestCase(t, &testCase)
+	resource.ParallelTest(t, testCase)
+}
+
+// Test is like resource.Test but capturing or replaying the requests sent through the proxy of the test.
+func Test(t *testing.T, testCase resource.TestCase) { //nolint: gocritic // Same signature as resource.Test
+	t.Helper()
+	useProxyForTestCase(t, &testCase)
+	resource.Test(t, testCase)
+}
+
+func useProxyForTestCase(t *testing.T, testCase *resource.TestCase) {
+	t.Helper()
+	value, ok := proxies.Load(t)
+	if !ok {
+		return
+	}
+	proxy := value.(*Proxy)
+	config := unit.MockHTTPDataConfig{}
+	if IsInCaptureMode() {
+		upstream := upstreamTransport()
+		capture := unit.CaptureTransportForTestCase(t, config, testCase, upstream)
+		proxy.SetTransport(&skipDigestChallengeTransport{capture: capture, upstream: upstream})
+	} else {
+		proxy.SetTransport(unit.ReplayTransportForTestCase(t, config, testCase))
+	}
+}
+
+func upstreamTransport() http.RoundTripper {
+	return http.DefaultTransport.(*http.Transport).Clone()
+}
+
+// skipDigestChallengeTransport doesn't capture the requests without credentials sent by the digest transport to get the auth challenge.
+type skipDigestChallengeTransport struct {
+	capture  http.RoundTripper
+	upstream http.RoundTripper
+}
+
+func (s *skipDigestChallengeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") == "" {
+		return s.upstream.RoundTrip(req)
+	}
+	return s.capture.RoundTrip(req)
+}
@@ -137,6 +137,7 @@ func enableReplayForTestCase(t *testing.T, config *MockHTTPDataConfig, testCase
 	t.Helper()
 	tfConfigs := extractAndNormalizeConfig(t, testCase)
 	data := ReadMockData(t, tfConfigs)
+	ValidateMockDataWithSpec(t, data)
 	roundTripper, mockRoundTripper := NewMockRoundTripper(t, config, data)
 	httpClientModifier := mockClientModifier{config: config, mockRoundTripper: roundTripper}
 	testCase.ProtoV6ProviderFactories = TestAccProviderV6FactoriesWithMock(t, &httpClientModifier)
@@ -147,19 +148,9 @@ func enableReplayForTestCase(t *testing.T, config *MockHTTPDataConfig, testCase
 	}
 	require.Equal(t, len(testCase.Steps), len(data.Steps), "Number of steps in test case and mock data should match")
 	checkFunc := mockRoundTripper.CheckStepRequests
+	wrapSkipFuncs(t, testCase, tfConfigs, mockRoundTripper.IncreaseStepNumberAndInit)
 	for i := range testCase.Steps {
 		step := &testCase.Steps[i]
-		oldSkip := step.SkipFunc
-		step.SkipFunc = func() (bool, error) {
-			mockRoundTripper.IncreaseStepNumberAndInit()
-			logConfig(t, tfConfigs, i)
-			var shouldSkip bool
-			var err error
-			if oldSkip != nil {
-				shouldSkip, err = oldSkip()
-			}
-			return shouldSkip, err
-		}
 		if i == len(testCase.Steps)-1 {
 			// Last check done in checkDestroy to support checking DELETE calls
 			step.Check = wrapClientDuringCheck(step.Check, &httpClientModifier)
@@ -173,16 +164,61 @@ func enableReplayForTestCase(t *testing.T, config *MockHTTPDataConfig, testCase
 
 func enableCaptureForTestCase(t *testing.T, config *MockHTTPDataConfig, testCase *resource.TestCase) error {
 	t.Helper()
-	stepCount := len(testCase.Steps)
 	tfConfigs := extractAndNormalizeConfig(t, testCase)
-	capturedData := NewMockHTTPData(t, stepCount, tfConfigs)
+	capturedData := NewMockHTTPData(t, len(testCase.Steps), tfConfigs)
 	clientModifier := NewCaptureMockConfigClientModifier(t, config, capturedData)
 	testCase.ProtoV6ProviderFactories = TestAccProviderV6FactoriesWithMock(t, clientModifier)
-	for i := range stepCount {
+	wrapSkipFuncs(t, testCase, tfConfigs, clientModifier.IncreaseStepNumber)
+	for i := range testCase.Steps {
+		step := &testCase.Steps[i]
+		step.Check = wrapClientDuringCheck(step.Check, clientModifier)
+	}
+	writeCapturedDataOnCleanup(t, clientModifier)
+	testCase.CheckDestroy = wrapClientDuringCheck(testCase.CheckDestroy, clientModifier)
+	return nil
+}
+
+// CaptureTransportForTestCase returns a round tripper sending the requests with transport and capturing them in the mock data file of the test.
+// It is used when requests are not sent by the provider clients of the test case, e.g. when they go through a proxy.
+func CaptureTransportForTestCase(t *testing.T, config MockHTTPDataConfig, testCase *resource.TestCase, transport http.RoundTripper) http.RoundTripper { //nolint: gocritic // Want each test run to have its own config (hugeParam: config is heavy (112 bytes); consider passing it by pointer)
+	t.Helper()
+	tfConfigs := extractAndNormalizeConfig(t, testCase)
+	capturedData := NewMockHTTPData(t, len(testCase.Steps), tfConfigs)
+	clientModifier := NewCaptureMockConfigClientModifier(t, &config, capturedData)
+	clientModifier.oldTransport = transport
+	wrapSkipFuncs(t, testCase, tfConfigs, clientModifier.IncreaseStepNumber)
+	writeCapturedDataOnCleanup(t, clientModifier)
+	return clientModifier
+}
+
+// ReplayTransportForTestCase returns a round tripper responding with the mock data file of the test, requests of each step are checked at the end of the step.
+// It is used when requests are not sent by the provider clients of the test case, e.g. when they go through a proxy.
+func ReplayTransportForTestCase(t *testing.T, config MockHTTPDataConfig, testCase *resource.TestCase) http.RoundTripper { //nolint: gocritic // Want each test run to have its own config (hugeParam: config is heavy (112 bytes); consider passing it by pointer)
+	t.Helper()
+	tfConfigs := extractAndNormalizeConfig(t, testCase)
+	data := ReadMockData(t, tfConfigs)
+	ValidateMockDataWithSpec(t, data)
+	roundTripper, mockRoundTripper := NewMockRoundTripper(t, &config, data)
+	require.Equal(t, len(testCase.Steps), len(data.Steps), "Number of steps in test case and mock data should match")
+	checkFunc := mockRoundTripper.CheckStepRequests
+	wrapSkipFuncs(t, testCase, tfConfigs, mockRoundTripper.IncreaseStepNumberAndInit)
+	for i := range len(testCase.Steps) - 1 {
+		// Last check done in checkDestroy to support checking DELETE calls
+		step := &testCase.Steps[i]
+		step.Check = composeChecks(step.Check, checkFunc)
+	}
+	testCase.CheckDestroy = composeChecks(testCase.CheckDestroy, checkFunc)
+	return roundTripper
+}
+
+// wrapSkipFuncs calls increaseStepNumber at the start of every step, SkipFunc is called for all steps including import steps.
+func wrapSkipFuncs(t *testing.T, testCase *resource.TestCase, tfConfigs []string, increaseStepNumber func()) {
+	t.Helper()
+	for i := range testCase.Steps {
 		step := &testCase.Steps[i]
 		oldSkip := step.SkipFunc
 		step.SkipFunc = func() (bool, error) {
-			clientModifier.IncreaseStepNumber()
+			increaseStepNumber()
 			logConfig(t, tfConfigs, i)
 			var shouldSkip bool
 			var err error
@@ -191,9 +227,11 @@ func enableCaptureForTestCase(t *testing.T, config *MockHTTPDataConfig, testCase
 			}
 			return shouldSkip, err
 		}
-		step.Check = wrapClientDuringCheck(step.Check, clientModifier)
 	}
+}
 
+func writeCapturedDataOnCleanup(t *testing.T, clientModifier *CaptureMockConfigClientModifier) {
+	t.Helper()
 	writeCapturedData := func() {
 		clientModifier.NormalizeCapturedData()
 		filePath := MockConfigFilePath(t)
@@ -204,8 +242,13 @@ func enableCaptureForTestCase(t *testing.T, config *MockHTTPDataConfig, testCase
 		require.NoError(t, err)
 	}
 	t.Cleanup(writeCapturedData)
-	testCase.CheckDestroy = wrapClientDuringCheck(testCase.CheckDestroy, clientModifier)
-	return nil
+}
+
+func composeChecks(oldCheck resource.TestCheckFunc, extraCheck resource.TestCheckFunc) resource.TestCheckFunc {
+	if oldCheck == nil {
+		return extraCheck
+	}
+	return resource.ComposeTestCheckFunc(oldCheck, extraCheck)
 }
 
 func logConfig(t *testing.T, tfConfigs []string, i int) {
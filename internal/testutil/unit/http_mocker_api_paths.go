@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/openapi"
 )
 
 type OpenapiSchema struct {
@@ -47,12 +49,30 @@ func parseAPISpecPaths(apiSpecPath string) (map[string][]APISpecPath, error) {
 	return paths, nil
 }
 
-// copied from tools/codegen/openapi/parser.go
 const (
-	atlasAdminAPISpecURL = "https://raw.githubusercontent.com/mongodb/atlas-sdk-go/main/openapi/atlas-api-transformed.yaml"
-	specFileRelPath      = "tools/codegen/open-api-spec.yml"
+	goModRelPath          = "go.mod"
+	specFileRelPathFormat = "tools/codegen/open-api-spec-%s.yml"
 )
 
+// sdkSpecVersion returns the atlas-sdk-go release tag of the spec of the SDK used by the provider, the file name includes it so a spec of another version is not reused.
+func sdkSpecVersion() string {
+	version, err := openapi.SDKVersionFromGoMod(fullPath(goModRelPath), openapi.SDKModulePath)
+	if err != nil {
+		panic(fmt.Sprintf("error reading SDK version from go.mod: %s", err))
+	}
+	return version
+}
+
+// specFilePathForVersion downloads the spec of the atlas-sdk-go release tag if it's not found.
+func specFilePathForVersion(version string) (string, error) {
+	specPath := fullPath(fmt.Sprintf(specFileRelPathFormat, version))
+	if fileExist(specPath) {
+		return specPath, nil
+	}
+	return specPath, DownloadOpenAPISpec(openapi.SpecURL(version), specPath)
+}
+
+// copied from tools/codegen/openapi/parser.go
 func DownloadOpenAPISpec(url, specFilePath string) (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
@@ -119,13 +139,9 @@ func init() {
 }
 
 func InitializeAPISpecPaths() {
-	specPath := fullPath(specFileRelPath)
-	var err error
-	if !fileExist(specPath) {
-		err = DownloadOpenAPISpec(atlasAdminAPISpecURL, specPath)
-		if err != nil {
-			panic(fmt.Sprintf("error downloading OpenAPI spec: %s", err))
-		}
+	specPath, err := specFilePathForVersion(sdkSpecVersion())
+	if err != nil {
+		panic(fmt.Sprintf("error downloading OpenAPI spec: %s", err))
 	}
 	apiSpecPaths, err = parseAPISpecPaths(specPath)
 	if err != nil {
@@ -0,0 +1,424 @@
+package unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/openapi"
+)
+
+const versionedMediaTypeFormat = "application/vnd.atlas.%s+json"
+
+// SpecValidator checks that the recorded requests and responses of the mock data follow the Atlas Admin API contract.
+// It allows finding stale recordings hiding a real incompatibility, e.g. after an SDK version bump.
+type SpecValidator struct {
+	doc   *v3.Document
+	paths map[string][]APISpecPath
+	// previous are the validators of the specs of older SDK modules, used for the versions no longer in the spec.
+	previous []*SpecValidator
+}
+
+// NewSpecValidator uses the spec of the newest SDK module and the specs of the older SDK modules still used by the provider, newest first.
+func NewSpecValidator(apiSpecPath string, previousSpecPaths ...string) (*SpecValidator, error) {
+	validator, err := newSpecValidator(apiSpecPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, specPath := range previousSpecPaths {
+		previous, err := newSpecValidator(specPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", specPath, err)
+		}
+		validator.previous = append(validator.previous, previous)
+	}
+	return validator, nil
+}
+
+func newSpecValidator(apiSpecPath string) (*SpecValidator, error) {
+	data, err := os.ReadFile(apiSpecPath)
+	if err != nil {
+		return nil, err
+	}
+	document, err := libopenapi.NewDocument(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create document from OpenAPI spec: %w", err)
+	}
+	model, errs := document.BuildV3Model()
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("cannot build OpenAPI model: %d errors reported, first: %w", len(errs), errs[0])
+	}
+	paths, err := parseAPISpecPaths(apiSpecPath)
+	if err != nil {
+		return nil, err
+	}
+	return &SpecValidator{doc: &model.Model, paths: paths}, nil
+}
+
+// SpecFilePath is the path of the OpenAPI spec of the SDK version in go.mod downloaded by InitializeAPISpecPaths.
+func SpecFilePath() string {
+	return fullPath(fmt.Sprintf(specFileRelPathFormat, sdkSpecVersion()))
+}
+
+// PreviousSpecFilePaths returns the paths of the OpenAPI specs of the older SDK modules in go.mod, newest first, they are downloaded if not found.
+func PreviousSpecFilePaths() ([]string, error) {
+	versions, err := openapi.SDKVersionsFromGoMod(fullPath(goModRelPath))
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, version := range versions {
+		if version == sdkSpecVersion() {
+			continue
+		}
+		specPath, err := specFilePathForVersion(version)
+		if err != nil {
+			return nil, fmt.Errorf("error downloading OpenAPI spec %s: %w", version, err)
+		}
+		paths = append(paths, specPath)
+	}
+	return paths, nil
+}
+
+var (
+	specValidator     *SpecValidator
+	specValidatorErr  error
+	specValidatorOnce sync.Once
+)
+
+// ValidateMockDataWithSpec fails the test if the mock data doesn't follow the OpenAPI spec used by the http mocker.
+func ValidateMockDataWithSpec(t *testing.T, data *MockHTTPData) {
+	t.Helper()
+	specValidatorOnce.Do(func() {
+		var previousSpecPaths []string
+		if previousSpecPaths, specValidatorErr = PreviousSpecFilePaths(); specValidatorErr == nil {
+			specValidator, specValidatorErr = NewSpecValidator(SpecFilePath(), previousSpecPaths...)
+		}
+	})
+	require.NoError(t, specValidatorErr)
+	errs := specValidator.Validate(data)
+	for _, err := range errs {
+		t.Error(err)
+	}
+	require.Empty(t, errs, "mock data in %s doesn't match the OpenAPI spec, re-capture it with %s=true", MockConfigFilePath(t), EnvNameHTTPMockerCapture)
+}
+
+// Validate returns an error for every recorded request or response not matching the path, method, version or JSON schema of an operation.
+func (v *SpecValidator) Validate(data *MockHTTPData) []error {
+	var errs []error
+	for i := range data.Steps {
+		for j := range data.Steps[i].RequestResponses {
+			request := &data.Steps[i].RequestResponses[j]
+			for _, err := range v.ValidateRequest(request) {
+				errs = append(errs, fmt.Errorf("step %d: %s %s (%s): %w", i+1, request.Method, request.Path, request.Version, err))
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateRequest validates the request with the spec supporting its version, a version older than the ones in the spec
+// uses the spec of the newest older SDK module supporting it, as the provider still uses these modules for some operations.
+func (v *SpecValidator) ValidateRequest(request *RequestInfo) []error {
+	specPath, operation, found := v.findOperation(request)
+	if !found {
+		return []error{fmt.Errorf("path not found in OpenAPI spec")}
+	}
+	versions := operationVersions(operation)
+	if len(versions) > 0 && !slices.Contains(versions, request.Version) {
+		if request.Version < versions[len(versions)-1] {
+			for _, previous := range v.previous {
+				if _, previousOperation, found := previous.findOperation(request); found && slices.Contains(operationVersions(previousOperation), request.Version) {
+					return validateOperation(previousOperation, request)
+				}
+			}
+		}
+		return []error{fmt.Errorf("version not found for %s in the specs of the SDK modules in go.mod, supported versions: %v", specPath, versions)}
+	}
+	return validateOperation(operation, request)
+}
+
+// findOperation returns the operation of the spec path matching the request, spec paths are found by method so the path always has the operation.
+func (v *SpecValidator) findOperation(request *RequestInfo) (specPath string, operation *v3.Operation, found bool) {
+	specPath, found = v.findSpecPath(request.Method, removeQueryParamsAndTrim(request.Path))
+	if !found {
+		return "", nil, false
+	}
+	pathItem, _ := v.doc.Paths.PathItems.Get(specPath)
+	return specPath, pathItem.GetOperations().GetOrZero(strings.ToLower(request.Method)), true
+}
+
+func validateOperation(operation *v3.Operation, request *RequestInfo) []error {
+	mediaType := fmt.Sprintf(versionedMediaTypeFormat, request.Version)
+	var errs []error
+	if request.Text != "" && operation.RequestBody != nil && operation.RequestBody.Content != nil {
+		if content, ok := operation.RequestBody.Content.Get(mediaType); ok {
+			errs = append(errs, validateJSON("request", request.Text, content.Schema, true)...)
+		}
+	}
+	for _, response := range request.Responses {
+		errs = append(errs, validateResponse(operation, mediaType, &response)...)
+	}
+	return errs
+}
+
+// findSpecPath returns the spec path matching the path with most literal segments, e.g. /clusters/tenantUpgrade is preferred to /clusters/{clusterName}.
+func (v *SpecValidator) findSpecPath(method, path string) (string, bool) {
+	bestPath, bestScore := "", -1
+	for _, candidate := range v.paths[method] {
+		if !candidate.Match(path) {
+			continue
+		}
+		score := strings.Count(candidate.Path, "/") - strings.Count(candidate.Path, "{")
+		if score > bestScore {
+			bestPath, bestScore = candidate.Path, score
+		}
+	}
+	return bestPath, bestScore >= 0
+}
+
+func operationVersions(operation *v3.Operation) []string {
+	var mediaTypes []*orderedmap.Map[string, *v3.MediaType]
+	if operation.RequestBody != nil {
+		mediaTypes = append(mediaTypes, operation.RequestBody.Content)
+	}
+	if operation.Responses != nil {
+		for _, response := range operation.Responses.Codes.FromOldest() {
+			mediaTypes = append(mediaTypes, response.Content)
+		}
+	}
+	var versions []string
+	for _, content := range mediaTypes {
+		for mediaType := range content.KeysFromOldest() {
+			version, err := ExtractVersion(mediaType)
+			if err == nil && !slices.Contains(versions, version) {
+				versions = append(versions, version)
+			}
+		}
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+// validateResponse uses the schema of the recorded status, a success status not defined in the spec (e.g. 201 instead of 200) uses the schema of the defined success status.
+func validateResponse(operation *v3.Operation, mediaType string, response *statusText) []error {
+	if response.Text == "" || operation.Responses == nil || operation.Responses.Codes == nil {
+		return nil
+	}
+	specResponse, ok := operation.Responses.Codes.Get(strconv.Itoa(response.Status))
+	if !ok && response.Status >= 200 && response.Status < 300 {
+		for code, candidate := range operation.Responses.Codes.FromOldest() {
+			if strings.HasPrefix(code, "2") {
+				specResponse, ok = candidate, true
+				break
+			}
+		}
+	}
+	if !ok || specResponse.Content == nil {
+		return nil
+	}
+	content, ok := specResponse.Content.Get(mediaType)
+	if !ok {
+		content, ok = specResponse.Content.Get("application/json")
+	}
+	if !ok {
+		return nil
+	}
+	return validateJSON(fmt.Sprintf("response %d (index %d)", response.Status, response.ResponseIndex), response.Text, content.Schema, false)
+}
+
+func validateJSON(name, text string, proxy *base.SchemaProxy, isRequest bool) []error {
+	if proxy == nil {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal([]byte(text), &value); err != nil {
+		return []error{fmt.Errorf("%s is not valid JSON: %w", name, err)}
+	}
+	validator := schemaValidator{reportUnknownProperties: isRequest}
+	var errs []error
+	for _, msg := range validator.validate(proxy.Schema(), value, "$") {
+		errs = append(errs, fmt.Errorf("%s: %s", name, msg))
+	}
+	return errs
+}
+
+// schemaValidator reports unknown properties only in requests: a request property unknown by the spec will be rejected or ignored by Atlas,
+// while responses can have properties not in the spec, e.g. the ones of the oneOf schemas flattened by the SDK transformation.
+type schemaValidator struct {
+	reportUnknownProperties bool
+}
+
+// validate returns the mismatches of a JSON value with a schema.
+func (v schemaValidator) validate(schema *base.Schema, value any, path string) []string {
+	if schema == nil || value == nil {
+		// Atlas returns null for unset values even if the schema is not nullable, the SDK handles them as missing values
+		return nil
+	}
+	if alternatives := append(slices.Clone(schema.OneOf), schema.AnyOf...); len(alternatives) > 0 {
+		return v.validateAlternatives(schema, alternatives, value, path)
+	}
+	if typeErr := validateType(schema, value, path); typeErr != "" {
+		return []string{typeErr}
+	}
+	var errs []string
+	if len(schema.Enum) > 0 && !enumContains(schema.Enum, value) {
+		errs = append(errs, fmt.Sprintf("%s: value %v not in enum", path, value))
+	}
+	switch typed := value.(type) {
+	case map[string]any:
+		errs = append(errs, v.validateObject(schema, typed, path)...)
+	case []any:
+		if schema.Items != nil && schema.Items.IsA() {
+			for i, item := range typed {
+				errs = append(errs, v.validate(schema.Items.A.Schema(), item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+	return errs
+}
+
+func (v schemaValidator) validateObject(schema *base.Schema, value map[string]any, path string) []string {
+	properties, required, additional := objectProperties(schema)
+	var errs []string
+	for _, name := range required {
+		if _, ok := value[name]; !ok {
+			errs = append(errs, fmt.Sprintf("%s: missing required property %s", path, name))
+		}
+	}
+	for _, name := range sortedMapKeys(value) {
+		propertyPath := path + "." + name
+		if property, ok := properties[name]; ok {
+			errs = append(errs, v.validate(property, value[name], propertyPath)...)
+			continue
+		}
+		switch {
+		case additional != nil && additional.IsA():
+			errs = append(errs, v.validate(additional.A.Schema(), value[name], propertyPath)...)
+		case additional != nil && additional.B:
+		case v.reportUnknownProperties && len(properties) > 0:
+			errs = append(errs, fmt.Sprintf("%s: unknown property", propertyPath))
+		}
+	}
+	return errs
+}
+
+// validateAlternatives uses the discriminator mapping if defined, otherwise the value must match at least one of the oneOf or anyOf schemas.
+func (v schemaValidator) validateAlternatives(schema *base.Schema, alternatives []*base.SchemaProxy, value any, path string) []string {
+	if object, ok := value.(map[string]any); ok && schema.Discriminator != nil && schema.Discriminator.Mapping != nil {
+		discriminatorValue, _ := object[schema.Discriminator.PropertyName].(string)
+		if ref, ok := schema.Discriminator.Mapping.Get(discriminatorValue); ok {
+			for _, alternative := range alternatives {
+				if alternative.GetReference() == ref {
+					return v.validate(alternative.Schema(), value, path)
+				}
+			}
+		}
+	}
+	var firstErrs []string
+	for i, alternative := range alternatives {
+		errs := v.validate(alternative.Schema(), value, path)
+		if len(errs) == 0 {
+			return nil
+		}
+		if i == 0 {
+			firstErrs = errs
+		}
+	}
+	return append([]string{fmt.Sprintf("%s: value doesn't match any of the %d oneOf/anyOf schemas, errors for the first one:", path, len(alternatives))}, firstErrs...)
+}
+
+// objectProperties merges the properties of the schema and its allOf schemas.
+func objectProperties(schema *base.Schema) (properties map[string]*base.Schema, required []string, additional *base.DynamicValue[*base.SchemaProxy, bool]) {
+	properties = make(map[string]*base.Schema)
+	required = slices.Clone(schema.Required)
+	additional = schema.AdditionalProperties
+	for name, property := range schema.Properties.FromOldest() {
+		properties[name] = property.Schema()
+	}
+	for _, proxy := range schema.AllOf {
+		allOfProperties, allOfRequired, allOfAdditional := objectProperties(proxy.Schema())
+		for name, property := range allOfProperties {
+			if _, ok := properties[name]; !ok {
+				properties[name] = property
+			}
+		}
+		required = append(required, allOfRequired...)
+		if additional == nil {
+			additional = allOfAdditional
+		}
+	}
+	return properties, required, additional
+}
+
+func validateType(schema *base.Schema, value any, path string) string {
+	types := schema.Type
+	if len(types) == 0 {
+		if schema.Properties == nil && len(schema.AllOf) == 0 {
+			return ""
+		}
+		types = []string{"object"}
+	}
+	for _, expected := range types {
+		if valueHasType(value, expected) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("%s: expected type %v, got %T", path, types, value)
+}
+
+func valueHasType(value any, expected string) bool {
+	switch expected {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	}
+	return false
+}
+
+func enumContains(enum []*yaml.Node, value any) bool {
+	text := fmt.Sprint(value)
+	for _, node := range enum {
+		if node != nil && node.Value == text {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedMapKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,145 @@
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const clusterPath = "/api/atlas/v2/groups/6746ceed6f62fc3c122a3e0e/clusters/test-acc-tf-c-7871793563057636102"
+
+func TestSpecValidatorValidateRequest(t *testing.T) {
+	previousSpecPaths, err := unit.PreviousSpecFilePaths()
+	require.NoError(t, err)
+	validator, err := unit.NewSpecValidator(unit.SpecFilePath(), previousSpecPaths...)
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		request     string
+		errContains string
+	}{
+		"valid response": {
+			request: `
+path: ` + clusterPath + `
+method: GET
+version: '2024-08-05'
+responses:
+  - status: 200
+    text: '{"name": "test-acc-tf-c-7871793563057636102", "paused": false, "replicationSpecs": [{"zoneName": "Zone 1"}]}'
+`,
+		},
+		"null values are accepted": {
+			request: `
+path: ` + clusterPath + `
+method: GET
+version: '2024-08-05'
+responses:
+  - status: 200
+    text: '{"name": null, "paused": null}'
+`,
+		},
+		"older version uses the spec of the older SDK module": {
+			request: `
+path: ` + clusterPath + `
+method: GET
+version: '2023-02-01'
+responses:
+  - status: 200
+    text: '{"name": "test-acc-tf-c-7871793563057636102", "replicationSpecs": [{"numShards": 1}]}'
+`,
+		},
+		"older version invalid response": {
+			request: `
+path: ` + clusterPath + `
+method: GET
+version: '2023-02-01'
+responses:
+  - status: 200
+    text: '{"replicationSpecs": [{"numShards": "1"}]}'
+`,
+			errContains: "$.replicationSpecs[0].numShards: expected type [integer]",
+		},
+		"older version not found": {
+			request: `
+path: ` + clusterPath + `
+method: GET
+version: '2022-01-01'
+`,
+			errContains: "version not found for /api/atlas/v2/groups/{groupId}/clusters/{clusterName} in the specs of the SDK modules in go.mod",
+		},
+		"undefined success status uses the defined success response": {
+			request: `
+path: ` + clusterPath + `
+method: PATCH
+version: '2024-10-23'
+text: '{"paused": true}'
+responses:
+  - status: 201
+    text: '{"paused": "yes"}'
+`,
+			errContains: "response 201 (index 0): $.paused: expected type [boolean]",
+		},
+		"path not found": {
+			request: `
+path: /api/atlas/v2/groups/6746ceed6f62fc3c122a3e0e/unknownResource
+method: GET
+version: '2024-08-05'
+`,
+			errContains: "path not found in OpenAPI spec",
+		},
+		"path not found for the method": {
+			request: `
+path: ` + clusterPath + `
+method: POST
+version: '2024-08-05'
+`,
+			errContains: "path not found in OpenAPI spec",
+		},
+		"newer version not found": {
+			request: `
+path: ` + clusterPath + `
+method: GET
+version: '2099-01-01'
+`,
+			errContains: "version not found for /api/atlas/v2/groups/{groupId}/clusters/{clusterName}",
+		},
+		"invalid response type": {
+			request: `
+path: ` + clusterPath + `
+method: GET
+version: '2024-08-05'
+responses:
+  - status: 200
+    text: '{"paused": "yes"}'
+`,
+			errContains: "$.paused: expected type [boolean]",
+		},
+		"unknown request property": {
+			request: `
+path: ` + clusterPath + `
+method: PATCH
+version: '2024-10-23'
+text: '{"paused": true, "unknownAttribute": 1}'
+responses:
+  - status: 200
+    text: '{"paused": true}'
+`,
+			errContains: "request: $.unknownAttribute: unknown property",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var request unit.RequestInfo
+			require.NoError(t, yaml.Unmarshal([]byte(tc.request), &request))
+			errs := validator.ValidateRequest(&request)
+			if tc.errContains == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, 1)
+			assert.Contains(t, errs[0].Error(), tc.errContains)
+		})
+	}
+}
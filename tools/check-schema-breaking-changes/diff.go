@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	kindProvider   = "provider"
+	kindResource   = "resource"
+	kindDataSource = "data-source"
+	// advancedClusterV2Suffix is added to the names of the resources and data sources of the advanced cluster v2 schema as they have the same names as the default ones.
+	advancedClusterV2Suffix = " (advanced cluster v2 schema)"
+)
+
+type Change struct {
+	Kind     string
+	Name     string
+	Message  string
+	Breaking bool
+}
+
+func (c Change) String() string {
+	if c.Kind == kindProvider {
+		return fmt.Sprintf("%s: %s", c.Kind, c.Message)
+	}
+	return fmt.Sprintf("%s/%s: %s", c.Kind, c.Name, c.Message)
+}
+
+// Diff returns the changes from the baseline to the current schema sorted by kind and name, breaking changes are:
+// removed resources, data sources or attributes, type changes, optional to required, new required attributes,
+// configurable to computed only, removed computed, sensitivity changes and new ForceNew or RequiresReplace.
+// Changes of the advanced cluster v2 schema follow, their names have the advancedClusterV2Suffix.
+func Diff(baseline, current *ProviderSchema) []Change {
+	changes := diffSchema(kindProvider, "", baseline.Provider, current.Provider)
+	changes = append(changes, diffSchemas(kindResource, baseline.Resources, current.Resources)...)
+	changes = append(changes, diffSchemas(kindDataSource, baseline.DataSources, current.DataSources)...)
+	if baseline.AdvancedClusterV2 != nil || current.AdvancedClusterV2 != nil {
+		v2Changes := Diff(orEmpty(baseline.AdvancedClusterV2), orEmpty(current.AdvancedClusterV2))
+		for i := range v2Changes {
+			v2Changes[i].Name += advancedClusterV2Suffix
+		}
+		changes = append(changes, v2Changes...)
+	}
+	return changes
+}
+
+func orEmpty(s *ProviderSchema) *ProviderSchema {
+	if s == nil {
+		return &ProviderSchema{}
+	}
+	return s
+}
+
+func BreakingChanges(changes []Change) []Change {
+	var result []Change
+	for _, change := range changes {
+		if change.Breaking {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+func diffSchemas(kind string, baseline, current map[string]Schema) []Change {
+	var changes []Change
+	for _, name := range sortedKeys(baseline, current) {
+		oldSchema, inBaseline := baseline[name]
+		newSchema, inCurrent := current[name]
+		switch {
+		case !inCurrent:
+			changes = append(changes, Change{Kind: kind, Name: name, Message: "removed", Breaking: true})
+		case !inBaseline:
+			changes = append(changes, Change{Kind: kind, Name: name, Message: "added"})
+		default:
+			changes = append(changes, diffSchema(kind, name, oldSchema, newSchema)...)
+		}
+	}
+	return changes
+}
+
+func diffSchema(kind, name string, baseline, current Schema) []Change {
+	var changes []Change
+	add := func(breaking bool, format string, args ...any) {
+		changes = append(changes, Change{Kind: kind, Name: name, Message: fmt.Sprintf(format, args...), Breaking: breaking})
+	}
+	for _, path := range sortedKeys(baseline, current) {
+		oldAttr, inBaseline := baseline[path]
+		newAttr, inCurrent := current[path]
+		switch {
+		case !inCurrent:
+			if _, parentInCurrent := current[parentPath(path)]; parentInCurrent || parentPath(path) == "" {
+				add(true, "attribute %s removed", path)
+			}
+		case !inBaseline:
+			if _, parentInBaseline := baseline[parentPath(path)]; parentInBaseline || parentPath(path) == "" {
+				add(newAttr.Required, "attribute %s added%s", path, requiredSuffix(newAttr))
+			}
+		default:
+			diffAttribute(path, oldAttr, newAttr, add)
+		}
+	}
+	return changes
+}
+
+func diffAttribute(path string, oldAttr, newAttr Attribute, add func(breaking bool, format string, args ...any)) {
+	if oldAttr.Type != newAttr.Type {
+		add(true, "attribute %s type changed from %s to %s", path, oldAttr.Type, newAttr.Type)
+	}
+	if !oldAttr.Required && newAttr.Required {
+		add(true, "attribute %s changed from optional to required", path)
+	}
+	if oldAttr.Required && newAttr.Optional {
+		add(false, "attribute %s changed from required to optional", path)
+	}
+	if (oldAttr.Optional || oldAttr.Required) && !newAttr.Optional && !newAttr.Required {
+		add(true, "attribute %s is no longer configurable", path)
+	}
+	if oldAttr.Computed && !newAttr.Computed {
+		add(true, "attribute %s is no longer computed", path)
+	}
+	if !oldAttr.Computed && newAttr.Computed {
+		add(false, "attribute %s is now computed", path)
+	}
+	if !oldAttr.Optional && !oldAttr.Required && (newAttr.Optional || newAttr.Required) {
+		add(false, "attribute %s is now configurable", path)
+	}
+	if oldAttr.Sensitive != newAttr.Sensitive {
+		add(true, "attribute %s sensitive changed from %t to %t", path, oldAttr.Sensitive, newAttr.Sensitive)
+	}
+	if !oldAttr.RequiresReplace && newAttr.RequiresReplace {
+		add(true, "attribute %s now requires replacement (ForceNew or RequiresReplace)", path)
+	}
+	if oldAttr.RequiresReplace && !newAttr.RequiresReplace {
+		add(false, "attribute %s no longer requires replacement", path)
+	}
+}
+
+func requiredSuffix(attr Attribute) string {
+	if attr.Required {
+		return " as required"
+	}
+	return ""
+}
+
+func parentPath(path string) string {
+	index := strings.LastIndex(path, pathSeparator)
+	if index < 0 {
+		return ""
+	}
+	return path[:index]
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	keys := map[string]bool{}
+	for _, m := range maps {
+		for key := range m {
+			keys[key] = true
+		}
+	}
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
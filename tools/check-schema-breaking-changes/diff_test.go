@@ -0,0 +1,146 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	stringType := "tftypes.String"
+	testCases := map[string]struct {
+		baseline         Schema
+		current          Schema
+		expectedMessages []string
+		expectedBreaking []bool
+	}{
+		"no changes": {
+			baseline: Schema{"name": {Type: stringType, Required: true}},
+			current:  Schema{"name": {Type: stringType, Required: true}},
+		},
+		"attribute removed": {
+			baseline:         Schema{"name": {Type: stringType, Required: true}, "tags": {Type: "block_list", Optional: true}, "tags.key": {Type: stringType, Required: true}},
+			current:          Schema{"name": {Type: stringType, Required: true}},
+			expectedMessages: []string{"attribute tags removed"},
+			expectedBreaking: []bool{true},
+		},
+		"attributes added": {
+			baseline:         Schema{"name": {Type: stringType, Required: true}},
+			current:          Schema{"name": {Type: stringType, Required: true}, "comment": {Type: stringType, Optional: true}, "org_id": {Type: stringType, Required: true}},
+			expectedMessages: []string{"attribute comment added", "attribute org_id added as required"},
+			expectedBreaking: []bool{false, true},
+		},
+		"type changed from block to nested attribute": {
+			baseline:         Schema{"specs": {Type: "block_list", Optional: true}, "specs.zone": {Type: stringType, Optional: true}},
+			current:          Schema{"specs": {Type: "nested_list", Optional: true}, "specs.zone": {Type: stringType, Optional: true}},
+			expectedMessages: []string{"attribute specs type changed from block_list to nested_list"},
+			expectedBreaking: []bool{true},
+		},
+		"optional to required": {
+			baseline:         Schema{"name": {Type: stringType, Optional: true}},
+			current:          Schema{"name": {Type: stringType, Required: true}},
+			expectedMessages: []string{"attribute name changed from optional to required"},
+			expectedBreaking: []bool{true},
+		},
+		"required to optional and computed": {
+			baseline:         Schema{"name": {Type: stringType, Required: true}},
+			current:          Schema{"name": {Type: stringType, Optional: true, Computed: true}},
+			expectedMessages: []string{"attribute name changed from required to optional", "attribute name is now computed"},
+			expectedBreaking: []bool{false, false},
+		},
+		"optional to computed only": {
+			baseline:         Schema{"mongo_db_version": {Type: stringType, Optional: true, Computed: true}, "paused": {Type: "tftypes.Bool", Optional: true}},
+			current:          Schema{"mongo_db_version": {Type: stringType, Computed: true}, "paused": {Type: "tftypes.Bool", Computed: true}},
+			expectedMessages: []string{"attribute mongo_db_version is no longer configurable", "attribute paused is no longer configurable", "attribute paused is now computed"},
+			expectedBreaking: []bool{true, true, false},
+		},
+		"computed only to optional": {
+			baseline:         Schema{"mongo_db_version": {Type: stringType, Computed: true}},
+			current:          Schema{"mongo_db_version": {Type: stringType, Optional: true, Computed: true}},
+			expectedMessages: []string{"attribute mongo_db_version is now configurable"},
+			expectedBreaking: []bool{false},
+		},
+		"computed removed": {
+			baseline:         Schema{"disk_size_gb": {Type: "tftypes.Number", Optional: true, Computed: true}},
+			current:          Schema{"disk_size_gb": {Type: "tftypes.Number", Optional: true}},
+			expectedMessages: []string{"attribute disk_size_gb is no longer computed"},
+			expectedBreaking: []bool{true},
+		},
+		"sensitive changed": {
+			baseline:         Schema{"password": {Type: stringType, Optional: true, Sensitive: true}},
+			current:          Schema{"password": {Type: stringType, Optional: true}},
+			expectedMessages: []string{"attribute password sensitive changed from true to false"},
+			expectedBreaking: []bool{true},
+		},
+		"requires replace added": {
+			baseline:         Schema{"project_id": {Type: stringType, Required: true}},
+			current:          Schema{"project_id": {Type: stringType, Required: true, RequiresReplace: true}},
+			expectedMessages: []string{"attribute project_id now requires replacement (ForceNew or RequiresReplace)"},
+			expectedBreaking: []bool{true},
+		},
+		"requires replace removed": {
+			baseline:         Schema{"project_id": {Type: stringType, Required: true, RequiresReplace: true}},
+			current:          Schema{"project_id": {Type: stringType, Required: true}},
+			expectedMessages: []string{"attribute project_id no longer requires replacement"},
+			expectedBreaking: []bool{false},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			baseline := &ProviderSchema{Resources: map[string]Schema{"mongodbatlas_example": tc.baseline}}
+			current := &ProviderSchema{Resources: map[string]Schema{"mongodbatlas_example": tc.current}}
+			changes := Diff(baseline, current)
+			var messages []string
+			var breaking []bool
+			for _, change := range changes {
+				assert.Equal(t, kindResource, change.Kind)
+				assert.Equal(t, "mongodbatlas_example", change.Name)
+				messages = append(messages, change.Message)
+				breaking = append(breaking, change.Breaking)
+			}
+			assert.Equal(t, tc.expectedMessages, messages)
+			assert.Equal(t, tc.expectedBreaking, breaking)
+		})
+	}
+}
+
+func TestDiffResourcesAndDataSources(t *testing.T) {
+	schema := Schema{"id": {Type: "tftypes.String", Computed: true}}
+	baseline := &ProviderSchema{
+		Resources:   map[string]Schema{"mongodbatlas_removed": schema},
+		DataSources: map[string]Schema{"mongodbatlas_existing": schema},
+	}
+	current := &ProviderSchema{
+		Resources:   map[string]Schema{"mongodbatlas_added": schema},
+		DataSources: map[string]Schema{"mongodbatlas_existing": schema},
+	}
+	changes := Diff(baseline, current)
+	assert.Equal(t, []Change{
+		{Kind: kindResource, Name: "mongodbatlas_added", Message: "added"},
+		{Kind: kindResource, Name: "mongodbatlas_removed", Message: "removed", Breaking: true},
+	}, changes)
+	assert.Equal(t, changes[1:], BreakingChanges(changes))
+	assert.Equal(t, "resource/mongodbatlas_removed: removed", changes[1].String())
+}
+
+func TestDiffAdvancedClusterV2(t *testing.T) {
+	schema := Schema{"name": {Type: "tftypes.String", Required: true}}
+	v2Schema := Schema{"name": {Type: "tftypes.String", Required: true}, "replication_specs": {Type: "nested_list", Required: true}}
+	baseline := &ProviderSchema{
+		Resources:         map[string]Schema{"mongodbatlas_advanced_cluster": schema},
+		AdvancedClusterV2: &ProviderSchema{Resources: map[string]Schema{"mongodbatlas_advanced_cluster": v2Schema}},
+	}
+	current := &ProviderSchema{
+		Resources:         map[string]Schema{"mongodbatlas_advanced_cluster": schema},
+		AdvancedClusterV2: &ProviderSchema{Resources: map[string]Schema{"mongodbatlas_advanced_cluster": schema}},
+	}
+	changes := Diff(baseline, current)
+	assert.Equal(t, []Change{
+		{Kind: kindResource, Name: "mongodbatlas_advanced_cluster (advanced cluster v2 schema)", Message: "attribute replication_specs removed", Breaking: true},
+	}, changes)
+
+	changes = Diff(&ProviderSchema{}, &ProviderSchema{AdvancedClusterV2: &ProviderSchema{DataSources: map[string]Schema{"mongodbatlas_advanced_cluster": schema}}})
+	assert.Equal(t, []Change{
+		{Kind: kindDataSource, Name: "mongodbatlas_advanced_cluster (advanced cluster v2 schema)", Message: "added"},
+	}, changes)
+}
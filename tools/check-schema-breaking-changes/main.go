@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+const baselinePath = "tools/check-schema-breaking-changes/provider-schema.json"
+
+func main() {
+	update := flag.Bool("update", false, "write the current provider schema to the baseline file instead of checking it")
+	baseline := flag.String("baseline", baselinePath, "path of the provider schema baseline file")
+	flag.Parse()
+
+	current, err := DumpProviderSchema(context.Background())
+	if err != nil {
+		log.Fatalf("an error occurred when getting the provider schema: %v", err)
+	}
+
+	if *update {
+		if err := writeSchema(*baseline, current); err != nil {
+			log.Fatalf("an error occurred when writing the provider schema baseline: %v", err)
+		}
+		fmt.Printf("Provider schema baseline updated: %s\n", *baseline)
+		return
+	}
+
+	previous, err := readSchema(*baseline)
+	if err != nil {
+		log.Fatalf("an error occurred when reading the provider schema baseline: %v", err)
+	}
+	changes := Diff(previous, current)
+	for _, change := range changes {
+		if !change.Breaking {
+			fmt.Printf("[non-breaking] %s\n", change)
+		}
+	}
+	breakingChanges := BreakingChanges(changes)
+	for _, change := range breakingChanges {
+		fmt.Printf("[breaking] %s\n", change)
+	}
+	if len(breakingChanges) > 0 {
+		log.Fatalf("%d breaking changes found in the provider schema, if they are expected in a major release run `make update-schema-baseline`", len(breakingChanges))
+	}
+	if len(changes) > 0 {
+		fmt.Println("No breaking changes found, run `make update-schema-baseline` to include the non-breaking changes in the baseline")
+		return
+	}
+	fmt.Println("Provider schema matches the baseline")
+}
+
+func readSchema(path string) (*ProviderSchema, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var result ProviderSchema
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func writeSchema(path string, s *ProviderSchema) error {
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0o600)
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	sdkv2schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/provider"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedclustertpf"
+)
+
+const (
+	providerTypeName = "mongodbatlas"
+	pathSeparator    = "."
+	// requiresReplaceModifierName is the type of the plan modifiers returned by RequiresReplace, RequiresReplaceIf and RequiresReplaceIfConfigured in all framework planmodifier packages.
+	requiresReplaceModifierName = "requiresReplaceIfModifier"
+)
+
+// ProviderSchema is the merged schema of the SDKv2 and framework providers, attributes of nested attributes and blocks are flattened using their path, e.g. replication_specs.zone_name.
+type ProviderSchema struct {
+	Provider    Schema            `json:"provider,omitempty"`
+	Resources   map[string]Schema `json:"resources"`
+	DataSources map[string]Schema `json:"data_sources"`
+	// AdvancedClusterV2 has the advanced cluster resource and data sources served instead of the SDKv2 ones when config.AdvancedClusterV2Schema is enabled.
+	AdvancedClusterV2 *ProviderSchema `json:"advanced_cluster_v2,omitempty"`
+}
+
+type Schema map[string]Attribute
+
+type Attribute struct {
+	Type            string `json:"type"`
+	Required        bool   `json:"required,omitempty"`
+	Optional        bool   `json:"optional,omitempty"`
+	Computed        bool   `json:"computed,omitempty"`
+	Sensitive       bool   `json:"sensitive,omitempty"`
+	RequiresReplace bool   `json:"requires_replace,omitempty"`
+}
+
+// DumpProviderSchema gets the schema served by MuxProviderFactory, ForceNew and RequiresReplace are not part of the protocol so they are read from the SDKv2 and framework resources.
+// The advanced cluster v2 schema is also dumped as the flag enabling it can't be changed at runtime.
+func DumpProviderSchema(ctx context.Context) (*ProviderSchema, error) {
+	result, err := dumpServerSchema(ctx, provider.MuxProviderFactory()())
+	if err != nil {
+		return nil, err
+	}
+	replacePaths := sdkv2ForceNewPaths(provider.NewSdkV2Provider(nil))
+	frameworkProvider, ok := provider.NewFrameworkProvider(nil).(*provider.MongodbtlasProvider)
+	if !ok {
+		return nil, errors.New("unexpected framework provider type")
+	}
+	frameworkPaths, err := frameworkRequiresReplacePaths(ctx, frameworkProvider.Resources(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for name, paths := range frameworkPaths {
+		replacePaths[name] = append(replacePaths[name], paths...)
+	}
+	if err := setRequiresReplace(result, replacePaths); err != nil {
+		return nil, err
+	}
+	if result.AdvancedClusterV2, err = dumpAdvancedClusterV2Schema(ctx, frameworkProvider); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// advancedClusterV2Provider only serves the resource and data sources added by the framework provider when config.AdvancedClusterV2Schema is enabled.
+type advancedClusterV2Provider struct {
+	*provider.MongodbtlasProvider
+}
+
+func (p advancedClusterV2Provider) Resources(context.Context) []func() resource.Resource {
+	return []func() resource.Resource{advancedclustertpf.Resource}
+}
+
+func (p advancedClusterV2Provider) DataSources(context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{advancedclustertpf.DataSource, advancedclustertpf.PluralDataSource}
+}
+
+func dumpAdvancedClusterV2Schema(ctx context.Context, frameworkProvider *provider.MongodbtlasProvider) (*ProviderSchema, error) {
+	p := advancedClusterV2Provider{MongodbtlasProvider: frameworkProvider}
+	result, err := dumpServerSchema(ctx, providerserver.NewProtocol6(p)())
+	if err != nil {
+		return nil, err
+	}
+	// the provider schema is already part of the default schema
+	result.Provider = nil
+	replacePaths, err := frameworkRequiresReplacePaths(ctx, p.Resources(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := setRequiresReplace(result, replacePaths); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func dumpServerSchema(ctx context.Context, server tfprotov6.ProviderServer) (*ProviderSchema, error) {
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return nil, err
+	}
+	result := &ProviderSchema{
+		Provider:    Schema{},
+		Resources:   map[string]Schema{},
+		DataSources: map[string]Schema{},
+	}
+	if resp.Provider != nil {
+		addBlock(result.Provider, "", resp.Provider.Block)
+	}
+	for name, s := range resp.ResourceSchemas {
+		result.Resources[name] = Schema{}
+		addBlock(result.Resources[name], "", s.Block)
+	}
+	for name, s := range resp.DataSourceSchemas {
+		result.DataSources[name] = Schema{}
+		addBlock(result.DataSources[name], "", s.Block)
+	}
+	return result, nil
+}
+
+func setRequiresReplace(result *ProviderSchema, replacePaths map[string][]string) error {
+	for name, paths := range replacePaths {
+		resourceSchema, ok := result.Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in provider schema", name)
+		}
+		for _, path := range paths {
+			attr, ok := resourceSchema[path]
+			if !ok {
+				return fmt.Errorf("attribute %s of resource %s not found in provider schema", path, name)
+			}
+			attr.RequiresReplace = true
+			resourceSchema[path] = attr
+		}
+	}
+	return nil
+}
+
+func diagnosticsError(diags []*tfprotov6.Diagnostic) error {
+	var errs []error
+	for _, diag := range diags {
+		if diag.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", diag.Summary, diag.Detail))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + pathSeparator + name
+}
+
+func addBlock(s Schema, prefix string, block *tfprotov6.SchemaBlock) {
+	if block == nil {
+		return
+	}
+	for _, attr := range block.Attributes {
+		path := joinPath(prefix, attr.Name)
+		typeName := ""
+		if attr.NestedType != nil {
+			typeName = "nested_" + nestingName(attr.NestedType.Nesting.String())
+			addObject(s, path, attr.NestedType)
+		} else if attr.Type != nil {
+			typeName = attr.Type.String()
+		}
+		s[path] = Attribute{
+			Type:      typeName,
+			Required:  attr.Required,
+			Optional:  attr.Optional,
+			Computed:  attr.Computed,
+			Sensitive: attr.Sensitive,
+		}
+	}
+	for _, nestedBlock := range block.BlockTypes {
+		path := joinPath(prefix, nestedBlock.TypeName)
+		s[path] = Attribute{
+			Type: "block_" + nestingName(nestedBlock.Nesting.String()),
+			// blocks with min items are required, otherwise they are optional
+			Required: nestedBlock.MinItems > 0,
+			Optional: nestedBlock.MinItems == 0,
+		}
+		addBlock(s, path, nestedBlock.Block)
+	}
+}
+
+func addObject(s Schema, prefix string, object *tfprotov6.SchemaObject) {
+	addBlock(s, prefix, &tfprotov6.SchemaBlock{Attributes: object.Attributes})
+}
+
+// nestingName converts protocol nesting names like NESTING_LIST to list.
+func nestingName(nesting string) string {
+	return strings.ToLower(strings.TrimPrefix(nesting, "NESTING_"))
+}
+
+func sdkv2ForceNewPaths(p *sdkv2schema.Provider) map[string][]string {
+	result := map[string][]string{}
+	for name, r := range p.ResourcesMap {
+		if paths := forceNewPaths("", r.SchemaMap()); len(paths) > 0 {
+			result[name] = paths
+		}
+	}
+	return result
+}
+
+func forceNewPaths(prefix string, schemaMap map[string]*sdkv2schema.Schema) []string {
+	var paths []string
+	for name, s := range schemaMap {
+		path := joinPath(prefix, name)
+		if s.ForceNew {
+			paths = append(paths, path)
+		}
+		if nested, ok := s.Elem.(*sdkv2schema.Resource); ok {
+			paths = append(paths, forceNewPaths(path, nested.SchemaMap())...)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func frameworkRequiresReplacePaths(ctx context.Context, resources []func() resource.Resource) (map[string][]string, error) {
+	result := map[string][]string{}
+	for _, newResource := range resources {
+		r := newResource()
+		metadataResp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResp)
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		if schemaResp.Diagnostics.HasError() {
+			return nil, fmt.Errorf("schema of resource %s has errors: %v", metadataResp.TypeName, schemaResp.Diagnostics)
+		}
+		var paths []string
+		addRequiresReplacePaths(&paths, "", reflect.ValueOf(schemaResp.Schema))
+		if len(paths) > 0 {
+			sort.Strings(paths)
+			result[metadataResp.TypeName] = paths
+		}
+	}
+	return result, nil
+}
+
+// addRequiresReplacePaths uses reflection because each framework attribute and block type has its own PlanModifiers field type.
+// Nested attributes and blocks are found in the Attributes and Blocks fields of the value or its NestedObject field.
+func addRequiresReplacePaths(paths *[]string, prefix string, value reflect.Value) {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return
+	}
+	if nested := value.FieldByName("NestedObject"); nested.IsValid() {
+		addRequiresReplacePaths(paths, prefix, nested)
+	}
+	for _, fieldName := range []string{"Attributes", "Blocks"} {
+		children := value.FieldByName(fieldName)
+		if !children.IsValid() || children.Kind() != reflect.Map {
+			continue
+		}
+		iter := children.MapRange()
+		for iter.Next() {
+			path := joinPath(prefix, iter.Key().String())
+			if hasRequiresReplace(iter.Value()) {
+				*paths = append(*paths, path)
+			}
+			addRequiresReplacePaths(paths, path, iter.Value())
+		}
+	}
+}
+
+func hasRequiresReplace(value reflect.Value) bool {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return false
+	}
+	modifiers := value.FieldByName("PlanModifiers")
+	if !modifiers.IsValid() || modifiers.Kind() != reflect.Slice {
+		return false
+	}
+	for i := range modifiers.Len() {
+		modifier := modifiers.Index(i)
+		if modifier.Kind() == reflect.Interface && !modifier.IsNil() && reflect.TypeOf(modifier.Interface()).Name() == requiresReplaceModifierName {
+			return true
+		}
+	}
+	return false
+}
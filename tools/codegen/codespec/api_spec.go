@@ -29,6 +29,7 @@ func BuildSchema(proxy *base.SchemaProxy) (*APISpecSchema, error) {
 
 	resp.Type = schema.Type[0]
 	resp.Schema = schema
+	resp.Reference = proxy.GetReference()
 
 	return resp, nil
 }
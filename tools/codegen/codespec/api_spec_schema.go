@@ -2,14 +2,16 @@ package codespec
 
 import (
 	"slices"
+	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 )
 
 type APISpecSchema struct {
-	Schema *base.Schema
-	Type   string
+	Schema    *base.Schema
+	Type      string
+	Reference string
 }
 
 type APISpecResource struct {
@@ -19,6 +21,8 @@ type APISpecResource struct {
 	ReadOp             *high.Operation
 	UpdateOp           *high.Operation
 	DeleteOp           *high.Operation
+	DataSourceReadOp   *high.Operation
+	PluralReadOp       *high.Operation
 	CommonParameters   []*high.Parameter
 }
 
@@ -57,3 +61,29 @@ func (s *APISpecSchema) IsSensitive() *bool {
 
 	return &isSensitive
 }
+
+// GetSDKType returns the name of the Atlas SDK struct generated for the referenced schema, e.g. #/components/schemas/groupSettings results in GroupSettings.
+func (s *APISpecSchema) GetSDKType() string {
+	if s.Reference == "" {
+		return ""
+	}
+	return sdkName(s.Reference[strings.LastIndex(s.Reference, "/")+1:])
+}
+
+func newSDKField(name, format string) *SDKField {
+	return &SDKField{
+		Name:   sdkName(name),
+		Format: format,
+	}
+}
+
+// sdkName follows the naming of the SDK generator, which capitalizes each underscore separated part and keeps the rest of the casing, e.g. mongoDBVersion results in MongoDBVersion.
+func sdkName(name string) string {
+	parts := strings.Split(unsupportedCharacters.ReplaceAllString(name, ""), "_")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -35,28 +35,60 @@ func ToCodeSpecModel(atlasAdminAPISpecFilePath, configPath string, resourceName
 	var results []Resource
 	for name, resourceConfig := range resourceConfigsToIterate {
 		log.Printf("Generating resource: %s", name)
+		generate, err := generateOptions(&resourceConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid generate option of resource %s: %v", name, err)
+		}
 		// find resource operations, schemas, etc from OAS
 		oasResource, err := getAPISpecResource(&apiSpec.Model, &resourceConfig, SnakeCaseString(name))
 		if err != nil {
 			return nil, fmt.Errorf("unable to get APISpecResource schema: %v", err)
 		}
 		// map OAS resource model to CodeSpecModel
-		results = append(results, *apiSpecResourceToCodeSpecModel(oasResource, &resourceConfig, SnakeCaseString(name)))
+		resource := apiSpecResourceToCodeSpecModel(oasResource, &resourceConfig, SnakeCaseString(name))
+		resource.Generate = *generate
+		results = append(results, *resource)
 	}
 
 	return &Model{Resources: results}, nil
 }
 
+func generateOptions(resourceConfig *config.Resource) (*Generate, error) {
+	generate := &Generate{}
+	for _, option := range resourceConfig.Generate {
+		switch option {
+		case config.GenerateModel:
+			generate.Model = true
+		case config.GenerateResource:
+			generate.Resource = true
+		default:
+			return nil, fmt.Errorf("unknown value %s, valid values are %s and %s", option, config.GenerateModel, config.GenerateResource)
+		}
+	}
+	if generate.Resource && !generate.Model {
+		return nil, fmt.Errorf("%s requires %s as the generated resource uses the generated model conversions", config.GenerateResource, config.GenerateModel)
+	}
+	if (resourceConfig.DataSource != nil || resourceConfig.PluralDataSource != nil) && !generate.Model {
+		return nil, fmt.Errorf("data_source and plural_data_source require %s as the generated data sources use the generated model conversions", config.GenerateModel)
+	}
+	return generate, nil
+}
+
 func apiSpecResourceToCodeSpecModel(oasResource APISpecResource, resourceConfig *config.Resource, name SnakeCaseString) *Resource {
 	createOp := oasResource.CreateOp
 	readOp := oasResource.ReadOp
+	updateOp := oasResource.UpdateOp
 
-	pathParamAttributes := pathParamsToAttributes(createOp)
+	// read path params are needed to read the resource after import
+	pathParamAttributes := append(pathParamsToAttributes(createOp), pathParamsToAttributes(readOp)...)
 	createRequestAttributes := opRequestToAttributes(createOp)
 	createResponseAttributes := opResponseToAttributes(createOp)
 	readResponseAttributes := opResponseToAttributes(readOp)
 
 	attributes := mergeAttributes(pathParamAttributes, createRequestAttributes, createResponseAttributes, readResponseAttributes)
+	if updateOp != nil {
+		setUpdateRequestUsage(attributes, opRequestToAttributes(updateOp))
+	}
 
 	schema := &Schema{
 		Description:        oasResource.Description,
@@ -65,8 +97,14 @@ func apiSpecResourceToCodeSpecModel(oasResource APISpecResource, resourceConfig
 	}
 
 	resource := &Resource{
-		Name:   name,
-		Schema: schema,
+		Name:       name,
+		Schema:     schema,
+		Operations: resourceOperations(&oasResource, resourceConfig),
+		SDKTypes: SDKTypes{
+			CreateRequest: opRequestSDKType(createOp),
+			UpdateRequest: opRequestSDKType(updateOp),
+			ReadResponse:  opResponseSDKType(readOp),
+		},
 	}
 
 	applyConfigSchemaOptions(resourceConfig, resource)
@@ -95,6 +133,7 @@ func pathParamsToAttributes(createOp *high.Operation) Attributes {
 			log.Printf("[WARN] Path param %s could not be mapped: %s", paramName, err)
 			continue
 		}
+		parameterAttribute.SDKField = nil // path params are not part of the SDK request and response structs
 		pathAttributes = append(pathAttributes, *parameterAttribute)
 	}
 	return pathAttributes
@@ -114,6 +153,7 @@ func opRequestToAttributes(op *high.Operation) Attributes {
 		return nil
 	}
 
+	setSDKFieldUsage(requestAttributes, func(field *SDKField, usage SDKFieldUsage) { field.Request = usage })
 	return requestAttributes
 }
 
@@ -132,9 +172,74 @@ func opResponseToAttributes(op *high.Operation) Attributes {
 			log.Printf("[WARN] Operation response body schema could not be mapped (OperationId: %s): %s", op.OperationId, err)
 		}
 	}
+	setSDKFieldUsage(responseAttributes, func(field *SDKField, usage SDKFieldUsage) { field.Response = usage })
 	return responseAttributes
 }
 
+// setSDKFieldUsage must be called before attributes are merged, as it relies on the computability defined by the OpenAPI schema.
+// Properties required by the schema are generated as value fields in the SDK structs, the rest are pointers.
+func setSDKFieldUsage(attrs Attributes, setUsage func(field *SDKField, usage SDKFieldUsage)) {
+	for i := range attrs {
+		attr := &attrs[i]
+		if attr.SDKField != nil {
+			usage := SDKFieldPointer
+			if attr.ComputedOptionalRequired == Required {
+				usage = SDKFieldValue
+			}
+			setUsage(attr.SDKField, usage)
+		}
+		if nested := attr.nestedObject(); nested != nil {
+			setSDKFieldUsage(nested.Attributes, setUsage)
+		}
+	}
+}
+
+// setUpdateRequestUsage sets the update request usage of the resource attributes also present in the create request.
+// Nested attributes are only marked when both requests share the nested SDK type, as the conversions of nested objects are generated for the create request.
+func setUpdateRequestUsage(attrs, updateRequestAttrs Attributes) {
+	for i := range updateRequestAttrs {
+		updateAttr := &updateRequestAttrs[i]
+		attr := findAttribute(attrs, updateAttr.Name)
+		if attr == nil || attr.SDKField == nil || attr.SDKField.Request == SDKFieldAbsent || updateAttr.SDKField == nil {
+			continue
+		}
+		nested, updateNested := attr.nestedObject(), updateAttr.nestedObject()
+		if nested != nil {
+			if updateNested == nil || nested.SDKType != updateNested.SDKType {
+				log.Printf("[WARN] Attribute %s has a different type in the update request so it's not included in the update conversion", attr.Name)
+				continue
+			}
+			setUpdateRequestUsage(nested.Attributes, updateNested.Attributes)
+		}
+		attr.SDKField.UpdateRequest = updateAttr.SDKField.Request
+	}
+}
+
+func findAttribute(attrs Attributes, name SnakeCaseString) *Attribute {
+	for i := range attrs {
+		if attrs[i].Name == name {
+			return &attrs[i]
+		}
+	}
+	return nil
+}
+
+func opRequestSDKType(op *high.Operation) string {
+	requestSchema, err := buildSchemaFromRequest(op)
+	if err != nil {
+		return ""
+	}
+	return requestSchema.GetSDKType()
+}
+
+func opResponseSDKType(op *high.Operation) string {
+	responseSchema, err := buildSchemaFromResponse(op)
+	if err != nil {
+		return ""
+	}
+	return responseSchema.GetSDKType()
+}
+
 func getAPISpecResource(spec *high.Document, resourceConfig *config.Resource, name SnakeCaseString) (APISpecResource, error) {
 	var errResult error
 	var resourceDeprecationMsg *string
@@ -156,6 +261,26 @@ func getAPISpecResource(spec *high.Document, resourceConfig *config.Resource, na
 		errResult = errors.Join(errResult, fmt.Errorf("unable to extract '%s.delete' operation: %w", name, err))
 	}
 
+	var dataSourceReadOp, pluralReadOp *high.Operation
+	if ds := resourceConfig.DataSource; ds != nil {
+		dataSourceReadOp = readOp
+		if ds.Read != nil {
+			dataSourceReadOp, err = extractOp(spec.Paths, ds.Read)
+			if err != nil {
+				errResult = errors.Join(errResult, fmt.Errorf("unable to extract '%s.data_source.read' operation: %w", name, err))
+			}
+		}
+	}
+	if ds := resourceConfig.PluralDataSource; ds != nil {
+		if ds.Read == nil {
+			errResult = errors.Join(errResult, fmt.Errorf("'%s.plural_data_source.read' operation must be defined", name))
+		}
+		pluralReadOp, err = extractOp(spec.Paths, ds.Read)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("unable to extract '%s.plural_data_source.read' operation: %w", name, err))
+		}
+	}
+
 	commonParameters, err := extractCommonParameters(spec.Paths, resourceConfig.Read.Path)
 	if err != nil {
 		errResult = errors.Join(errResult, fmt.Errorf("unable to extract '%s' common parameters: %w", name, err))
@@ -172,6 +297,8 @@ func getAPISpecResource(spec *high.Document, resourceConfig *config.Resource, na
 		ReadOp:             readOp,
 		UpdateOp:           updateOp,
 		DeleteOp:           deleteOp,
+		DataSourceReadOp:   dataSourceReadOp,
+		PluralReadOp:       pluralReadOp,
 		CommonParameters:   commonParameters,
 	}, errResult
 }
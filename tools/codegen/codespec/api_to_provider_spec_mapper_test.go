@@ -37,17 +37,20 @@ func TestConvertToProviderSpec(t *testing.T) {
 					Attributes: codespec.Attributes{
 						{
 							Name:                     "bool_default_attr",
+							SDKField:                 &codespec.SDKField{Name: "BoolDefaultAttr", Request: codespec.SDKFieldPointer, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.ComputedOptional,
 							Bool:                     &codespec.BoolAttribute{Default: conversion.Pointer(false)},
 						},
 						{
 							Name:                     "count",
+							SDKField:                 &codespec.SDKField{Name: "Count", Format: "int32", Request: codespec.SDKFieldPointer, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Optional,
 							Int64:                    &codespec.Int64Attribute{},
 							Description:              conversion.StringPtr(testFieldDesc),
 						},
 						{
 							Name:                     "create_date",
+							SDKField:                 &codespec.SDKField{Name: "CreateDate", Format: "date-time", Response: codespec.SDKFieldPointer},
 							String:                   &codespec.StringAttribute{},
 							ComputedOptionalRequired: codespec.Computed,
 							Description:              conversion.StringPtr(testFieldDesc),
@@ -60,36 +63,55 @@ func TestConvertToProviderSpec(t *testing.T) {
 						},
 						{
 							Name:                     "num_double_default_attr",
+							SDKField:                 &codespec.SDKField{Name: "NumDoubleDefaultAttr", Format: "double", Request: codespec.SDKFieldPointer, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 							Float64:                  &codespec.Float64Attribute{Default: conversion.Pointer(2.0)},
 							ComputedOptionalRequired: codespec.ComputedOptional,
 						},
 						{
 							Name:                     "str_computed_attr",
+							SDKField:                 &codespec.SDKField{Name: "StrComputedAttr", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Computed,
 							String:                   &codespec.StringAttribute{},
 							Description:              conversion.StringPtr(testFieldDesc),
 						},
 						{
 							Name:                     "str_req_attr1",
+							SDKField:                 &codespec.SDKField{Name: "StrReqAttr1", Request: codespec.SDKFieldValue, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Required,
 							String:                   &codespec.StringAttribute{},
 							Description:              conversion.StringPtr(testFieldDesc),
 						},
 						{
 							Name:                     "str_req_attr2",
+							SDKField:                 &codespec.SDKField{Name: "StrReqAttr2", Request: codespec.SDKFieldValue, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Required,
 							String:                   &codespec.StringAttribute{},
 							Description:              conversion.StringPtr(testFieldDesc),
 						},
 						{
 							Name:                     "str_req_attr3",
+							SDKField:                 &codespec.SDKField{Name: "StrReqAttr3", Request: codespec.SDKFieldValue, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 							String:                   &codespec.StringAttribute{},
 							ComputedOptionalRequired: codespec.Required,
 							Description:              conversion.StringPtr(testFieldDesc),
 						},
 					},
 				},
-				Name: "test_resource",
+				Operations: codespec.Operations{
+					Create:         &codespec.APIOperation{SDKAPI: "TestResourceApi", SDKMethod: "CreateTestResourceConfiguration", PathParams: []codespec.SnakeCaseString{"group_id"}, HasRequestBody: true},
+					Read:           &codespec.APIOperation{SDKAPI: "TestResourceApi", SDKMethod: "GetTestResourceConfiguration", SDKResponseType: "TestResource", PathParams: []codespec.SnakeCaseString{"group_id"}, HasResponseBody: true},
+					Update:         &codespec.APIOperation{SDKAPI: "TestResourceApi", SDKMethod: "UpdateTestResourceConfiguration", PathParams: []codespec.SnakeCaseString{"group_id"}, HasRequestBody: true},
+					Delete:         &codespec.APIOperation{SDKAPI: "TestResourceApi", SDKMethod: "DeleteTestResourceConfiguration", PathParams: []codespec.SnakeCaseString{"group_id"}},
+					DataSourceRead: &codespec.APIOperation{SDKAPI: "TestResourceApi", SDKMethod: "GetTestResourceConfiguration", SDKResponseType: "TestResource", PathParams: []codespec.SnakeCaseString{"group_id"}, HasResponseBody: true},
+					PluralDataSourceRead: &codespec.APIOperation{
+						SDKAPI: "TestResourceApi", SDKMethod: "ListTestResources", SDKResponseType: "PaginatedTestResource", SDKResultsType: "TestResource",
+						PathParams: []codespec.SnakeCaseString{"group_id"}, HasResponseBody: true, Paginated: true,
+					},
+					StateSDKField: "State",
+				},
+				SDKTypes: codespec.SDKTypes{CreateRequest: "CreateTestResourceRequest", UpdateRequest: "TestResource", ReadResponse: "TestResource"},
+				Name:     "test_resource",
+				Generate: codespec.Generate{Model: true, Resource: true},
 			}},
 		},
 	}
@@ -115,12 +137,14 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 						},
 						{
 							Name:                     "group_id",
+							SDKField:                 &codespec.SDKField{Name: "GroupId", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Required,
 							String:                   &codespec.StringAttribute{},
 							Description:              conversion.StringPtr(testPathParamDesc),
 						},
 						{
 							Name:                     "list_primitive_string_attr",
+							SDKField:                 &codespec.SDKField{Name: "ListPrimitiveStringAttr", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Computed,
 							List: &codespec.ListAttribute{
 								ElementType: codespec.String,
@@ -129,18 +153,22 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 						},
 						{
 							Name:                     "nested_list_array_attr",
+							SDKField:                 &codespec.SDKField{Name: "NestedListArrayAttr", Request: codespec.SDKFieldValue, UpdateRequest: codespec.SDKFieldValue, Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Required,
 							ListNested: &codespec.ListNestedAttribute{
 								NestedObject: codespec.NestedAttributeObject{
+									SDKType: "NestedObjectAttr",
 									Attributes: codespec.Attributes{
 										{
 											Name:                     "inner_num_attr",
+											SDKField:                 &codespec.SDKField{Name: "InnerNumAttr", Format: "int32", Request: codespec.SDKFieldValue, UpdateRequest: codespec.SDKFieldValue, Response: codespec.SDKFieldValue},
 											ComputedOptionalRequired: codespec.Required,
 											Int64:                    &codespec.Int64Attribute{},
 											Description:              conversion.StringPtr(testFieldDesc),
 										},
 										{
 											Name:                     "list_primitive_string_attr",
+											SDKField:                 &codespec.SDKField{Name: "ListPrimitiveStringAttr", Request: codespec.SDKFieldPointer, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 											ComputedOptionalRequired: codespec.Optional,
 											List: &codespec.ListAttribute{
 												ElementType: codespec.String,
@@ -149,6 +177,7 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 										},
 										{
 											Name:                     "list_primitive_string_computed_attr",
+											SDKField:                 &codespec.SDKField{Name: "ListPrimitiveStringComputedAttr", Response: codespec.SDKFieldPointer},
 											ComputedOptionalRequired: codespec.Computed,
 											List: &codespec.ListAttribute{
 												ElementType: codespec.String,
@@ -162,12 +191,14 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 						},
 						{
 							Name:                     "nested_map_object_attr",
+							SDKField:                 &codespec.SDKField{Name: "NestedMapObjectAttr", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Computed,
 							MapNested: &codespec.MapNestedAttribute{
 								NestedObject: codespec.NestedAttributeObject{
 									Attributes: codespec.Attributes{
 										{
 											Name:                     "attr",
+											SDKField:                 &codespec.SDKField{Name: "Attr", Response: codespec.SDKFieldPointer},
 											ComputedOptionalRequired: codespec.Computed,
 											String:                   &codespec.StringAttribute{},
 										},
@@ -177,18 +208,22 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 						},
 						{
 							Name:                     "nested_set_array_attr",
+							SDKField:                 &codespec.SDKField{Name: "NestedSetArrayAttr", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Computed,
 							SetNested: &codespec.SetNestedAttribute{
 								NestedObject: codespec.NestedAttributeObject{
+									SDKType: "NestedObjectAttr",
 									Attributes: codespec.Attributes{
 										{
 											Name:                     "inner_num_attr",
+											SDKField:                 &codespec.SDKField{Name: "InnerNumAttr", Format: "int32", Response: codespec.SDKFieldValue},
 											ComputedOptionalRequired: codespec.Computed,
 											Int64:                    &codespec.Int64Attribute{},
 											Description:              conversion.StringPtr(testFieldDesc),
 										},
 										{
 											Name:                     "list_primitive_string_attr",
+											SDKField:                 &codespec.SDKField{Name: "ListPrimitiveStringAttr", Response: codespec.SDKFieldPointer},
 											ComputedOptionalRequired: codespec.Computed,
 											List: &codespec.ListAttribute{
 												ElementType: codespec.String,
@@ -202,12 +237,14 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 						},
 						{
 							Name:                     "optional_string_attr",
+							SDKField:                 &codespec.SDKField{Name: "OptionalStringAttr", Request: codespec.SDKFieldPointer, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Optional,
 							String:                   &codespec.StringAttribute{},
 							Description:              conversion.StringPtr("Optional string"),
 						},
 						{
 							Name:                     "set_primitive_string_attr",
+							SDKField:                 &codespec.SDKField{Name: "SetPrimitiveStringAttr", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Computed,
 							Set: &codespec.SetAttribute{
 								ElementType: codespec.String,
@@ -216,18 +253,22 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 						},
 						{
 							Name:                     "single_nested_attr",
+							SDKField:                 &codespec.SDKField{Name: "SingleNestedAttr", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Computed,
 							SingleNested: &codespec.SingleNestedAttribute{
 								NestedObject: codespec.NestedAttributeObject{
+									SDKType: "SingleNestedAttr",
 									Attributes: codespec.Attributes{
 										{
 											Name:                     "inner_int_attr",
+											SDKField:                 &codespec.SDKField{Name: "InnerIntAttr", Response: codespec.SDKFieldValue},
 											ComputedOptionalRequired: codespec.Computed,
 											Int64:                    &codespec.Int64Attribute{},
 											Description:              conversion.StringPtr(testFieldDesc),
 										},
 										{
 											Name:                     "inner_str_attr",
+											SDKField:                 &codespec.SDKField{Name: "InnerStrAttr", Response: codespec.SDKFieldValue},
 											ComputedOptionalRequired: codespec.Computed,
 											String:                   &codespec.StringAttribute{},
 											Description:              conversion.StringPtr(testFieldDesc),
@@ -239,12 +280,15 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 						},
 						{
 							Name:                     "single_nested_attr_with_nested_maps",
+							SDKField:                 &codespec.SDKField{Name: "SingleNestedAttrWithNestedMaps", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Computed,
 							SingleNested: &codespec.SingleNestedAttribute{
 								NestedObject: codespec.NestedAttributeObject{
+									SDKType: "SingleNestedAttrWithNestedMaps",
 									Attributes: codespec.Attributes{
 										{
 											Name:                     "map_attr1",
+											SDKField:                 &codespec.SDKField{Name: "MapAttr1", Response: codespec.SDKFieldPointer},
 											ComputedOptionalRequired: codespec.Computed,
 											Map: &codespec.MapAttribute{
 												ElementType: codespec.String,
@@ -252,6 +296,7 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 										},
 										{
 											Name:                     "map_attr2",
+											SDKField:                 &codespec.SDKField{Name: "MapAttr2", Response: codespec.SDKFieldPointer},
 											ComputedOptionalRequired: codespec.Computed,
 											Map: &codespec.MapAttribute{
 												ElementType: codespec.String,
@@ -264,7 +309,15 @@ func TestConvertToProviderSpec_nested(t *testing.T) {
 						},
 					},
 				},
-				Name: "test_resource_with_nested_attr",
+				Operations: codespec.Operations{
+					Create:        &codespec.APIOperation{SDKAPI: "AtlasSearchApi", SDKMethod: "CreateNestedTestResource", SDKResponseType: "NestedTestResourceResponse", PathParams: []codespec.SnakeCaseString{"group_id", "cluster_name"}, HasRequestBody: true, HasResponseBody: true},
+					Read:          &codespec.APIOperation{SDKAPI: "AtlasSearchApi", SDKMethod: "GetNestedTestResource", SDKResponseType: "NestedTestResourceResponse", PathParams: []codespec.SnakeCaseString{"group_id", "cluster_name"}, HasResponseBody: true},
+					Update:        &codespec.APIOperation{SDKAPI: "AtlasSearchApi", SDKMethod: "UpdateNestedTestResource", SDKResponseType: "NestedTestResourceResponse", PathParams: []codespec.SnakeCaseString{"group_id", "cluster_name"}, HasRequestBody: true, HasResponseBody: true},
+					Delete:        &codespec.APIOperation{SDKAPI: "AtlasSearchApi", SDKMethod: "DeleteNestedTestResource", SDKResponseType: "NoBody", PathParams: []codespec.SnakeCaseString{"group_id", "cluster_name"}, HasResponseBody: true},
+					StateSDKField: "State",
+				},
+				SDKTypes: codespec.SDKTypes{CreateRequest: "NestedTestResourceRequest", UpdateRequest: "NestedTestResourceRequest", ReadResponse: "NestedTestResourceResponse"},
+				Name:     "test_resource_with_nested_attr",
 			},
 			},
 		},
@@ -285,24 +338,29 @@ func TestConvertToProviderSpec_nested_schemaOverrides(t *testing.T) {
 					Attributes: codespec.Attributes{
 						{
 							Name:                     "project_id",
+							SDKField:                 &codespec.SDKField{Name: "GroupId", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Required,
 							String:                   &codespec.StringAttribute{},
 							Description:              conversion.StringPtr(testPathParamDesc),
 						},
 						{
 							Name:                     "nested_list_array_attr",
+							SDKField:                 &codespec.SDKField{Name: "NestedListArrayAttr", Request: codespec.SDKFieldValue, UpdateRequest: codespec.SDKFieldValue, Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Required,
 							ListNested: &codespec.ListNestedAttribute{
 								NestedObject: codespec.NestedAttributeObject{
+									SDKType: "NestedObjectAttr",
 									Attributes: codespec.Attributes{
 										{
 											Name:                     "inner_num_attr_alias",
+											SDKField:                 &codespec.SDKField{Name: "InnerNumAttr", Format: "int32", Request: codespec.SDKFieldValue, UpdateRequest: codespec.SDKFieldValue, Response: codespec.SDKFieldValue},
 											ComputedOptionalRequired: codespec.Required,
 											Int64:                    &codespec.Int64Attribute{},
 											Description:              conversion.StringPtr("Overridden inner_num_attr_alias description"),
 										},
 										{
 											Name:                     "list_primitive_string_computed_attr",
+											SDKField:                 &codespec.SDKField{Name: "ListPrimitiveStringComputedAttr", Response: codespec.SDKFieldPointer},
 											ComputedOptionalRequired: codespec.Computed,
 											List: &codespec.ListAttribute{
 												ElementType: codespec.String,
@@ -316,24 +374,30 @@ func TestConvertToProviderSpec_nested_schemaOverrides(t *testing.T) {
 						},
 						{
 							Name:                     "optional_string_attr",
+							SDKField:                 &codespec.SDKField{Name: "OptionalStringAttr", Request: codespec.SDKFieldPointer, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.ComputedOptional,
 							String:                   &codespec.StringAttribute{},
 							Description:              conversion.StringPtr("Optional string that has config override to optional/computed"),
 						},
 						{
 							Name:                     "outer_object",
+							SDKField:                 &codespec.SDKField{Name: "OuterObject", Response: codespec.SDKFieldPointer},
 							ComputedOptionalRequired: codespec.Computed,
 							SingleNested: &codespec.SingleNestedAttribute{
 								NestedObject: codespec.NestedAttributeObject{
+									SDKType: "OuterObject",
 									Attributes: codespec.Attributes{
 										{
 											Name:                     "nested_level1",
+											SDKField:                 &codespec.SDKField{Name: "NestedLevel1", Response: codespec.SDKFieldPointer},
 											ComputedOptionalRequired: codespec.Computed,
 											SingleNested: &codespec.SingleNestedAttribute{
 												NestedObject: codespec.NestedAttributeObject{
+													SDKType: "NestedLevel1Object",
 													Attributes: codespec.Attributes{
 														{
 															Name:                     "level_field1_alias",
+															SDKField:                 &codespec.SDKField{Name: "LevelField1", Response: codespec.SDKFieldPointer},
 															ComputedOptionalRequired: codespec.Computed,
 															String:                   &codespec.StringAttribute{},
 															Description:              conversion.StringPtr("Overridden level_field1_alias description"),
@@ -354,7 +418,15 @@ func TestConvertToProviderSpec_nested_schemaOverrides(t *testing.T) {
 						},
 					},
 				},
-				Name: "test_resource_with_nested_attr_overrides",
+				Operations: codespec.Operations{
+					Create:        &codespec.APIOperation{SDKAPI: "AtlasSearchApi", SDKMethod: "CreateNestedTestResource", SDKResponseType: "NestedTestResourceResponse", PathParams: []codespec.SnakeCaseString{"project_id", "cluster_name"}, HasRequestBody: true, HasResponseBody: true},
+					Read:          &codespec.APIOperation{SDKAPI: "AtlasSearchApi", SDKMethod: "GetNestedTestResource", SDKResponseType: "NestedTestResourceResponse", PathParams: []codespec.SnakeCaseString{"project_id", "cluster_name"}, HasResponseBody: true},
+					Update:        &codespec.APIOperation{SDKAPI: "AtlasSearchApi", SDKMethod: "UpdateNestedTestResource", SDKResponseType: "NestedTestResourceResponse", PathParams: []codespec.SnakeCaseString{"project_id", "cluster_name"}, HasRequestBody: true, HasResponseBody: true},
+					Delete:        &codespec.APIOperation{SDKAPI: "AtlasSearchApi", SDKMethod: "DeleteNestedTestResource", SDKResponseType: "NoBody", PathParams: []codespec.SnakeCaseString{"project_id", "cluster_name"}, HasResponseBody: true},
+					StateSDKField: "State",
+				},
+				SDKTypes: codespec.SDKTypes{CreateRequest: "NestedTestResourceRequest", UpdateRequest: "NestedTestResourceRequest", ReadResponse: "NestedTestResourceResponse"},
+				Name:     "test_resource_with_nested_attr_overrides",
 			},
 			},
 		},
@@ -362,6 +434,29 @@ func TestConvertToProviderSpec_nested_schemaOverrides(t *testing.T) {
 	runTestCase(t, tc)
 }
 
+func TestConvertToProviderSpec_invalidGenerate(t *testing.T) {
+	testCases := map[string]struct {
+		configPath    string
+		expectedError string
+	}{
+		"resource without model": {
+			configPath:    "testdata/config-invalid-generate.yml",
+			expectedError: "invalid generate option of resource test_resource: resource requires model as the generated resource uses the generated model conversions",
+		},
+		"data source without model": {
+			configPath:    "testdata/config-data-source-without-model.yml",
+			expectedError: "invalid generate option of resource test_resource: data_source and plural_data_source require model as the generated data sources use the generated model conversions",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resourceName := "test_resource"
+			_, err := codespec.ToCodeSpecModel(testDataAPISpecPath, tc.configPath, &resourceName)
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
 func runTestCase(t *testing.T, tc convertToSpecTestCase) {
 	t.Helper()
 	result, err := codespec.ToCodeSpecModel(tc.inputOpenAPISpecPath, tc.inputConfigPath, &tc.inputResourceName)
@@ -61,6 +61,7 @@ func (s *APISpecSchema) buildStringAttr(name string, computability ComputedOptio
 		ComputedOptionalRequired: computability,
 		DeprecationMessage:       s.GetDeprecationMessage(),
 		Description:              s.GetDescription(),
+		SDKField:                 newSDKField(name, s.Schema.Format),
 		Sensitive:                s.IsSensitive(),
 		String:                   &StringAttribute{},
 	}
@@ -83,6 +84,7 @@ func (s *APISpecSchema) buildIntegerAttr(name string, computability ComputedOpti
 		ComputedOptionalRequired: computability,
 		DeprecationMessage:       s.GetDeprecationMessage(),
 		Description:              s.GetDescription(),
+		SDKField:                 newSDKField(name, s.Schema.Format),
 		Int64:                    &Int64Attribute{},
 	}
 
@@ -105,6 +107,7 @@ func (s *APISpecSchema) buildNumberAttr(name string, computability ComputedOptio
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			SDKField:                 newSDKField(name, s.Schema.Format),
 			Float64:                  &Float64Attribute{},
 		}
 
@@ -125,6 +128,7 @@ func (s *APISpecSchema) buildNumberAttr(name string, computability ComputedOptio
 		ComputedOptionalRequired: computability,
 		DeprecationMessage:       s.GetDeprecationMessage(),
 		Description:              s.GetDescription(),
+		SDKField:                 newSDKField(name, s.Schema.Format),
 		Number:                   &NumberAttribute{},
 	}, nil
 }
@@ -135,6 +139,7 @@ func (s *APISpecSchema) buildBoolAttr(name string, computability ComputedOptiona
 		ComputedOptionalRequired: computability,
 		DeprecationMessage:       s.GetDeprecationMessage(),
 		Description:              s.GetDescription(),
+		SDKField:                 newSDKField(name, s.Schema.Format),
 		Bool:                     &BoolAttribute{},
 	}
 
@@ -167,6 +172,7 @@ func (s *APISpecSchema) buildArrayAttr(name string, computability ComputedOption
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			SDKField:                 newSDKField(name, s.Schema.Format),
 		}
 
 		if nestedObject != nil {
@@ -191,7 +197,7 @@ func (s *APISpecSchema) buildArrayAttr(name string, computability ComputedOption
 		if err != nil {
 			return nil, fmt.Errorf("error while building nested schema: %s", name)
 		}
-		nestedObject := &NestedAttributeObject{Attributes: objectAttributes}
+		nestedObject := &NestedAttributeObject{SDKType: itemSchema.GetSDKType(), Attributes: objectAttributes}
 
 		return createAttribute(nestedObject, Unknown), nil // Using Unknown ElemType as a placeholder for no ElemType
 	}
@@ -225,6 +231,7 @@ func (s *APISpecSchema) buildMapAttr(name string, computability ComputedOptional
 		ComputedOptionalRequired: computability,
 		DeprecationMessage:       s.GetDeprecationMessage(),
 		Description:              s.GetDescription(),
+		SDKField:                 newSDKField(name, s.Schema.Format),
 	}
 
 	if mapSchema.Type == OASTypeObject {
@@ -235,6 +242,7 @@ func (s *APISpecSchema) buildMapAttr(name string, computability ComputedOptional
 
 		result.MapNested = &MapNestedAttribute{
 			NestedObject: NestedAttributeObject{
+				SDKType:    mapSchema.GetSDKType(),
 				Attributes: mapAttributes,
 			},
 		}
@@ -263,8 +271,10 @@ func (s *APISpecSchema) buildSingleNestedAttr(name string, computability Compute
 		ComputedOptionalRequired: computability,
 		DeprecationMessage:       s.GetDeprecationMessage(),
 		Description:              s.GetDescription(),
+		SDKField:                 newSDKField(name, s.Schema.Format),
 		SingleNested: &SingleNestedAttribute{
 			NestedObject: NestedAttributeObject{
+				SDKType:    s.GetSDKType(),
 				Attributes: objectAttributes,
 			},
 		},
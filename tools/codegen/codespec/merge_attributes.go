@@ -46,26 +46,32 @@ func addOrUpdate(attr *Attribute, computability ComputedOptionalRequired, merged
 			existingAttr.ComputedOptionalRequired = computability
 		}
 
+		mergeSDKField(existingAttr, attr)
+
 		// handle nested attributes
 		if existingAttr.ListNested != nil && attr.ListNested != nil {
+			mergeSDKType(&existingAttr.ListNested.NestedObject, &attr.ListNested.NestedObject)
 			mergeNestedAttributes(&existingAttr.ListNested.NestedObject.Attributes, attr.ListNested.NestedObject.Attributes, computability, isFromResponse)
 		} else if attr.ListNested != nil {
 			existingAttr.ListNested = attr.ListNested
 		}
 
 		if existingAttr.SingleNested != nil && attr.SingleNested != nil {
+			mergeSDKType(&existingAttr.SingleNested.NestedObject, &attr.SingleNested.NestedObject)
 			mergeNestedAttributes(&existingAttr.SingleNested.NestedObject.Attributes, attr.SingleNested.NestedObject.Attributes, computability, isFromResponse)
 		} else if attr.SingleNested != nil {
 			existingAttr.SingleNested = attr.SingleNested
 		}
 
 		if existingAttr.SetNested != nil && attr.SetNested != nil {
+			mergeSDKType(&existingAttr.SetNested.NestedObject, &attr.SetNested.NestedObject)
 			mergeNestedAttributes(&existingAttr.SetNested.NestedObject.Attributes, attr.SetNested.NestedObject.Attributes, computability, isFromResponse)
 		} else if attr.SetNested != nil {
 			existingAttr.SetNested = attr.SetNested
 		}
 
 		if existingAttr.MapNested != nil && attr.MapNested != nil {
+			mergeSDKType(&existingAttr.MapNested.NestedObject, &attr.MapNested.NestedObject)
 			mergeNestedAttributes(&existingAttr.MapNested.NestedObject.Attributes, attr.MapNested.NestedObject.Attributes, computability, isFromResponse)
 		} else if attr.MapNested != nil {
 			existingAttr.MapNested = attr.MapNested
@@ -78,6 +84,31 @@ func addOrUpdate(attr *Attribute, computability ComputedOptionalRequired, merged
 	}
 }
 
+// mergeSDKField keeps the usage of the field in both the request and response bodies, path params only get an SDK field if they are also present in a body
+func mergeSDKField(existingAttr, attr *Attribute) {
+	if attr.SDKField == nil {
+		return
+	}
+	if existingAttr.SDKField == nil {
+		field := *attr.SDKField
+		existingAttr.SDKField = &field
+		return
+	}
+	if attr.SDKField.Request != SDKFieldAbsent {
+		existingAttr.SDKField.Request = attr.SDKField.Request
+	}
+	if attr.SDKField.Response != SDKFieldAbsent {
+		existingAttr.SDKField.Response = attr.SDKField.Response
+	}
+}
+
+// mergeSDKType keeps the SDK type found first, request and response bodies usually share the same nested types
+func mergeSDKType(existingObject, object *NestedAttributeObject) {
+	if existingObject.SDKType == "" {
+		existingObject.SDKType = object.SDKType
+	}
+}
+
 func mergeAttributes(pathParams, createRequest, createResponse, readResponse Attributes) Attributes {
 	merged := make(map[string]*Attribute)
 
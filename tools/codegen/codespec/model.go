@@ -16,8 +16,56 @@ type Model struct {
 }
 
 type Resource struct {
-	Schema *Schema
-	Name   SnakeCaseString
+	Schema     *Schema
+	Operations Operations
+	SDKTypes   SDKTypes
+	Name       SnakeCaseString
+	Generate   Generate
+}
+
+// Generate are the files generated in addition to the schema, as defined in the generate option of the config.
+type Generate struct {
+	Model    bool
+	Resource bool
+}
+
+// Operations are the Atlas SDK calls of the resource and its data sources, Update, Delete and the data source reads are nil if the config doesn't define them.
+type Operations struct {
+	Create               *APIOperation
+	Read                 *APIOperation
+	Update               *APIOperation
+	Delete               *APIOperation
+	DataSourceRead       *APIOperation
+	PluralDataSourceRead *APIOperation
+	// StateSDKField is the read response field polled by the operations that wait for a state transition.
+	StateSDKField string
+}
+
+type APIOperation struct {
+	Wait            *Wait
+	SDKAPI          string
+	SDKMethod       string
+	SDKResponseType string
+	// SDKResultsType is the item type of the results array in list responses.
+	SDKResultsType  string
+	PathParams      []SnakeCaseString
+	HasRequestBody  bool
+	HasResponseBody bool
+	// Paginated is true for list operations accepting the pageNum query param.
+	Paginated bool
+}
+
+type Wait struct {
+	PendingStates []string
+	TargetStates  []string
+}
+
+// SDKTypes are the names of the Atlas SDK structs used as request and response bodies, derived from the schema references of the OpenAPI operations.
+// An empty name means the body schema is not a reference so no conversion is generated for it.
+type SDKTypes struct {
+	CreateRequest string
+	UpdateRequest string
+	ReadResponse  string
 }
 
 type Schema struct {
@@ -45,6 +93,7 @@ type Attribute struct {
 	Int64        *Int64Attribute
 	SingleNested *SingleNestedAttribute
 	Timeouts     *TimeoutsAttribute
+	SDKField     *SDKField
 
 	Description              *string
 	Name                     SnakeCaseString
@@ -53,6 +102,20 @@ type Attribute struct {
 	ComputedOptionalRequired ComputedOptionalRequired
 }
 
+func (a *Attribute) nestedObject() *NestedAttributeObject {
+	switch {
+	case a.ListNested != nil:
+		return &a.ListNested.NestedObject
+	case a.SetNested != nil:
+		return &a.SetNested.NestedObject
+	case a.SingleNested != nil:
+		return &a.SingleNested.NestedObject
+	case a.MapNested != nil:
+		return &a.MapNested.NestedObject
+	}
+	return nil
+}
+
 type BoolAttribute struct {
 	Default *bool
 }
@@ -97,9 +160,28 @@ type ListNestedAttribute struct {
 	NestedObject NestedAttributeObject
 }
 type NestedAttributeObject struct {
+	SDKType    string
 	Attributes Attributes
 }
 
+// SDKField describes the Atlas SDK struct field an attribute is converted from and to, nil for attributes that are not part of the request or response bodies like path params.
+// Request is the usage in the create request, UpdateRequest is only set for fields also present in the create request.
+type SDKField struct {
+	Name          string
+	Format        string
+	Request       SDKFieldUsage
+	UpdateRequest SDKFieldUsage
+	Response      SDKFieldUsage
+}
+
+type SDKFieldUsage int
+
+const (
+	SDKFieldAbsent SDKFieldUsage = iota
+	SDKFieldPointer
+	SDKFieldValue
+)
+
 type TimeoutsAttribute struct {
 	ConfigurableTimeouts []Operation
 }
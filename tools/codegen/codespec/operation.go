@@ -0,0 +1,133 @@
+package codespec
+
+import (
+	"log"
+	"regexp"
+	"strings"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/config"
+)
+
+const (
+	defaultStateProperty = "state"
+	oasPageNumParam      = "pageNum"
+	oasResultsProperty   = "results"
+)
+
+var (
+	pathParamPlaceholder = regexp.MustCompile(`{([^}]+)}`)
+	nonAlphanumeric      = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+)
+
+func resourceOperations(oasResource *APISpecResource, resourceConfig *config.Resource) Operations {
+	aliases := resourceConfig.SchemaOptions.Aliases
+	stateProperty := resourceConfig.StateProperty
+	if stateProperty == "" {
+		stateProperty = defaultStateProperty
+	}
+	ops := Operations{
+		Create:        newAPIOperation(oasResource.CreateOp, resourceConfig.Create, aliases),
+		Read:          newAPIOperation(oasResource.ReadOp, resourceConfig.Read, aliases),
+		Update:        newAPIOperation(oasResource.UpdateOp, resourceConfig.Update, aliases),
+		Delete:        newAPIOperation(oasResource.DeleteOp, resourceConfig.Delete, aliases),
+		StateSDKField: sdkName(stateProperty),
+	}
+	if ds := resourceConfig.DataSource; ds != nil {
+		dsReadConfig := resourceConfig.Read
+		if ds.Read != nil {
+			dsReadConfig = ds.Read
+		}
+		ops.DataSourceRead = newAPIOperation(oasResource.DataSourceReadOp, dsReadConfig, aliases)
+	}
+	if ds := resourceConfig.PluralDataSource; ds != nil {
+		ops.PluralDataSourceRead = newAPIOperation(oasResource.PluralReadOp, ds.Read, aliases)
+	}
+	return ops
+}
+
+func newAPIOperation(op *high.Operation, apiOp *config.APIOperation, aliases map[string]string) *APIOperation {
+	if op == nil || apiOp == nil {
+		return nil
+	}
+	if len(op.Tags) == 0 {
+		log.Printf("[WARN] Operation has no tags so the SDK API can't be derived (OperationId: %s)", op.OperationId)
+	}
+
+	// the SDK only returns the response body if the response content has a schema
+	_, responseErr := buildSchemaFromResponse(op)
+	_, requestErr := buildSchemaFromRequest(op)
+
+	result := &APIOperation{
+		SDKAPI:          sdkAPIName(op.Tags),
+		SDKMethod:       sdkName(op.OperationId),
+		SDKResponseType: opResponseSDKType(op),
+		PathParams:      pathParamNames(apiOp.Path, aliases),
+		HasRequestBody:  requestErr == nil,
+		HasResponseBody: responseErr == nil,
+		SDKResultsType:  opResultsSDKType(op),
+		Paginated:       hasQueryParam(op, oasPageNumParam),
+	}
+	if len(apiOp.PendingStates) > 0 || len(apiOp.TargetStates) > 0 {
+		result.Wait = &Wait{
+			PendingStates: apiOp.PendingStates,
+			TargetStates:  apiOp.TargetStates,
+		}
+	}
+	return result
+}
+
+// sdkAPIName follows the naming of the SDK generator, which groups operations by their first tag, e.g. Push-Based Log Export results in PushBasedLogExportApi.
+func sdkAPIName(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	words := nonAlphanumeric.Split(tags[0], -1)
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, "") + "Api"
+}
+
+// pathParamNames returns the attribute names of the path params in the order they appear in the path, which is the order of the SDK method arguments.
+func pathParamNames(path string, aliases map[string]string) []SnakeCaseString {
+	var names []SnakeCaseString
+	for _, match := range pathParamPlaceholder.FindAllStringSubmatch(path, -1) {
+		name := terraformAttrName(match[1])
+		if alias, ok := aliases[name.SnakeCase()]; ok {
+			name = SnakeCaseString(alias)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// opResultsSDKType returns the SDK type of the items of paginated list responses, which contain them in the results property.
+func opResultsSDKType(op *high.Operation) string {
+	responseSchema, err := buildSchemaFromResponse(op)
+	if err != nil || responseSchema.Schema.Properties == nil {
+		return ""
+	}
+	resultsProxy := responseSchema.Schema.Properties.GetOrZero(oasResultsProperty)
+	if resultsProxy == nil {
+		return ""
+	}
+	results, err := BuildSchema(resultsProxy)
+	if err != nil || results.Schema.Items == nil || !results.Schema.Items.IsA() {
+		return ""
+	}
+	items := &APISpecSchema{Reference: results.Schema.Items.A.GetReference()}
+	return items.GetSDKType()
+}
+
+func hasQueryParam(op *high.Operation, name string) bool {
+	for _, param := range op.Parameters {
+		if param.In == OASQueryParam && param.Name == name {
+			return true
+		}
+	}
+	return false
+}
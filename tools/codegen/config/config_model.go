@@ -1,20 +1,42 @@
 package config
 
+const (
+	GenerateModel    = "model"
+	GenerateResource = "resource"
+)
+
 type Config struct {
 	Resources map[string]Resource `yaml:"resources"`
 }
 
 type Resource struct {
-	Create        *APIOperation `yaml:"create"`
-	Read          *APIOperation `yaml:"read"`
-	Update        *APIOperation `yaml:"update"`
-	Delete        *APIOperation `yaml:"delete"`
-	SchemaOptions SchemaOptions `yaml:"schema"`
+	Create           *APIOperation `yaml:"create"`
+	Read             *APIOperation `yaml:"read"`
+	Update           *APIOperation `yaml:"update"`
+	Delete           *APIOperation `yaml:"delete"`
+	DataSource       *DataSource   `yaml:"data_source"`
+	PluralDataSource *DataSource   `yaml:"plural_data_source"`
+	StateProperty    string        `yaml:"state_property"`
+	SchemaOptions    SchemaOptions `yaml:"schema"`
+	// Generate lists the files generated in addition to resource_schema.go: model for model.go, resource for resource.go and state_transition.go.
+	// Resources with hand-written implementations don't define it so their files are not overwritten.
+	Generate []string `yaml:"generate"`
+}
+
+// DataSource defines the read operation of a data source generated from the resource schema, the resource must generate model.
+// The singular data source uses the read operation of the resource if not defined, the plural data source must define the list operation.
+// Resources with hand-written data sources don't define them so their files are not overwritten.
+type DataSource struct {
+	Read *APIOperation `yaml:"read"`
 }
 
+// APIOperation defines the endpoint of a resource operation.
+// If pending_states and target_states are defined, the generated resource waits for the state returned by the read operation (state_property of the resource) to reach one of the target states.
 type APIOperation struct {
-	Path   string `yaml:"path"`
-	Method string `yaml:"method"`
+	Path          string   `yaml:"path"`
+	Method        string   `yaml:"method"`
+	PendingStates []string `yaml:"pending_states"`
+	TargetStates  []string `yaml:"target_states"`
 }
 
 type SchemaOptions struct {
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/codespec"
 	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/openapi"
@@ -11,37 +14,152 @@ import (
 )
 
 const (
-	atlasAdminAPISpecURL = "https://raw.githubusercontent.com/mongodb/atlas-sdk-go/main/openapi/atlas-api-transformed.yaml"
-	configPath           = "tools/codegen/config.yml"
-	specFilePath         = "tools/codegen/open-api-spec.yml"
+	configPath       = "tools/codegen/config.yml"
+	specFilePath     = "tools/codegen/open-api-spec.yml"
+	goModPath        = "go.mod"
+	goModSpecVersion = "go.mod"
 )
 
 func main() {
-	resourceName := getOsArg()
+	specPath := flag.String("spec-path", "", "path of a local Atlas Admin API spec, the spec is not downloaded if defined")
+	specVersion := flag.String("spec-version", openapi.LatestSpecVersion, "atlas-sdk-go release tag or branch of the spec to download, use go.mod for the SDK version required in go.mod")
+	check := flag.Bool("check", false, "generate schemas in memory and fail if they differ from the committed generated resource_schema.go files")
+	flag.Parse()
+	resourceName := getResourceNameArg()
 
-	if err := openapi.DownloadOpenAPISpec(atlasAdminAPISpecURL, specFilePath); err != nil {
-		log.Fatalf("an error occurred when downloading Atlas Admin API spec: %v", err)
+	if *specPath == "" {
+		*specPath = specFilePath
+		if err := downloadSpec(*specVersion); err != nil {
+			log.Fatalf("an error occurred when downloading Atlas Admin API spec: %v", err)
+		}
 	}
 
-	model, err := codespec.ToCodeSpecModel(specFilePath, configPath, resourceName)
+	model, err := codespec.ToCodeSpecModel(*specPath, configPath, resourceName)
 	if err != nil {
 		log.Fatalf("an error occurred while generating codespec.Model: %v", err)
 	}
 
+	if *check {
+		checkSchemas(model)
+		return
+	}
+
 	for i := range model.Resources {
 		resourceModel := model.Resources[i]
-		schemaCode := schema.GenerateGoCode(resourceModel)
-		if err := writeToFile(fmt.Sprintf("internal/service/%s/resource_schema.go", resourceModel.Name.LowerCaseNoUnderscore()), schemaCode); err != nil {
+		fileName := schemaFileName(&resourceModel)
+		committed, err := readSchemaFile(fileName)
+		if err != nil {
+			log.Fatalf("an error occurred when reading file: %v", err)
+		}
+		if committed != nil && !isGeneratedSchema(committed) {
+			log.Printf("[WARN] %s is hand-written, schema of resource %s is not generated", fileName, resourceModel.Name)
+		} else if err := writeToFile(fileName, schema.GenerateGoCode(resourceModel)); err != nil {
 			log.Fatalf("an error occurred when writing content to file: %v", err)
 		}
+
+		if resourceModel.SDKTypes == (codespec.SDKTypes{}) {
+			log.Printf("[WARN] No SDK types found for resource %s, model conversions and resource operations are not generated", resourceModel.Name)
+			continue
+		}
+
+		if resourceModel.Generate.Model {
+			modelCode := schema.GenerateModelGoCode(resourceModel)
+			if err := writeToFile(fmt.Sprintf("internal/service/%s/model.go", resourceModel.Name.LowerCaseNoUnderscore()), modelCode); err != nil {
+				log.Fatalf("an error occurred when writing content to file: %v", err)
+			}
+		}
+
+		if resourceModel.Generate.Resource {
+			resourceCode := schema.GenerateResourceGoCode(resourceModel)
+			if err := writeToFile(fmt.Sprintf("internal/service/%s/resource.go", resourceModel.Name.LowerCaseNoUnderscore()), resourceCode); err != nil {
+				log.Fatalf("an error occurred when writing content to file: %v", err)
+			}
+
+			if schema.HasStateTransition(resourceModel) {
+				stateTransitionCode := schema.GenerateStateTransitionGoCode(resourceModel)
+				if err := writeToFile(fmt.Sprintf("internal/service/%s/state_transition.go", resourceModel.Name.LowerCaseNoUnderscore()), stateTransitionCode); err != nil {
+					log.Fatalf("an error occurred when writing content to file: %v", err)
+				}
+			}
+		}
+
+		if resourceModel.Operations.DataSourceRead != nil {
+			dataSourceCode := schema.GenerateDataSourceGoCode(resourceModel)
+			if err := writeToFile(fmt.Sprintf("internal/service/%s/data_source.go", resourceModel.Name.LowerCaseNoUnderscore()), dataSourceCode); err != nil {
+				log.Fatalf("an error occurred when writing content to file: %v", err)
+			}
+		}
+
+		if schema.HasPluralDataSource(resourceModel) {
+			pluralDataSourceCode := schema.GeneratePluralDataSourceGoCode(resourceModel)
+			if err := writeToFile(fmt.Sprintf("internal/service/%s/plural_data_source.go", resourceModel.Name.LowerCaseNoUnderscore()), pluralDataSourceCode); err != nil {
+				log.Fatalf("an error occurred when writing content to file: %v", err)
+			}
+		}
 	}
 }
 
-func getOsArg() *string {
-	if len(os.Args) < 2 {
+func getResourceNameArg() *string {
+	if flag.NArg() < 1 {
 		return nil
 	}
-	return &os.Args[1]
+	resourceName := flag.Arg(0)
+	return &resourceName
+}
+
+func downloadSpec(version string) error {
+	if version == goModSpecVersion {
+		sdkVersion, err := openapi.SDKVersionFromGoMod(goModPath, openapi.SDKModulePath)
+		if err != nil {
+			return err
+		}
+		version = sdkVersion
+	}
+	log.Printf("Downloading Atlas Admin API spec version %s", version)
+	return openapi.DownloadOpenAPISpec(openapi.SpecURL(version), specFilePath)
+}
+
+// checkSchemas exits with an error if the committed schemas differ from the generated ones, other generated files are not checked as they can be manually adjusted.
+// Only the schema files owned by the generator are checked, the ones that are not committed or are hand-written are skipped.
+func checkSchemas(model *codespec.Model) {
+	var outdated []string
+	checked := 0
+	for i := range model.Resources {
+		fileName := schemaFileName(&model.Resources[i])
+		committed, err := readSchemaFile(fileName)
+		if err != nil {
+			log.Fatalf("an error occurred when reading file: %v", err)
+		}
+		if committed == nil || !isGeneratedSchema(committed) {
+			log.Printf("Skipping resource %s, %s is not generated", model.Resources[i].Name, fileName)
+			continue
+		}
+		checked++
+		if !bytes.Equal(committed, []byte(schema.GenerateGoCode(model.Resources[i]))) {
+			outdated = append(outdated, fileName)
+		}
+	}
+	if len(outdated) > 0 {
+		log.Fatalf("generated schemas differ from committed files, run make generate-schema to update them:\n%s", strings.Join(outdated, "\n"))
+	}
+	log.Printf("Committed schemas of %d resources are up to date", checked)
+}
+
+// readSchemaFile returns nil content if the file doesn't exist.
+func readSchemaFile(fileName string) ([]byte, error) {
+	content, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return content, err
+}
+
+func isGeneratedSchema(content []byte) bool {
+	return bytes.HasPrefix(content, []byte(schema.GeneratedFileHeader))
+}
+
+func schemaFileName(resourceModel *codespec.Resource) string {
+	return fmt.Sprintf("internal/service/%s/resource_schema.go", resourceModel.Name.LowerCaseNoUnderscore())
 }
 
 func writeToFile(fileName, content string) error {
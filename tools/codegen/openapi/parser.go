@@ -13,7 +13,10 @@ import (
 )
 
 func ParseAtlasAdminAPI(filePath string) (*libopenapi.DocumentModel[v3.Document], error) {
-	atlasAPISpec, _ := os.ReadFile(filePath)
+	atlasAPISpec, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read spec file: %w", err)
+	}
 	document, err := libopenapi.NewDocument(atlasAPISpec)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create new document: %e", err)
@@ -48,6 +51,10 @@ func DownloadOpenAPISpec(url, specFilePath string) (err error) {
 		defer res.Body.Close()
 	}
 
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d downloading %s", res.StatusCode, url)
+	}
+
 	body, readErr := io.ReadAll(res.Body)
 	if readErr != nil {
 		return readErr
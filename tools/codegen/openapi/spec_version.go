@@ -0,0 +1,73 @@
+package openapi
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+	"golang.org/x/mod/modfile"
+)
+
+const (
+	// LatestSpecVersion is the branch of atlas-sdk-go with the latest spec, it can be ahead of the SDK version used by the provider.
+	LatestSpecVersion = "main"
+	specURLFormat     = "https://raw.githubusercontent.com/mongodb/atlas-sdk-go/%s/openapi/atlas-api-transformed.yaml"
+	sdkModulePrefix   = "go.mongodb.org/atlas-sdk/"
+)
+
+// SDKModulePath is the SDK module used by the provider and the generated code.
+var SDKModulePath = strings.TrimSuffix(reflect.TypeOf(admin.APIClient{}).PkgPath(), "/admin")
+
+// SpecURL returns the URL of the Atlas Admin API spec published in atlas-sdk-go for a release tag (e.g. v20241113004.1.0) or branch.
+func SpecURL(version string) string {
+	return fmt.Sprintf(specURLFormat, version)
+}
+
+// SDKVersionFromGoMod returns the version of the SDK module required in the go.mod file, which is also the atlas-sdk-go release tag of its spec.
+func SDKVersionFromGoMod(goModPath, sdkModulePath string) (string, error) {
+	goMod, err := parseGoMod(goModPath)
+	if err != nil {
+		return "", err
+	}
+	for _, req := range goMod.Require {
+		if req.Mod.Path == sdkModulePath {
+			return req.Mod.Version, nil
+		}
+	}
+	return "", fmt.Errorf("module %s is not required in %s", sdkModulePath, goModPath)
+}
+
+// SDKVersionsFromGoMod returns the versions of all the SDK modules required in the go.mod file starting with the newest module,
+// older modules are still used for the API versions not supported by the newest one.
+func SDKVersionsFromGoMod(goModPath string) ([]string, error) {
+	goMod, err := parseGoMod(goModPath)
+	if err != nil {
+		return nil, err
+	}
+	var modules []string
+	versions := map[string]string{}
+	for _, req := range goMod.Require {
+		if strings.HasPrefix(req.Mod.Path, sdkModulePrefix) {
+			modules = append(modules, req.Mod.Path)
+			versions[req.Mod.Path] = req.Mod.Version
+		}
+	}
+	slices.Sort(modules)
+	slices.Reverse(modules)
+	result := make([]string, len(modules))
+	for i, module := range modules {
+		result[i] = versions[module]
+	}
+	return result, nil
+}
+
+func parseGoMod(goModPath string) (*modfile.File, error) {
+	content, err := os.ReadFile(goModPath)
+	if err != nil {
+		return nil, err
+	}
+	return modfile.ParseLax(goModPath, content, nil)
+}
@@ -0,0 +1,40 @@
+package openapi_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/openapi"
+)
+
+const goModContent = `module example.com/test
+
+go 1.23
+
+require (
+	github.com/stretchr/testify v1.10.0
+	go.mongodb.org/atlas-sdk/v20240805005 v20240805005.0.0
+	go.mongodb.org/atlas-sdk/v20241113004 v20241113004.1.0
+)
+`
+
+func TestSDKVersionFromGoMod(t *testing.T) {
+	goModPath := filepath.Join(t.TempDir(), "go.mod")
+	require.NoError(t, os.WriteFile(goModPath, []byte(goModContent), 0o600))
+
+	version, err := openapi.SDKVersionFromGoMod(goModPath, "go.mongodb.org/atlas-sdk/v20241113004")
+	require.NoError(t, err)
+	assert.Equal(t, "v20241113004.1.0", version)
+	assert.Equal(t, "https://raw.githubusercontent.com/mongodb/atlas-sdk-go/v20241113004.1.0/openapi/atlas-api-transformed.yaml", openapi.SpecURL(version))
+
+	_, err = openapi.SDKVersionFromGoMod(goModPath, "go.mongodb.org/atlas-sdk/v20250101001")
+	require.Error(t, err)
+
+	versions, err := openapi.SDKVersionsFromGoMod(goModPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v20241113004.1.0", "v20240805005.0.0"}, versions)
+}
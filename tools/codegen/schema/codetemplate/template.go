@@ -9,6 +9,21 @@ import (
 //go:embed schema-file.go.tmpl
 var schemaFileTemplate string
 
+//go:embed model-file.go.tmpl
+var modelFileTemplate string
+
+//go:embed resource-file.go.tmpl
+var resourceFileTemplate string
+
+//go:embed state-transition-file.go.tmpl
+var stateTransitionFileTemplate string
+
+//go:embed data-source-file.go.tmpl
+var dataSourceFileTemplate string
+
+//go:embed plural-data-source-file.go.tmpl
+var pluralDataSourceFileTemplate string
+
 type SchemaFileInputs struct {
 	PackageName      string
 	SchemaAttributes string
@@ -16,8 +31,104 @@ type SchemaFileInputs struct {
 	Imports          []string
 }
 
+type ModelFileInputs struct {
+	PackageName string
+	Conversions string
+	Imports     []string
+}
+
+type ResourceFileInputs struct {
+	Create                *OperationInputs
+	Read                  *OperationInputs
+	Update                *OperationInputs
+	Delete                *OperationInputs
+	PackageName           string
+	ResourceName          string
+	ImportAttributes      []string
+	NonResponseAttributes []string
+}
+
+// OperationInputs contain the code snippets of an operation, Call is the SDK call of the operation and ReadCall the SDK call of the read operation.
+type OperationInputs struct {
+	Wait                     *WaitInputs
+	Call                     string
+	ReadCall                 string
+	Conversion               string
+	Verb                     string
+	HasResponseBody          bool
+	UseResponse              bool
+	SetNonResponseAttributes bool
+}
+
+type WaitInputs struct {
+	ClientAPI     string
+	PendingStates string
+	TargetStates  string
+	Timeout       string
+	PastVerb      string
+}
+
+type StateTransitionFileInputs struct {
+	PackageName   string
+	SDKImport     string
+	ClientAPI     string
+	ResponseType  string
+	ReadCall      string
+	StateSDKField string
+}
+
+// DataSourceFileInputs contain the model used by the data source, DSModelStruct is only set if the model is defined in the generated file.
+type DataSourceFileInputs struct {
+	PackageName           string
+	DSModel               string
+	DSModelStruct         string
+	RequiredFields        string
+	ReadCall              string
+	DSModelFields         []string
+	NonResponseAttributes []string
+}
+
+// PluralDataSourceFileInputs contain the list request of the plural data source, which is executed for every page if Paginated.
+type PluralDataSourceFileInputs struct {
+	PackageName               string
+	SDKImport                 string
+	DSModel                   string
+	DSModelStruct             string
+	RequiredFields            string
+	ListRequest               string
+	ResultsType               string
+	RootFields                []string
+	DSModelFields             []string
+	RootNonResponseAttributes []string
+	Paginated                 bool
+}
+
 func ApplySchemaFileTemplate(inputs SchemaFileInputs) bytes.Buffer {
-	t, err := template.New("template").Parse(schemaFileTemplate)
+	return applyTemplate(schemaFileTemplate, inputs)
+}
+
+func ApplyModelFileTemplate(inputs ModelFileInputs) bytes.Buffer {
+	return applyTemplate(modelFileTemplate, inputs)
+}
+
+func ApplyResourceFileTemplate(inputs ResourceFileInputs) bytes.Buffer {
+	return applyTemplate(resourceFileTemplate, inputs)
+}
+
+func ApplyStateTransitionFileTemplate(inputs StateTransitionFileInputs) bytes.Buffer {
+	return applyTemplate(stateTransitionFileTemplate, inputs)
+}
+
+func ApplyDataSourceFileTemplate(inputs DataSourceFileInputs) bytes.Buffer {
+	return applyTemplate(dataSourceFileTemplate, inputs)
+}
+
+func ApplyPluralDataSourceFileTemplate(inputs PluralDataSourceFileInputs) bytes.Buffer {
+	return applyTemplate(pluralDataSourceFileTemplate, inputs)
+}
+
+func applyTemplate(fileTemplate string, inputs any) bytes.Buffer {
+	t, err := template.New("template").Parse(fileTemplate)
 	if err != nil {
 		panic(err)
 	}
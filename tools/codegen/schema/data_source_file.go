@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"log"
+	"slices"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/codespec"
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/schema/codetemplate"
+)
+
+const (
+	resourceModel   = "TFModel"
+	dataSourceModel = "TFDSModel"
+)
+
+// GenerateDataSourceGoCode generates the data source calling the read operation of the data source, its schema is derived from the resource schema.
+func GenerateDataSourceGoCode(input codespec.Resource) string {
+	attrs := input.Schema.Attributes
+	op := input.Operations.DataSourceRead
+	dsModel, dsModelStruct, dsModelFields := dataSourceModelInputs(attrs)
+
+	tmplInputs := codetemplate.DataSourceFileInputs{
+		PackageName:           input.Name.LowerCaseNoUnderscore(),
+		DSModel:               dsModel,
+		DSModelStruct:         dsModelStruct,
+		DSModelFields:         dsModelFields,
+		RequiredFields:        stringSliceLiteral(importAttributes(op)),
+		ReadCall:              sdkCall("", attrs, op, "tfConfig", ""),
+		NonResponseAttributes: nonResponseAttributes(dataSourceAttributes(attrs)),
+	}
+	result := codetemplate.ApplyDataSourceFileTemplate(tmplInputs)
+	return formatCode(result.Bytes())
+}
+
+// GeneratePluralDataSourceGoCode generates the plural data source calling the list operation, all pages are read if the operation is paginated.
+func GeneratePluralDataSourceGoCode(input codespec.Resource) string {
+	attrs := input.Schema.Attributes
+	op := input.Operations.PluralDataSourceRead
+	dsModel, dsModelStruct, dsModelFields := dataSourceModelInputs(attrs)
+	if input.Operations.DataSourceRead != nil {
+		dsModelStruct, dsModelFields = "", nil // already defined by the data source
+	}
+
+	var rootFields, requiredFields, rootNonResponseAttrs []string
+	for _, name := range op.PathParams {
+		attr := findAttribute(attrs, name)
+		if attr == nil {
+			continue
+		}
+		rootFields = append(rootFields, typedModelProperty(attr))
+		requiredFields = append(requiredFields, name.SnakeCase())
+		if !inResponse(attr) {
+			rootNonResponseAttrs = append(rootNonResponseAttrs, name.PascalCase())
+		}
+	}
+	for i := range attrs {
+		attr := &attrs[i]
+		if attr.Timeouts == nil && !inResponse(attr) && !slices.Contains(op.PathParams, attr.Name) {
+			log.Printf("[WARN] Attribute %s of %s is not returned by %s, it's null in the plural data source results", attr.Name, input.Name, op.SDKMethod)
+		}
+	}
+
+	tmplInputs := codetemplate.PluralDataSourceFileInputs{
+		PackageName:               input.Name.LowerCaseNoUnderscore(),
+		SDKImport:                 sdkImportStatement,
+		DSModel:                   dsModel,
+		DSModelStruct:             dsModelStruct,
+		DSModelFields:             dsModelFields,
+		RequiredFields:            stringSliceLiteral(requiredFields),
+		ListRequest:               sdkRequest("", attrs, op, "tfConfig", ""),
+		ResultsType:               op.SDKResultsType,
+		RootFields:                rootFields,
+		RootNonResponseAttributes: rootNonResponseAttrs,
+		Paginated:                 op.Paginated,
+	}
+	result := codetemplate.ApplyPluralDataSourceFileTemplate(tmplInputs)
+	return formatCode(result.Bytes())
+}
+
+// HasPluralDataSource is true if the plural data source is defined and its results can be converted with the conversion of the read response.
+func HasPluralDataSource(input codespec.Resource) bool {
+	op := input.Operations.PluralDataSourceRead
+	if op == nil {
+		return false
+	}
+	if op.SDKResultsType != input.SDKTypes.ReadResponse {
+		log.Printf("[WARN] Results of %s (%s) don't match the read response (%s), plural data source of %s is not generated", op.SDKMethod, op.SDKResultsType, input.SDKTypes.ReadResponse, input.Name)
+		return false
+	}
+	return true
+}
+
+// dataSourceModelInputs returns the model of the data sources, the resource model is used unless it contains timeouts which are not part of data source schemas.
+func dataSourceModelInputs(attrs codespec.Attributes) (model, modelStruct string, modelFields []string) {
+	dsAttrs := dataSourceAttributes(attrs)
+	if len(dsAttrs) == len(attrs) {
+		return resourceModel, "", nil
+	}
+	for i := range dsAttrs {
+		modelFields = append(modelFields, dsAttrs[i].Name.PascalCase())
+	}
+	return dataSourceModel, generateStructOfTypedModel(dsAttrs, "DS").Code, modelFields
+}
+
+func dataSourceAttributes(attrs codespec.Attributes) codespec.Attributes {
+	var dsAttrs codespec.Attributes
+	for i := range attrs {
+		if attrs[i].Timeouts == nil {
+			dsAttrs = append(dsAttrs, attrs[i])
+		}
+	}
+	return dsAttrs
+}
@@ -0,0 +1,74 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/codespec"
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/schema"
+	"github.com/sebdah/goldie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	readOp = &codespec.APIOperation{SDKAPI: "TestApi", SDKMethod: "GetTestName", SDKResponseType: "TestName", PathParams: pathParams, HasResponseBody: true}
+	listOp = &codespec.APIOperation{
+		SDKAPI: "TestApi", SDKMethod: "ListTestNames", SDKResponseType: "PaginatedTestName", SDKResultsType: "TestName",
+		PathParams: []codespec.SnakeCaseString{"project_id"}, HasResponseBody: true, Paginated: true,
+	}
+)
+
+func TestDataSourceGenerationFromCodeSpec(t *testing.T) {
+	inputModel := codespec.Resource{
+		Name:       "test_name",
+		SDKTypes:   codespec.SDKTypes{ReadResponse: "TestName"},
+		Schema:     &codespec.Schema{Attributes: resourceAttrs},
+		Operations: codespec.Operations{Read: readOp, DataSourceRead: readOp},
+	}
+	result := schema.GenerateDataSourceGoCode(inputModel)
+	g := goldie.New(t, goldie.WithNameSuffix(".golden.go"))
+	g.Assert(t, "data-source", []byte(result))
+}
+
+func TestPluralDataSourceGenerationFromCodeSpec(t *testing.T) {
+	notPaginatedListOp := *listOp
+	notPaginatedListOp.Paginated = false
+
+	testCases := map[string]schemaGenerationTestCase{
+		"Paginated list with data source model": {
+			inputModel: codespec.Resource{
+				Name:       "test_name",
+				SDKTypes:   codespec.SDKTypes{ReadResponse: "TestName"},
+				Schema:     &codespec.Schema{Attributes: resourceAttrs},
+				Operations: codespec.Operations{Read: readOp, DataSourceRead: readOp, PluralDataSourceRead: listOp},
+			},
+			goldenFileName: "paginated-plural-data-source",
+		},
+		"Not paginated list with resource model": {
+			inputModel: codespec.Resource{
+				Name:       "test_name",
+				SDKTypes:   codespec.SDKTypes{ReadResponse: "TestName"},
+				Schema:     &codespec.Schema{Attributes: resourceAttrs[:len(resourceAttrs)-1]},
+				Operations: codespec.Operations{Read: readOp, PluralDataSourceRead: &notPaginatedListOp},
+			},
+			goldenFileName: "plural-data-source",
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			result := schema.GeneratePluralDataSourceGoCode(tc.inputModel)
+			g := goldie.New(t, goldie.WithNameSuffix(".golden.go"))
+			g.Assert(t, tc.goldenFileName, []byte(result))
+		})
+	}
+}
+
+func TestHasPluralDataSource(t *testing.T) {
+	resource := codespec.Resource{
+		SDKTypes:   codespec.SDKTypes{ReadResponse: "TestName"},
+		Operations: codespec.Operations{PluralDataSourceRead: listOp},
+	}
+	assert.True(t, schema.HasPluralDataSource(resource))
+	resource.SDKTypes.ReadResponse = "OtherTestName"
+	assert.False(t, schema.HasPluralDataSource(resource))
+}
@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"go/format"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/codespec"
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/schema/codetemplate"
+)
+
+func GenerateModelGoCode(input codespec.Resource) string {
+	conversions := GenerateConversions(input)
+
+	tmplInputs := codetemplate.ModelFileInputs{
+		PackageName: input.Name.LowerCaseNoUnderscore(),
+		Imports:     conversions.Imports,
+		Conversions: conversions.Code,
+	}
+	result := codetemplate.ApplyModelFileTemplate(tmplInputs)
+
+	formattedResult, err := format.Source(result.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return string(formattedResult)
+}
@@ -0,0 +1,218 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/codespec"
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/schema"
+	"github.com/sebdah/goldie/v2"
+)
+
+var (
+	requestAndResponseField = func(name string, requestUsage codespec.SDKFieldUsage) *codespec.SDKField {
+		return &codespec.SDKField{Name: name, Request: requestUsage, Response: codespec.SDKFieldPointer}
+	}
+	nestedStringAttr = codespec.Attribute{
+		Name:                     "string_attr",
+		String:                   &codespec.StringAttribute{},
+		SDKField:                 requestAndResponseField("StringAttr", codespec.SDKFieldPointer),
+		ComputedOptionalRequired: codespec.Optional,
+	}
+	nestedIntAttr = codespec.Attribute{
+		Name:                     "int_attr",
+		Int64:                    &codespec.Int64Attribute{},
+		SDKField:                 &codespec.SDKField{Name: "IntAttr", Format: "int32", Request: codespec.SDKFieldValue, Response: codespec.SDKFieldValue},
+		ComputedOptionalRequired: codespec.Required,
+	}
+)
+
+func TestModelGenerationFromCodeSpec(t *testing.T) {
+	testCases := map[string]schemaGenerationTestCase{
+		"Primitive attributes": {
+			inputModel: codespec.Resource{
+				Name:     "test_name",
+				SDKTypes: codespec.SDKTypes{CreateRequest: "TestNameCreateRequest", ReadResponse: "TestName"},
+				Schema: &codespec.Schema{
+					Attributes: []codespec.Attribute{
+						{
+							Name:                     "project_id",
+							String:                   &codespec.StringAttribute{},
+							SDKField:                 &codespec.SDKField{Name: "GroupId", Response: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.Required,
+						},
+						{
+							Name:                     "cluster_name",
+							String:                   &codespec.StringAttribute{},
+							ComputedOptionalRequired: codespec.Required,
+						},
+						{
+							Name:                     "string_attr",
+							String:                   &codespec.StringAttribute{},
+							SDKField:                 requestAndResponseField("StringAttr", codespec.SDKFieldValue),
+							ComputedOptionalRequired: codespec.Required,
+						},
+						{
+							Name:                     "bool_attr",
+							Bool:                     &codespec.BoolAttribute{},
+							SDKField:                 requestAndResponseField("BoolAttr", codespec.SDKFieldPointer),
+							ComputedOptionalRequired: codespec.Optional,
+						},
+						{
+							Name:                     "int_attr",
+							Int64:                    &codespec.Int64Attribute{},
+							SDKField:                 &codespec.SDKField{Name: "IntAttr", Format: "int32", Request: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.ComputedOptional,
+						},
+						{
+							Name:                     "int64_attr",
+							Int64:                    &codespec.Int64Attribute{},
+							SDKField:                 &codespec.SDKField{Name: "Int64Attr", Format: "int64", Request: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.Optional,
+						},
+						{
+							Name:                     "float_attr",
+							Float64:                  &codespec.Float64Attribute{},
+							SDKField:                 &codespec.SDKField{Name: "FloatAttr", Format: "double", Request: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.Optional,
+						},
+						{
+							Name:                     "create_date",
+							String:                   &codespec.StringAttribute{},
+							SDKField:                 &codespec.SDKField{Name: "CreateDate", Format: "date-time", Response: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.Computed,
+						},
+						{
+							Name:                     "number_attr",
+							Number:                   &codespec.NumberAttribute{},
+							SDKField:                 requestAndResponseField("NumberAttr", codespec.SDKFieldPointer),
+							ComputedOptionalRequired: codespec.Optional,
+						},
+						{
+							Name:                     "simple_list_attr",
+							List:                     &codespec.ListAttribute{ElementType: codespec.String},
+							SDKField:                 requestAndResponseField("SimpleListAttr", codespec.SDKFieldValue),
+							ComputedOptionalRequired: codespec.Required,
+						},
+						{
+							Name:                     "simple_set_attr",
+							Set:                      &codespec.SetAttribute{ElementType: codespec.Int64},
+							SDKField:                 requestAndResponseField("SimpleSetAttr", codespec.SDKFieldPointer),
+							ComputedOptionalRequired: codespec.Optional,
+						},
+						{
+							Name:                     "simple_map_attr",
+							Map:                      &codespec.MapAttribute{ElementType: codespec.String},
+							SDKField:                 &codespec.SDKField{Name: "SimpleMapAttr", Response: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.Computed,
+						},
+						{
+							Name:                     "write_only_list_attr",
+							List:                     &codespec.ListAttribute{ElementType: codespec.String},
+							SDKField:                 &codespec.SDKField{Name: "WriteOnlyListAttr", Request: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.Optional,
+						},
+						{
+							Name: "timeouts",
+							Timeouts: &codespec.TimeoutsAttribute{
+								ConfigurableTimeouts: []codespec.Operation{codespec.Create},
+							},
+						},
+					},
+				},
+			},
+			goldenFileName: "primitive-attributes-model",
+		},
+		"Nested attributes": {
+			inputModel: codespec.Resource{
+				Name:     "test_name",
+				SDKTypes: codespec.SDKTypes{CreateRequest: "TestNameCreateRequest", ReadResponse: "TestName"},
+				Schema: &codespec.Schema{
+					Attributes: []codespec.Attribute{
+						{
+							Name:                     "nested_single_attr",
+							SDKField:                 requestAndResponseField("NestedSingleAttr", codespec.SDKFieldValue),
+							ComputedOptionalRequired: codespec.Required,
+							SingleNested: &codespec.SingleNestedAttribute{
+								NestedObject: codespec.NestedAttributeObject{
+									SDKType:    "NestedSingle",
+									Attributes: []codespec.Attribute{nestedStringAttr, nestedIntAttr},
+								},
+							},
+						},
+						{
+							Name:                     "nested_list_attr",
+							SDKField:                 requestAndResponseField("NestedListAttr", codespec.SDKFieldPointer),
+							ComputedOptionalRequired: codespec.Optional,
+							ListNested: &codespec.ListNestedAttribute{
+								NestedObject: codespec.NestedAttributeObject{
+									SDKType: "NestedListItem",
+									Attributes: []codespec.Attribute{
+										nestedStringAttr,
+										{
+											Name:                     "inner_list_attr",
+											SDKField:                 requestAndResponseField("InnerListAttr", codespec.SDKFieldPointer),
+											ComputedOptionalRequired: codespec.Optional,
+											SingleNested: &codespec.SingleNestedAttribute{
+												NestedObject: codespec.NestedAttributeObject{
+													SDKType:    "InnerObject",
+													Attributes: []codespec.Attribute{nestedIntAttr},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Name:                     "set_nested_attribute",
+							SDKField:                 &codespec.SDKField{Name: "SetNestedAttribute", Response: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.Computed,
+							SetNested: &codespec.SetNestedAttribute{
+								NestedObject: codespec.NestedAttributeObject{
+									SDKType:    "NestedSetItem",
+									Attributes: []codespec.Attribute{nestedStringAttr},
+								},
+							},
+						},
+						{
+							Name:                     "map_nested_attribute",
+							SDKField:                 &codespec.SDKField{Name: "MapNestedAttribute", Response: codespec.SDKFieldPointer},
+							ComputedOptionalRequired: codespec.Computed,
+							MapNested: &codespec.MapNestedAttribute{
+								NestedObject: codespec.NestedAttributeObject{
+									Attributes: []codespec.Attribute{nestedStringAttr},
+								},
+							},
+						},
+					},
+				},
+			},
+			goldenFileName: "nested-attributes-model",
+		},
+		"Response only": {
+			inputModel: codespec.Resource{
+				Name:     "test_name",
+				SDKTypes: codespec.SDKTypes{ReadResponse: "TestName"},
+				Schema: &codespec.Schema{
+					Attributes: []codespec.Attribute{
+						{
+							Name:                     "string_attr",
+							String:                   &codespec.StringAttribute{},
+							SDKField:                 &codespec.SDKField{Name: "StringAttr", Response: codespec.SDKFieldValue},
+							ComputedOptionalRequired: codespec.Computed,
+						},
+					},
+				},
+			},
+			goldenFileName: "response-only-model",
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			result := schema.GenerateModelGoCode(tc.inputModel)
+			g := goldie.New(t, goldie.WithNameSuffix(".golden.go"))
+			g.Assert(t, tc.goldenFileName, []byte(result))
+		})
+	}
+}
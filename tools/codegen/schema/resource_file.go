@@ -0,0 +1,209 @@
+package schema
+
+import (
+	"fmt"
+	"go/format"
+	"log"
+	"strings"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/codespec"
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/schema/codetemplate"
+)
+
+// GenerateResourceGoCode generates the framework resource calling the SDK operations of the resource, the conversions are generated by GenerateModelGoCode.
+func GenerateResourceGoCode(input codespec.Resource) string {
+	ops := input.Operations
+	attrs := input.Schema.Attributes
+	nonResponseAttrs := nonResponseAttributes(attrs)
+
+	tmplInputs := codetemplate.ResourceFileInputs{
+		PackageName:           input.Name.LowerCaseNoUnderscore(),
+		ResourceName:          input.Name.SnakeCase(),
+		Create:                applyOperationInputs(&input, ops.Create, "plan", "NewAtlasReq", "creating", "created", codespec.Create),
+		Read:                  &codetemplate.OperationInputs{Call: sdkCall("", attrs, ops.Read, "state", "")},
+		Delete:                deleteOperationInputs(&input),
+		ImportAttributes:      importAttributes(ops.Read),
+		NonResponseAttributes: nonResponseAttrs,
+	}
+	if ops.Update != nil {
+		tmplInputs.Update = applyOperationInputs(&input, ops.Update, "plan", updateConversion(&input), "updating", "updated", codespec.Update)
+	}
+	result := codetemplate.ApplyResourceFileTemplate(tmplInputs)
+	return formatCode(result.Bytes())
+}
+
+// GenerateStateTransitionGoCode generates the state waiter used by the operations that define pending or target states.
+func GenerateStateTransitionGoCode(input codespec.Resource) string {
+	ops := input.Operations
+	tmplInputs := codetemplate.StateTransitionFileInputs{
+		PackageName:   input.Name.LowerCaseNoUnderscore(),
+		SDKImport:     sdkImportStatement,
+		ClientAPI:     ops.Read.SDKAPI,
+		ResponseType:  input.SDKTypes.ReadResponse,
+		ReadCall:      sdkCall("client", input.Schema.Attributes, ops.Read, "model", ""),
+		StateSDKField: ops.StateSDKField,
+	}
+	result := codetemplate.ApplyStateTransitionFileTemplate(tmplInputs)
+	return formatCode(result.Bytes())
+}
+
+// HasStateTransition is true if any operation of the resource waits for a state transition.
+func HasStateTransition(input codespec.Resource) bool {
+	ops := input.Operations
+	for _, op := range []*codespec.APIOperation{ops.Create, ops.Update, ops.Delete} {
+		if op != nil && op.Wait != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func applyOperationInputs(input *codespec.Resource, op *codespec.APIOperation, receiver, conversion, verb, pastVerb string, timeoutOp codespec.Operation) *codetemplate.OperationInputs {
+	attrs := input.Schema.Attributes
+	reqArg := ""
+	if !op.HasRequestBody {
+		conversion = ""
+	} else {
+		reqArg = "atlasReq"
+	}
+	return &codetemplate.OperationInputs{
+		Call:                     sdkCall("", attrs, op, receiver, reqArg),
+		ReadCall:                 sdkCall("", attrs, input.Operations.Read, receiver, ""),
+		Conversion:               conversion,
+		Verb:                     verb,
+		HasResponseBody:          op.HasResponseBody,
+		UseResponse:              op.Wait == nil && op.SDKResponseType != "" && op.SDKResponseType == input.SDKTypes.ReadResponse,
+		SetNonResponseAttributes: len(nonResponseAttributes(attrs)) > 0,
+		Wait:                     waitInputs(input, op, receiver, pastVerb, timeoutOp),
+	}
+}
+
+func deleteOperationInputs(input *codespec.Resource) *codetemplate.OperationInputs {
+	op := input.Operations.Delete
+	if op == nil {
+		return nil
+	}
+	return &codetemplate.OperationInputs{
+		Call:            sdkCall("", input.Schema.Attributes, op, "state", ""),
+		HasResponseBody: op.HasResponseBody,
+		Wait:            waitInputs(input, op, "state", "deleted", codespec.Delete),
+	}
+}
+
+func waitInputs(input *codespec.Resource, op *codespec.APIOperation, receiver, pastVerb string, timeoutOp codespec.Operation) *codetemplate.WaitInputs {
+	if op.Wait == nil {
+		return nil
+	}
+	wait := &codetemplate.WaitInputs{
+		ClientAPI:     input.Operations.Read.SDKAPI,
+		PendingStates: stringSliceLiteral(op.Wait.PendingStates),
+		TargetStates:  stringSliceLiteral(op.Wait.TargetStates),
+		PastVerb:      pastVerb,
+	}
+	if hasConfigurableTimeout(input.Schema.Attributes, timeoutOp) {
+		wait.Timeout = fmt.Sprintf("%s.Timeouts.%s(ctx, defaultTimeout)", receiver, timeoutMethod[timeoutOp])
+	}
+	return wait
+}
+
+var timeoutMethod = map[codespec.Operation]string{
+	codespec.Create: "Create",
+	codespec.Update: "Update",
+	codespec.Delete: "Delete",
+}
+
+func hasConfigurableTimeout(attrs codespec.Attributes, op codespec.Operation) bool {
+	for i := range attrs {
+		if attrs[i].Timeouts == nil {
+			continue
+		}
+		for _, timeoutOp := range attrs[i].Timeouts.ConfigurableTimeouts {
+			if timeoutOp == op {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func updateConversion(input *codespec.Resource) string {
+	if hasUpdateReqConversion(input) {
+		return "NewAtlasUpdateReq"
+	}
+	return "NewAtlasReq"
+}
+
+// sdkCall returns the call of the operation with the path params taken from the TF model in the receiver variable, followed by the request body if any.
+// An empty client calls the SDK API of the operation from the connV2 variable.
+func sdkCall(client string, attrs codespec.Attributes, op *codespec.APIOperation, receiver, reqArg string) string {
+	return sdkRequest(client, attrs, op, receiver, reqArg) + ".Execute()"
+}
+
+// sdkRequest returns the SDK request of the operation before it's executed, so optional params like the page number can be set.
+func sdkRequest(client string, attrs codespec.Attributes, op *codespec.APIOperation, receiver, reqArg string) string {
+	if client == "" {
+		client = "connV2." + op.SDKAPI
+	}
+	args := []string{"ctx"}
+	for _, name := range op.PathParams {
+		field := fmt.Sprintf("%s.%s", receiver, name.PascalCase())
+		attr := findAttribute(attrs, name)
+		switch {
+		case attr == nil:
+			log.Printf("[WARN] Path param %s of %s is not an attribute of the resource", name, op.SDKMethod)
+			args = append(args, field+".ValueString()")
+		case attr.Int64 != nil:
+			args = append(args, fmt.Sprintf("int(%s.ValueInt64())", field))
+		default:
+			args = append(args, field+".ValueString()")
+		}
+	}
+	if reqArg != "" {
+		args = append(args, reqArg)
+	}
+	return fmt.Sprintf("%s.%s(%s)", client, op.SDKMethod, strings.Join(args, ", "))
+}
+
+func importAttributes(readOp *codespec.APIOperation) []string {
+	names := make([]string, len(readOp.PathParams))
+	for i, name := range readOp.PathParams {
+		names[i] = name.SnakeCase()
+	}
+	return names
+}
+
+// nonResponseAttributes returns the model fields of the attributes that can't be set from the read response.
+func nonResponseAttributes(attrs codespec.Attributes) []string {
+	var fields []string
+	for i := range attrs {
+		if !inResponse(&attrs[i]) {
+			fields = append(fields, attrs[i].Name.PascalCase())
+		}
+	}
+	return fields
+}
+
+func findAttribute(attrs codespec.Attributes, name codespec.SnakeCaseString) *codespec.Attribute {
+	for i := range attrs {
+		if attrs[i].Name == name {
+			return &attrs[i]
+		}
+	}
+	return nil
+}
+
+func stringSliceLiteral(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return fmt.Sprintf("[]string{%s}", strings.Join(quoted, ", "))
+}
+
+func formatCode(code []byte) string {
+	formattedResult, err := format.Source(code)
+	if err != nil {
+		panic(err)
+	}
+	return string(formattedResult)
+}
@@ -0,0 +1,108 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/codespec"
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/schema"
+	"github.com/sebdah/goldie/v2"
+)
+
+var (
+	pathParams    = []codespec.SnakeCaseString{"project_id", "cluster_name"}
+	resourceAttrs = codespec.Attributes{
+		{
+			Name:                     "project_id",
+			String:                   &codespec.StringAttribute{},
+			ComputedOptionalRequired: codespec.Required,
+		},
+		{
+			Name:                     "cluster_name",
+			String:                   &codespec.StringAttribute{},
+			ComputedOptionalRequired: codespec.Required,
+		},
+		{
+			Name:                     "string_attr",
+			String:                   &codespec.StringAttribute{},
+			SDKField:                 &codespec.SDKField{Name: "StringAttr", Request: codespec.SDKFieldValue, UpdateRequest: codespec.SDKFieldPointer, Response: codespec.SDKFieldPointer},
+			ComputedOptionalRequired: codespec.Required,
+		},
+		{
+			Name:                     "state_name",
+			String:                   &codespec.StringAttribute{},
+			SDKField:                 &codespec.SDKField{Name: "StateName", Response: codespec.SDKFieldPointer},
+			ComputedOptionalRequired: codespec.Computed,
+		},
+		{
+			Name: "timeouts",
+			Timeouts: &codespec.TimeoutsAttribute{
+				ConfigurableTimeouts: []codespec.Operation{codespec.Create, codespec.Delete},
+			},
+		},
+	}
+)
+
+func TestResourceGenerationFromCodeSpec(t *testing.T) {
+	testCases := map[string]schemaGenerationTestCase{
+		"Operations with state transitions": {
+			inputModel: codespec.Resource{
+				Name:     "test_name",
+				SDKTypes: codespec.SDKTypes{CreateRequest: "TestNameCreateRequest", UpdateRequest: "TestNameUpdateRequest", ReadResponse: "TestName"},
+				Schema:   &codespec.Schema{Attributes: resourceAttrs},
+				Operations: codespec.Operations{
+					Create: &codespec.APIOperation{
+						SDKAPI: "TestApi", SDKMethod: "CreateTestName", PathParams: pathParams, HasRequestBody: true,
+						Wait: &codespec.Wait{PendingStates: []string{"CREATING"}, TargetStates: []string{"IDLE"}},
+					},
+					Read: &codespec.APIOperation{SDKAPI: "TestApi", SDKMethod: "GetTestName", SDKResponseType: "TestName", PathParams: pathParams, HasResponseBody: true},
+					Update: &codespec.APIOperation{
+						SDKAPI: "TestApi", SDKMethod: "UpdateTestName", SDKResponseType: "TestName", PathParams: pathParams, HasRequestBody: true, HasResponseBody: true,
+						Wait: &codespec.Wait{PendingStates: []string{"UPDATING"}, TargetStates: []string{"IDLE"}},
+					},
+					Delete: &codespec.APIOperation{
+						SDKAPI: "TestApi", SDKMethod: "DeleteTestName", PathParams: pathParams,
+						Wait: &codespec.Wait{PendingStates: []string{"DELETING"}, TargetStates: []string{"DELETED"}},
+					},
+					StateSDKField: "StateName",
+				},
+			},
+			goldenFileName: "state-transitions-resource",
+		},
+		"Operations without state transitions": {
+			inputModel: codespec.Resource{
+				Name:     "test_name",
+				SDKTypes: codespec.SDKTypes{CreateRequest: "TestNameCreateRequest", UpdateRequest: "TestNameCreateRequest", ReadResponse: "TestName"},
+				Schema:   &codespec.Schema{Attributes: resourceAttrs},
+				Operations: codespec.Operations{
+					Create: &codespec.APIOperation{SDKAPI: "TestApi", SDKMethod: "CreateTestName", SDKResponseType: "TestName", PathParams: pathParams, HasRequestBody: true, HasResponseBody: true},
+					Read:   &codespec.APIOperation{SDKAPI: "TestApi", SDKMethod: "GetTestName", SDKResponseType: "TestName", PathParams: pathParams, HasResponseBody: true},
+					Update: &codespec.APIOperation{SDKAPI: "TestApi", SDKMethod: "UpdateTestName", PathParams: pathParams, HasRequestBody: true},
+				},
+			},
+			goldenFileName: "no-state-transitions-resource",
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			result := schema.GenerateResourceGoCode(tc.inputModel)
+			g := goldie.New(t, goldie.WithNameSuffix(".golden.go"))
+			g.Assert(t, tc.goldenFileName, []byte(result))
+		})
+	}
+}
+
+func TestStateTransitionGenerationFromCodeSpec(t *testing.T) {
+	inputModel := codespec.Resource{
+		Name:     "test_name",
+		SDKTypes: codespec.SDKTypes{ReadResponse: "TestName"},
+		Schema:   &codespec.Schema{Attributes: resourceAttrs},
+		Operations: codespec.Operations{
+			Read:          &codespec.APIOperation{SDKAPI: "TestApi", SDKMethod: "GetTestName", SDKResponseType: "TestName", PathParams: pathParams, HasResponseBody: true},
+			StateSDKField: "StateName",
+		},
+	}
+	result := schema.GenerateStateTransitionGoCode(inputModel)
+	g := goldie.New(t, goldie.WithNameSuffix(".golden.go"))
+	g.Assert(t, "state-transition", []byte(result))
+}
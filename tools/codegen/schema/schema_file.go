@@ -7,6 +7,9 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/schema/codetemplate"
 )
 
+// GeneratedFileHeader starts the generated schema files, files without it are hand-written and not overwritten or checked.
+const GeneratedFileHeader = "// Code generated by tools/codegen using `make generate-schema`. DO NOT EDIT.\n\n"
+
 func GenerateGoCode(input codespec.Resource) string {
 	schemaAttrs := GenerateSchemaAttributes(input.Schema.Attributes)
 	models := GenerateTypedModels(input.Schema.Attributes)
@@ -27,5 +30,5 @@ func GenerateGoCode(input codespec.Resource) string {
 	if err != nil {
 		panic(err)
 	}
-	return string(formattedResult)
+	return GeneratedFileHeader + string(formattedResult)
 }
@@ -0,0 +1,438 @@
+package schema
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/tools/codegen/codespec"
+)
+
+const (
+	diagImportStatement       = "github.com/hashicorp/terraform-plugin-framework/diag"
+	basetypesImportStatement  = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	conversionImportStatement = "github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+// sdkImportStatement is taken from the SDK version used by the provider so it's updated together with the SDK.
+var sdkImportStatement = reflect.TypeOf(admin.APIClient{}).PkgPath()
+
+var sdkElementType = map[codespec.ElemType]string{
+	codespec.Bool:   "bool",
+	codespec.Int64:  "int",
+	codespec.String: "string",
+}
+
+// GenerateConversions generates NewTFModel from the read response SDK type and NewAtlasReq to the create request SDK type.
+// NewAtlasUpdateReq is also generated if the update request uses a different SDK type.
+// Attributes that can't be converted are logged and left for the conversion to be written by hand.
+func GenerateConversions(input codespec.Resource) CodeStatement {
+	attrs := input.Schema.Attributes
+	stmts := []CodeStatement{}
+	if sdkType := input.SDKTypes.ReadResponse; sdkType != "" {
+		stmts = append(stmts, generateNewTFModel(attrs, sdkType))
+		stmts = append(stmts, generateNestedTFConversions(attrs)...)
+	}
+	if sdkType := input.SDKTypes.CreateRequest; sdkType != "" {
+		stmts = append(stmts, generateNewAtlasReq(attrs, sdkType))
+		stmts = append(stmts, generateNestedAtlasConversions(attrs)...)
+	}
+	if hasUpdateReqConversion(&input) {
+		stmts = append(stmts, generateNewAtlasUpdateReq(attrs, input.SDKTypes.UpdateRequest))
+	}
+	return GroupCodeStatements(stmts, func(list []string) string { return strings.Join(list, "\n\n") })
+}
+
+func generateNewTFModel(attrs codespec.Attributes, sdkType string) CodeStatement {
+	fields := tfModelFields(attrs, "apiResp")
+	return CodeStatement{
+		Code: fmt.Sprintf(`func NewTFModel(ctx context.Context, apiResp *admin.%s) (*TFModel, diag.Diagnostics) {
+	diags := &diag.Diagnostics{}
+	tfModel := &TFModel{
+		%s
+	}
+	if diags.HasError() {
+		return nil, *diags
+	}
+	return tfModel, nil
+}`, sdkType, fields.Code),
+		Imports: append(fields.Imports, diagImportStatement, sdkImportStatement),
+	}
+}
+
+func generateNewAtlasReq(attrs codespec.Attributes, sdkType string) CodeStatement {
+	fields := atlasReqFields(attrs, "plan", createRequestUsage)
+	return CodeStatement{
+		Code: fmt.Sprintf(`func NewAtlasReq(ctx context.Context, plan *TFModel) (*admin.%s, diag.Diagnostics) {
+	diags := &diag.Diagnostics{}
+	atlasReq := &admin.%s{
+		%s
+	}
+	if diags.HasError() {
+		return nil, *diags
+	}
+	return atlasReq, nil
+}`, sdkType, sdkType, fields.Code),
+		Imports: append(fields.Imports, diagImportStatement, sdkImportStatement),
+	}
+}
+
+func generateNewAtlasUpdateReq(attrs codespec.Attributes, sdkType string) CodeStatement {
+	fields := atlasReqFields(attrs, "plan", updateRequestUsage)
+	return CodeStatement{
+		Code: fmt.Sprintf(`func NewAtlasUpdateReq(ctx context.Context, plan *TFModel) (*admin.%s, diag.Diagnostics) {
+	diags := &diag.Diagnostics{}
+	atlasReq := &admin.%s{
+		%s
+	}
+	if diags.HasError() {
+		return nil, *diags
+	}
+	return atlasReq, nil
+}`, sdkType, sdkType, fields.Code),
+		Imports: append(fields.Imports, diagImportStatement, sdkImportStatement),
+	}
+}
+
+func generateNestedTFConversions(attrs codespec.Attributes) []CodeStatement {
+	stmts := []CodeStatement{}
+	for i := range attrs {
+		attr := &attrs[i]
+		nested := nestedObject(attr)
+		if nested == nil || nested.SDKType == "" || !inResponse(attr) {
+			continue
+		}
+		name := attr.Name.PascalCase()
+		fields := tfModelFields(nested.Attributes, "item")
+		var code string
+		switch {
+		case attr.SingleNested != nil:
+			code = fmt.Sprintf(`func newTF%[1]s(ctx context.Context, item *admin.%[2]s, diags *diag.Diagnostics) types.Object {
+	if item == nil {
+		return types.ObjectNull(%[1]sObjType.AttrTypes)
+	}
+	tfModel := TF%[1]sModel{
+		%[3]s
+	}
+	objValue, localDiags := types.ObjectValueFrom(ctx, %[1]sObjType.AttrTypes, tfModel)
+	diags.Append(localDiags...)
+	return objValue
+}`, name, nested.SDKType, fields.Code)
+		case attr.ListNested != nil, attr.SetNested != nil:
+			collection := collectionName(attr)
+			code = fmt.Sprintf(`func newTF%[1]s(ctx context.Context, apiResp *[]admin.%[2]s, diags *diag.Diagnostics) types.%[4]s {
+	if apiResp == nil {
+		return types.%[4]sNull(%[1]sObjType)
+	}
+	tfModels := make([]TF%[1]sModel, len(*apiResp))
+	for i := range *apiResp {
+		item := &(*apiResp)[i]
+		tfModels[i] = TF%[1]sModel{
+			%[3]s
+		}
+	}
+	%[5]sValue, localDiags := types.%[4]sValueFrom(ctx, %[1]sObjType, tfModels)
+	diags.Append(localDiags...)
+	return %[5]sValue
+}`, name, nested.SDKType, fields.Code, collection, strings.ToLower(collection))
+		default:
+			continue
+		}
+		stmts = append(stmts, CodeStatement{
+			Code:    code,
+			Imports: append(fields.Imports, diagImportStatement, typesImportStatement, sdkImportStatement),
+		})
+		stmts = append(stmts, generateNestedTFConversions(nested.Attributes)...)
+	}
+	return stmts
+}
+
+func generateNestedAtlasConversions(attrs codespec.Attributes) []CodeStatement {
+	stmts := []CodeStatement{}
+	for i := range attrs {
+		attr := &attrs[i]
+		nested := nestedObject(attr)
+		if nested == nil || nested.SDKType == "" || !inRequest(attr) {
+			continue
+		}
+		name := attr.Name.PascalCase()
+		fields := atlasReqFields(nested.Attributes, "tfModel", createRequestUsage)
+		var code string
+		imports := []string{diagImportStatement, typesImportStatement, sdkImportStatement}
+		switch {
+		case attr.SingleNested != nil:
+			code = fmt.Sprintf(`func newAtlas%[1]s(ctx context.Context, tfObject types.Object, diags *diag.Diagnostics) *admin.%[2]s {
+	if tfObject.IsNull() || tfObject.IsUnknown() {
+		return nil
+	}
+	tfModel := &TF%[1]sModel{}
+	if localDiags := tfObject.As(ctx, tfModel, basetypes.ObjectAsOptions{}); localDiags.HasError() {
+		diags.Append(localDiags...)
+		return nil
+	}
+	return &admin.%[2]s{
+		%[3]s
+	}
+}`, name, nested.SDKType, fields.Code)
+			imports = append(imports, basetypesImportStatement)
+		case attr.ListNested != nil, attr.SetNested != nil:
+			collection := collectionName(attr)
+			code = fmt.Sprintf(`func newAtlas%[1]s(ctx context.Context, tf%[4]s types.%[4]s, diags *diag.Diagnostics) *[]admin.%[2]s {
+	if tf%[4]s.IsNull() || tf%[4]s.IsUnknown() {
+		return nil
+	}
+	tfModels := []TF%[1]sModel{}
+	if localDiags := tf%[4]s.ElementsAs(ctx, &tfModels, false); localDiags.HasError() {
+		diags.Append(localDiags...)
+		return nil
+	}
+	atlasReq := make([]admin.%[2]s, len(tfModels))
+	for i := range tfModels {
+		tfModel := &tfModels[i]
+		atlasReq[i] = admin.%[2]s{
+			%[3]s
+		}
+	}
+	return &atlasReq
+}`, name, nested.SDKType, fields.Code, collection)
+		default:
+			continue
+		}
+		stmts = append(stmts, CodeStatement{
+			Code:    code,
+			Imports: append(fields.Imports, imports...),
+		})
+		stmts = append(stmts, generateNestedAtlasConversions(nested.Attributes)...)
+	}
+	return stmts
+}
+
+// tfModelFields returns the fields of a TF model struct literal populated from the SDK struct in the receiver variable.
+// Collection attributes not present in the response are set to null as the zero value of collection types can't be stored in the state.
+func tfModelFields(attrs codespec.Attributes, receiver string) CodeStatement {
+	fields := []string{}
+	imports := []string{}
+	for i := range attrs {
+		attr := &attrs[i]
+		value, valueImports, ok := tfValueFromSDK(attr, receiver)
+		if !ok {
+			value = nullTFValue(attr)
+			valueImports = []string{typesImportStatement}
+		}
+		if value == "" {
+			continue
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s,", attr.Name.PascalCase(), value))
+		imports = append(imports, valueImports...)
+	}
+	return CodeStatement{
+		Code:    strings.Join(fields, "\n"),
+		Imports: imports,
+	}
+}
+
+// atlasReqFields returns the fields of an SDK struct literal populated from the TF model in the receiver variable, usage selects the request the struct is used in.
+func atlasReqFields(attrs codespec.Attributes, receiver string, usage func(field *codespec.SDKField) codespec.SDKFieldUsage) CodeStatement {
+	fields := []string{}
+	imports := []string{}
+	for i := range attrs {
+		attr := &attrs[i]
+		value, valueImports, ok := sdkValueFromTF(attr, receiver, usage)
+		if !ok {
+			continue
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s,", attr.SDKField.Name, value))
+		imports = append(imports, valueImports...)
+	}
+	return CodeStatement{
+		Code:    strings.Join(fields, "\n"),
+		Imports: imports,
+	}
+}
+
+func tfValueFromSDK(attr *codespec.Attribute, receiver string) (value string, imports []string, ok bool) {
+	if !inResponse(attr) {
+		return "", nil, false
+	}
+	field := receiver + "." + attr.SDKField.Name
+	isPointer := attr.SDKField.Response == codespec.SDKFieldPointer
+	fieldPtr := field
+	if !isPointer {
+		fieldPtr = "&" + field
+	}
+	imports = []string{typesImportStatement}
+	switch {
+	case attr.String != nil && attr.SDKField.Format == "date-time":
+		imports = append(imports, conversionImportStatement)
+		if isPointer {
+			return fmt.Sprintf("types.StringPointerValue(conversion.TimePtrToStringPtr(%s))", field), imports, true
+		}
+		return fmt.Sprintf("types.StringValue(conversion.TimeToString(%s))", field), imports, true
+	case attr.String != nil:
+		return primitiveTFValue("String", field, isPointer), imports, true
+	case attr.Bool != nil:
+		return primitiveTFValue("Bool", field, isPointer), imports, true
+	case attr.Int64 != nil && attr.SDKField.Format == "int64":
+		return primitiveTFValue("Int64", field, isPointer), imports, true
+	case attr.Int64 != nil:
+		if isPointer {
+			imports = append(imports, conversionImportStatement)
+			return fmt.Sprintf("types.Int64PointerValue(conversion.IntPtrToInt64Ptr(%s))", field), imports, true
+		}
+		return fmt.Sprintf("types.Int64Value(int64(%s))", field), imports, true
+	case attr.Float64 != nil && attr.SDKField.Format == codespec.OASFormatDouble:
+		return primitiveTFValue("Float64", field, isPointer), imports, true
+	case attr.List != nil && sdkElementType[attr.List.ElementType] != "":
+		imports = append(imports, conversionImportStatement)
+		return fmt.Sprintf("conversion.ToTFList(ctx, diags, %s, %s)", elementTypeToString[attr.List.ElementType], fieldPtr), imports, true
+	case attr.Set != nil && sdkElementType[attr.Set.ElementType] != "":
+		imports = append(imports, conversionImportStatement)
+		return fmt.Sprintf("conversion.ToTFSet(ctx, diags, %s, %s)", elementTypeToString[attr.Set.ElementType], fieldPtr), imports, true
+	case attr.Map != nil && attr.Map.ElementType == codespec.String:
+		imports = append(imports, conversionImportStatement)
+		return fmt.Sprintf("conversion.ToTFMapOfString(ctx, diags, %s)", fieldPtr), imports, true
+	case attr.SingleNested != nil, attr.ListNested != nil, attr.SetNested != nil:
+		if nestedObject(attr).SDKType != "" {
+			return fmt.Sprintf("newTF%s(ctx, %s, diags)", attr.Name.PascalCase(), fieldPtr), nil, true
+		}
+	}
+	log.Printf("[WARN] Conversion from SDK field %s to attribute %s is not supported", attr.SDKField.Name, attr.Name)
+	return "", nil, false
+}
+
+func sdkValueFromTF(attr *codespec.Attribute, receiver string, usage func(field *codespec.SDKField) codespec.SDKFieldUsage) (value string, imports []string, ok bool) {
+	if attr.SDKField == nil || usage(attr.SDKField) == codespec.SDKFieldAbsent {
+		return "", nil, false
+	}
+	field := receiver + "." + attr.Name.PascalCase()
+	isPointer := usage(attr.SDKField) == codespec.SDKFieldPointer
+	canBeUnknown := attr.ComputedOptionalRequired == codespec.Computed || attr.ComputedOptionalRequired == codespec.ComputedOptional
+	switch {
+	case attr.String != nil && attr.SDKField.Format != "date-time":
+		return primitiveSDKValue("String", field, isPointer, canBeUnknown)
+	case attr.Bool != nil:
+		return primitiveSDKValue("Bool", field, isPointer, canBeUnknown)
+	case attr.Int64 != nil && attr.SDKField.Format == "int64":
+		return primitiveSDKValue("Int64", field, isPointer, canBeUnknown)
+	case attr.Int64 != nil:
+		if !isPointer {
+			return fmt.Sprintf("int(%s.ValueInt64())", field), nil, true
+		}
+		value, imports, _ := primitiveSDKValue("Int64", field, isPointer, canBeUnknown)
+		return fmt.Sprintf("conversion.Int64PtrToIntPtr(%s)", value), append(imports, conversionImportStatement), true
+	case attr.Float64 != nil && attr.SDKField.Format == codespec.OASFormatDouble:
+		return primitiveSDKValue("Float64", field, isPointer, canBeUnknown)
+	case attr.List != nil && sdkElementType[attr.List.ElementType] != "":
+		value = fmt.Sprintf("conversion.ToSlicePointer[%s](ctx, diags, %s)", sdkElementType[attr.List.ElementType], field)
+		return sdkValueOrPointer(value, isPointer), []string{conversionImportStatement}, true
+	case attr.Set != nil && sdkElementType[attr.Set.ElementType] != "":
+		value = fmt.Sprintf("conversion.ToSlicePointer[%s](ctx, diags, %s)", sdkElementType[attr.Set.ElementType], field)
+		return sdkValueOrPointer(value, isPointer), []string{conversionImportStatement}, true
+	case attr.SingleNested != nil, attr.ListNested != nil, attr.SetNested != nil:
+		if nestedObject(attr).SDKType != "" {
+			value = fmt.Sprintf("newAtlas%s(ctx, %s, diags)", attr.Name.PascalCase(), field)
+			if isPointer {
+				return value, nil, true
+			}
+			return sdkValueOrPointer(value, isPointer), []string{conversionImportStatement}, true
+		}
+	}
+	log.Printf("[WARN] Conversion from attribute %s to SDK field %s is not supported", attr.Name, attr.SDKField.Name)
+	return "", nil, false
+}
+
+func primitiveTFValue(typeName, field string, isPointer bool) string {
+	if isPointer {
+		return fmt.Sprintf("types.%sPointerValue(%s)", typeName, field)
+	}
+	return fmt.Sprintf("types.%sValue(%s)", typeName, field)
+}
+
+func primitiveSDKValue(typeName, field string, isPointer, canBeUnknown bool) (value string, imports []string, ok bool) {
+	switch {
+	case !isPointer:
+		return fmt.Sprintf("%s.Value%s()", field, typeName), nil, true
+	case canBeUnknown:
+		return fmt.Sprintf("conversion.NilForUnknown(%s, %s.Value%sPointer())", field, field, typeName), []string{conversionImportStatement}, true
+	default:
+		return fmt.Sprintf("%s.Value%sPointer()", field, typeName), nil, true
+	}
+}
+
+// sdkValueOrPointer dereferences the slice or struct pointer for fields that are required in the SDK struct.
+func sdkValueOrPointer(value string, isPointer bool) string {
+	if isPointer {
+		return value
+	}
+	return fmt.Sprintf("conversion.SafeValue(%s)", value)
+}
+
+// nullTFValue returns the null value of collection and object attributes, primitive attributes are omitted as their zero value is null.
+func nullTFValue(attr *codespec.Attribute) string {
+	name := attr.Name.PascalCase()
+	switch {
+	case attr.List != nil:
+		return fmt.Sprintf("types.ListNull(%s)", elementTypeToString[attr.List.ElementType])
+	case attr.Set != nil:
+		return fmt.Sprintf("types.SetNull(%s)", elementTypeToString[attr.Set.ElementType])
+	case attr.Map != nil:
+		return fmt.Sprintf("types.MapNull(%s)", elementTypeToString[attr.Map.ElementType])
+	case attr.ListNested != nil:
+		return fmt.Sprintf("types.ListNull(%sObjType)", name)
+	case attr.SetNested != nil:
+		return fmt.Sprintf("types.SetNull(%sObjType)", name)
+	case attr.MapNested != nil:
+		return fmt.Sprintf("types.MapNull(%sObjType)", name)
+	case attr.SingleNested != nil:
+		return fmt.Sprintf("types.ObjectNull(%sObjType.AttrTypes)", name)
+	default:
+		return ""
+	}
+}
+
+func nestedObject(attr *codespec.Attribute) *codespec.NestedAttributeObject {
+	switch {
+	case attr.ListNested != nil:
+		return &attr.ListNested.NestedObject
+	case attr.SetNested != nil:
+		return &attr.SetNested.NestedObject
+	case attr.SingleNested != nil:
+		return &attr.SingleNested.NestedObject
+	case attr.MapNested != nil:
+		return &attr.MapNested.NestedObject
+	default:
+		return nil
+	}
+}
+
+func collectionName(attr *codespec.Attribute) string {
+	if attr.SetNested != nil {
+		return "Set"
+	}
+	return "List"
+}
+
+func inResponse(attr *codespec.Attribute) bool {
+	return attr.SDKField != nil && attr.SDKField.Response != codespec.SDKFieldAbsent
+}
+
+func inRequest(attr *codespec.Attribute) bool {
+	return attr.SDKField != nil && attr.SDKField.Request != codespec.SDKFieldAbsent
+}
+
+func createRequestUsage(field *codespec.SDKField) codespec.SDKFieldUsage {
+	return field.Request
+}
+
+func updateRequestUsage(field *codespec.SDKField) codespec.SDKFieldUsage {
+	return field.UpdateRequest
+}
+
+// hasUpdateReqConversion is true when the update request can't reuse the NewAtlasReq conversion.
+func hasUpdateReqConversion(input *codespec.Resource) bool {
+	sdkTypes := input.SDKTypes
+	return sdkTypes.UpdateRequest != "" && sdkTypes.UpdateRequest != sdkTypes.CreateRequest
+}
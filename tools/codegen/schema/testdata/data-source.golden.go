@@ -0,0 +1,74 @@
+package testname
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+var _ datasource.DataSource = &ds{}
+var _ datasource.DataSourceWithConfigure = &ds{}
+
+func DataSource() datasource.DataSource {
+	return &ds{
+		DSCommon: config.DSCommon{
+			DataSourceName: resourceName,
+		},
+	}
+}
+
+type ds struct {
+	config.DSCommon
+}
+
+func (d *ds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = conversion.DataSourceSchemaFromResource(ResourceSchema(ctx), &conversion.DataSourceSchemaRequest{
+		RequiredFields: []string{"project_id", "cluster_name"},
+	})
+}
+
+func (d *ds) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfConfig TFDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := d.Client.AtlasV2
+	apiResp, _, err := connV2.TestApi.GetTestName(ctx, tfConfig.ProjectId.ValueString(), tfConfig.ClusterName.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error reading data source", err.Error())
+		return
+	}
+
+	newTFModel, diags := NewTFModel(ctx, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	newDSModel := newTFDSModel(newTFModel)
+	newDSModel.ProjectId = tfConfig.ProjectId
+	newDSModel.ClusterName = tfConfig.ClusterName
+	resp.Diagnostics.Append(resp.State.Set(ctx, newDSModel)...)
+}
+
+type TFDSModel struct {
+	ProjectId   types.String `tfsdk:"project_id"`
+	ClusterName types.String `tfsdk:"cluster_name"`
+	StringAttr  types.String `tfsdk:"string_attr"`
+	StateName   types.String `tfsdk:"state_name"`
+}
+
+// newTFDSModel returns the data source model without the resource timeouts.
+func newTFDSModel(tfModel *TFModel) *TFDSModel {
+	return &TFDSModel{
+		ProjectId:   tfModel.ProjectId,
+		ClusterName: tfModel.ClusterName,
+		StringAttr:  tfModel.StringAttr,
+		StateName:   tfModel.StateName,
+	}
+}
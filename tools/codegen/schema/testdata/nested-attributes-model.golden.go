@@ -0,0 +1,144 @@
+package testname
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+func NewTFModel(ctx context.Context, apiResp *admin.TestName) (*TFModel, diag.Diagnostics) {
+	diags := &diag.Diagnostics{}
+	tfModel := &TFModel{
+		NestedSingleAttr:   newTFNestedSingleAttr(ctx, apiResp.NestedSingleAttr, diags),
+		NestedListAttr:     newTFNestedListAttr(ctx, apiResp.NestedListAttr, diags),
+		SetNestedAttribute: newTFSetNestedAttribute(ctx, apiResp.SetNestedAttribute, diags),
+		MapNestedAttribute: types.MapNull(MapNestedAttributeObjType),
+	}
+	if diags.HasError() {
+		return nil, *diags
+	}
+	return tfModel, nil
+}
+
+func newTFNestedSingleAttr(ctx context.Context, item *admin.NestedSingle, diags *diag.Diagnostics) types.Object {
+	if item == nil {
+		return types.ObjectNull(NestedSingleAttrObjType.AttrTypes)
+	}
+	tfModel := TFNestedSingleAttrModel{
+		StringAttr: types.StringPointerValue(item.StringAttr),
+		IntAttr:    types.Int64Value(int64(item.IntAttr)),
+	}
+	objValue, localDiags := types.ObjectValueFrom(ctx, NestedSingleAttrObjType.AttrTypes, tfModel)
+	diags.Append(localDiags...)
+	return objValue
+}
+
+func newTFNestedListAttr(ctx context.Context, apiResp *[]admin.NestedListItem, diags *diag.Diagnostics) types.List {
+	if apiResp == nil {
+		return types.ListNull(NestedListAttrObjType)
+	}
+	tfModels := make([]TFNestedListAttrModel, len(*apiResp))
+	for i := range *apiResp {
+		item := &(*apiResp)[i]
+		tfModels[i] = TFNestedListAttrModel{
+			StringAttr:    types.StringPointerValue(item.StringAttr),
+			InnerListAttr: newTFInnerListAttr(ctx, item.InnerListAttr, diags),
+		}
+	}
+	listValue, localDiags := types.ListValueFrom(ctx, NestedListAttrObjType, tfModels)
+	diags.Append(localDiags...)
+	return listValue
+}
+
+func newTFInnerListAttr(ctx context.Context, item *admin.InnerObject, diags *diag.Diagnostics) types.Object {
+	if item == nil {
+		return types.ObjectNull(InnerListAttrObjType.AttrTypes)
+	}
+	tfModel := TFInnerListAttrModel{
+		IntAttr: types.Int64Value(int64(item.IntAttr)),
+	}
+	objValue, localDiags := types.ObjectValueFrom(ctx, InnerListAttrObjType.AttrTypes, tfModel)
+	diags.Append(localDiags...)
+	return objValue
+}
+
+func newTFSetNestedAttribute(ctx context.Context, apiResp *[]admin.NestedSetItem, diags *diag.Diagnostics) types.Set {
+	if apiResp == nil {
+		return types.SetNull(SetNestedAttributeObjType)
+	}
+	tfModels := make([]TFSetNestedAttributeModel, len(*apiResp))
+	for i := range *apiResp {
+		item := &(*apiResp)[i]
+		tfModels[i] = TFSetNestedAttributeModel{
+			StringAttr: types.StringPointerValue(item.StringAttr),
+		}
+	}
+	setValue, localDiags := types.SetValueFrom(ctx, SetNestedAttributeObjType, tfModels)
+	diags.Append(localDiags...)
+	return setValue
+}
+
+func NewAtlasReq(ctx context.Context, plan *TFModel) (*admin.TestNameCreateRequest, diag.Diagnostics) {
+	diags := &diag.Diagnostics{}
+	atlasReq := &admin.TestNameCreateRequest{
+		NestedSingleAttr: conversion.SafeValue(newAtlasNestedSingleAttr(ctx, plan.NestedSingleAttr, diags)),
+		NestedListAttr:   newAtlasNestedListAttr(ctx, plan.NestedListAttr, diags),
+	}
+	if diags.HasError() {
+		return nil, *diags
+	}
+	return atlasReq, nil
+}
+
+func newAtlasNestedSingleAttr(ctx context.Context, tfObject types.Object, diags *diag.Diagnostics) *admin.NestedSingle {
+	if tfObject.IsNull() || tfObject.IsUnknown() {
+		return nil
+	}
+	tfModel := &TFNestedSingleAttrModel{}
+	if localDiags := tfObject.As(ctx, tfModel, basetypes.ObjectAsOptions{}); localDiags.HasError() {
+		diags.Append(localDiags...)
+		return nil
+	}
+	return &admin.NestedSingle{
+		StringAttr: tfModel.StringAttr.ValueStringPointer(),
+		IntAttr:    int(tfModel.IntAttr.ValueInt64()),
+	}
+}
+
+func newAtlasNestedListAttr(ctx context.Context, tfList types.List, diags *diag.Diagnostics) *[]admin.NestedListItem {
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil
+	}
+	tfModels := []TFNestedListAttrModel{}
+	if localDiags := tfList.ElementsAs(ctx, &tfModels, false); localDiags.HasError() {
+		diags.Append(localDiags...)
+		return nil
+	}
+	atlasReq := make([]admin.NestedListItem, len(tfModels))
+	for i := range tfModels {
+		tfModel := &tfModels[i]
+		atlasReq[i] = admin.NestedListItem{
+			StringAttr:    tfModel.StringAttr.ValueStringPointer(),
+			InnerListAttr: newAtlasInnerListAttr(ctx, tfModel.InnerListAttr, diags),
+		}
+	}
+	return &atlasReq
+}
+
+func newAtlasInnerListAttr(ctx context.Context, tfObject types.Object, diags *diag.Diagnostics) *admin.InnerObject {
+	if tfObject.IsNull() || tfObject.IsUnknown() {
+		return nil
+	}
+	tfModel := &TFInnerListAttrModel{}
+	if localDiags := tfObject.As(ctx, tfModel, basetypes.ObjectAsOptions{}); localDiags.HasError() {
+		diags.Append(localDiags...)
+		return nil
+	}
+	return &admin.InnerObject{
+		IntAttr: int(tfModel.IntAttr.ValueInt64()),
+	}
+}
@@ -0,0 +1,66 @@
+package testname
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+var _ datasource.DataSource = &pluralDS{}
+var _ datasource.DataSourceWithConfigure = &pluralDS{}
+
+func PluralDataSource() datasource.DataSource {
+	return &pluralDS{
+		DSCommon: config.DSCommon{
+			DataSourceName: fmt.Sprintf("%ss", resourceName),
+		},
+	}
+}
+
+type pluralDS struct {
+	config.DSCommon
+}
+
+type TFPluralDSModel struct {
+	ProjectId types.String `tfsdk:"project_id"`
+	Results   []TFModel    `tfsdk:"results"`
+}
+
+func (d *pluralDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = conversion.PluralDataSourceSchemaFromResource(ResourceSchema(ctx), &conversion.PluralDataSourceSchemaRequest{
+		RequiredFields: []string{"project_id"},
+	})
+}
+
+func (d *pluralDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfConfig TFPluralDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := d.Client.AtlasV2
+	apiResp, _, err := connV2.TestApi.ListTestNames(ctx, tfConfig.ProjectId.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error reading plural data source", err.Error())
+		return
+	}
+	results := apiResp.GetResults()
+
+	tfConfig.Results = make([]TFModel, len(results))
+	for i := range results {
+		newTFModel, diags := NewTFModel(ctx, &results[i])
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		tfConfig.Results[i] = *newTFModel
+		tfConfig.Results[i].ProjectId = tfConfig.ProjectId
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfConfig)...)
+}
@@ -0,0 +1,49 @@
+package testname
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+func NewTFModel(ctx context.Context, apiResp *admin.TestName) (*TFModel, diag.Diagnostics) {
+	diags := &diag.Diagnostics{}
+	tfModel := &TFModel{
+		ProjectId:         types.StringPointerValue(apiResp.GroupId),
+		StringAttr:        types.StringPointerValue(apiResp.StringAttr),
+		BoolAttr:          types.BoolPointerValue(apiResp.BoolAttr),
+		IntAttr:           types.Int64PointerValue(conversion.IntPtrToInt64Ptr(apiResp.IntAttr)),
+		Int64Attr:         types.Int64PointerValue(apiResp.Int64Attr),
+		FloatAttr:         types.Float64PointerValue(apiResp.FloatAttr),
+		CreateDate:        types.StringPointerValue(conversion.TimePtrToStringPtr(apiResp.CreateDate)),
+		SimpleListAttr:    conversion.ToTFList(ctx, diags, types.StringType, apiResp.SimpleListAttr),
+		SimpleSetAttr:     conversion.ToTFSet(ctx, diags, types.Int64Type, apiResp.SimpleSetAttr),
+		SimpleMapAttr:     conversion.ToTFMapOfString(ctx, diags, apiResp.SimpleMapAttr),
+		WriteOnlyListAttr: types.ListNull(types.StringType),
+	}
+	if diags.HasError() {
+		return nil, *diags
+	}
+	return tfModel, nil
+}
+
+func NewAtlasReq(ctx context.Context, plan *TFModel) (*admin.TestNameCreateRequest, diag.Diagnostics) {
+	diags := &diag.Diagnostics{}
+	atlasReq := &admin.TestNameCreateRequest{
+		StringAttr:        plan.StringAttr.ValueString(),
+		BoolAttr:          plan.BoolAttr.ValueBoolPointer(),
+		IntAttr:           conversion.Int64PtrToIntPtr(conversion.NilForUnknown(plan.IntAttr, plan.IntAttr.ValueInt64Pointer())),
+		Int64Attr:         plan.Int64Attr.ValueInt64Pointer(),
+		FloatAttr:         plan.FloatAttr.ValueFloat64Pointer(),
+		SimpleListAttr:    conversion.SafeValue(conversion.ToSlicePointer[string](ctx, diags, plan.SimpleListAttr)),
+		SimpleSetAttr:     conversion.ToSlicePointer[int](ctx, diags, plan.SimpleSetAttr),
+		WriteOnlyListAttr: conversion.ToSlicePointer[string](ctx, diags, plan.WriteOnlyListAttr),
+	}
+	if diags.HasError() {
+		return nil, *diags
+	}
+	return atlasReq, nil
+}
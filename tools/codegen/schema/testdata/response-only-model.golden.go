@@ -0,0 +1,20 @@
+package testname
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+)
+
+func NewTFModel(ctx context.Context, apiResp *admin.TestName) (*TFModel, diag.Diagnostics) {
+	diags := &diag.Diagnostics{}
+	tfModel := &TFModel{
+		StringAttr: types.StringValue(apiResp.StringAttr),
+	}
+	if diags.HasError() {
+		return nil, *diags
+	}
+	return tfModel, nil
+}
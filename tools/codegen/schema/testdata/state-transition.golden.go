@@ -0,0 +1,50 @@
+package testname
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"go.mongodb.org/atlas-sdk/v20241113004/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
+)
+
+const (
+	defaultTimeout = 20 * time.Minute
+	minTimeout     = 30 * time.Second
+	retryTimeDelay = 10 * time.Second
+)
+
+// waitStateTransition returns a nil response when the target is the deleted state.
+func waitStateTransition(ctx context.Context, model *TFModel, client admin.TestApi, pendingStates, targetStates []string, timeout time.Duration) (*admin.TestName, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    pendingStates,
+		Target:     targetStates,
+		Refresh:    refreshFunc(ctx, model, client),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      retryTimeDelay,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiResp, _ := result.(*admin.TestName)
+	return apiResp, nil
+}
+
+func refreshFunc(ctx context.Context, model *TFModel, client admin.TestApi) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		apiResp, getResp, err := client.GetTestName(ctx, model.ProjectId.ValueString(), model.ClusterName.ValueString()).Execute()
+		if err != nil {
+			if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+				return "", retrystrategy.RetryStrategyDeletedState, nil
+			}
+			return nil, "", err
+		}
+		return apiResp, apiResp.GetStateName(), nil
+	}
+}
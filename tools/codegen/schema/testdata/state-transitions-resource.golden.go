@@ -0,0 +1,174 @@
+package testname
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const resourceName = "test_name"
+
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
+		},
+	}
+}
+
+type rs struct {
+	config.RSCommon
+}
+
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	atlasReq, diags := NewAtlasReq(ctx, &plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	connV2 := r.Client.AtlasV2
+	_, err := connV2.TestApi.CreateTestName(ctx, plan.ProjectId.ValueString(), plan.ClusterName.ValueString(), atlasReq).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error creating resource", err.Error())
+		return
+	}
+
+	timeout, localDiags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(localDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := waitStateTransition(ctx, &plan, connV2.TestApi, []string{"CREATING"}, []string{"IDLE"}, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("error waiting for resource to be created", err.Error())
+		return
+	}
+
+	newTFModel, diags := NewTFModel(ctx, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	setNonResponseAttributes(newTFModel, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFModel)...)
+}
+
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.Client.AtlasV2
+	apiResp, getResp, err := connV2.TestApi.GetTestName(ctx, state.ProjectId.ValueString(), state.ClusterName.ValueString()).Execute()
+	if err != nil {
+		if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+
+	newTFModel, diags := NewTFModel(ctx, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	setNonResponseAttributes(newTFModel, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFModel)...)
+}
+
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	atlasReq, diags := NewAtlasUpdateReq(ctx, &plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	connV2 := r.Client.AtlasV2
+	_, _, err := connV2.TestApi.UpdateTestName(ctx, plan.ProjectId.ValueString(), plan.ClusterName.ValueString(), atlasReq).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error updating resource", err.Error())
+		return
+	}
+
+	timeout := defaultTimeout
+
+	apiResp, err := waitStateTransition(ctx, &plan, connV2.TestApi, []string{"UPDATING"}, []string{"IDLE"}, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("error waiting for resource to be updated", err.Error())
+		return
+	}
+
+	newTFModel, diags := NewTFModel(ctx, apiResp)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	setNonResponseAttributes(newTFModel, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFModel)...)
+}
+
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.Client.AtlasV2
+	if _, err := connV2.TestApi.DeleteTestName(ctx, state.ProjectId.ValueString(), state.ClusterName.ValueString()).Execute(); err != nil {
+		resp.Diagnostics.AddError("error deleting resource", err.Error())
+		return
+	}
+
+	timeout, localDiags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(localDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := waitStateTransition(ctx, &state, connV2.TestApi, []string{"DELETING"}, []string{"DELETED"}, timeout); err != nil {
+		resp.Diagnostics.AddError("error waiting for resource to be deleted", err.Error())
+		return
+	}
+}
+
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	conversion.ImportStateAttributes(ctx, req, resp, "project_id", "cluster_name")
+}
+
+// setNonResponseAttributes keeps the attributes that are not returned by the API, like path params and timeouts, from the plan or prior state.
+func setNonResponseAttributes(tfModel, prior *TFModel) {
+	tfModel.ProjectId = prior.ProjectId
+	tfModel.ClusterName = prior.ClusterName
+	tfModel.Timeouts = prior.Timeouts
+}
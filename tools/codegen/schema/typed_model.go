@@ -31,8 +31,7 @@ func generateTypedModels(attributes codespec.Attributes, name string, isNested b
 func generateModelObjType(attrs codespec.Attributes, name string) CodeStatement {
 	structProperties := []string{}
 	for i := range attrs {
-		propType := attrModelType(&attrs[i])
-		prop := fmt.Sprintf(`%q: %sType,`, attrs[i].Name.SnakeCase(), propType)
+		prop := fmt.Sprintf(`%q: %s,`, attrs[i].Name.SnakeCase(), attrObjType(&attrs[i]))
 		structProperties = append(structProperties, prop)
 	}
 	structPropsCode := strings.Join(structProperties, "\n")
@@ -44,20 +43,30 @@ func generateModelObjType(attrs codespec.Attributes, name string) CodeStatement
 	}
 }
 
-func getNestedModel(attribute *codespec.Attribute) *CodeStatement {
-	var nested *codespec.NestedAttributeObject
-	if attribute.ListNested != nil {
-		nested = &attribute.ListNested.NestedObject
-	}
-	if attribute.SingleNested != nil {
-		nested = &attribute.SingleNested.NestedObject
-	}
-	if attribute.MapNested != nil {
-		nested = &attribute.MapNested.NestedObject
-	}
-	if attribute.SetNested != nil {
-		nested = &attribute.SetNested.NestedObject
+// attrObjType returns the attr.Type of an attribute inside a nested object, collections need their element type to be defined.
+func attrObjType(attr *codespec.Attribute) string {
+	switch {
+	case attr.List != nil:
+		return fmt.Sprintf("types.ListType{ElemType: %s}", elementTypeToString[attr.List.ElementType])
+	case attr.Set != nil:
+		return fmt.Sprintf("types.SetType{ElemType: %s}", elementTypeToString[attr.Set.ElementType])
+	case attr.Map != nil:
+		return fmt.Sprintf("types.MapType{ElemType: %s}", elementTypeToString[attr.Map.ElementType])
+	case attr.ListNested != nil:
+		return fmt.Sprintf("types.ListType{ElemType: %sObjType}", attr.Name.PascalCase())
+	case attr.SetNested != nil:
+		return fmt.Sprintf("types.SetType{ElemType: %sObjType}", attr.Name.PascalCase())
+	case attr.MapNested != nil:
+		return fmt.Sprintf("types.MapType{ElemType: %sObjType}", attr.Name.PascalCase())
+	case attr.SingleNested != nil:
+		return attr.Name.PascalCase() + "ObjType"
+	default:
+		return attrModelType(attr) + "Type"
 	}
+}
+
+func getNestedModel(attribute *codespec.Attribute) *CodeStatement {
+	nested := nestedObject(attribute)
 	if nested == nil {
 		return nil
 	}
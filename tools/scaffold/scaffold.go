@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -23,6 +24,12 @@ type ScaffoldParams struct {
 	NameCamelCase     string
 	NameSnakeCase     string
 	NameLowerNoSpaces string
+	// ImportIDAttributes are the attributes whose values are joined with "/" in the import ID, e.g. project_id/name.
+	ImportIDAttributes []string
+	// MinVersion is the first provider version with the resource, migration tests are skipped for older versions.
+	MinVersion        string
+	WithMockTest      bool
+	WithMigrationTest bool
 }
 
 type FileGeneration struct {
@@ -31,8 +38,16 @@ type FileGeneration struct {
 }
 
 func main() {
-	nameCamelCase := os.Args[1]
-	generationType := os.Args[2]
+	withMockTest := flag.Bool("with-mock-test", false, "generate a mockable acceptance test using unit.CaptureOrMockTestCaseAndRun and its testdata YAML skeleton")
+	withMigrationTest := flag.Bool("with-migration-test", false, "generate a migration test using mig.CreateAndRunTest")
+	importIDAttributes := flag.String("import-id-attributes", "", "comma-separated attributes that form the import ID, e.g. project_id,name, defaults to project_id when generating mock or migration tests")
+	minVersion := flag.String("min-version", "", "first provider version with the resource, e.g. 1.25.0, the migration test is skipped when the previous provider version is older")
+	flag.Parse()
+	if flag.NArg() != 2 {
+		log.Fatalf("Usage: scaffold [--with-mock-test] [--with-migration-test] [--min-version=1.25.0] [--import-id-attributes=project_id,name] <resource_name> <type>")
+	}
+	nameCamelCase := flag.Arg(0)
+	generationType := flag.Arg(1)
 
 	params := ScaffoldParams{
 		GenerationType:    generationType,
@@ -40,6 +55,12 @@ func main() {
 		NameCamelCase:     nameCamelCase,
 		NameSnakeCase:     ToSnakeCase(nameCamelCase),
 		NameLowerNoSpaces: strings.ToLower(nameCamelCase),
+		MinVersion:        *minVersion,
+		WithMockTest:      *withMockTest,
+		WithMigrationTest: *withMigrationTest,
+	}
+	if *importIDAttributes != "" {
+		params.ImportIDAttributes = strings.Split(*importIDAttributes, ",")
 	}
 
 	files, err := filesToGenerate(&params)
@@ -56,8 +77,18 @@ func main() {
 func filesToGenerate(params *ScaffoldParams) ([]FileGeneration, error) {
 	folderPath := fmt.Sprintf("internal/service/%s", params.NameLowerNoSpaces)
 
+	if (params.WithMockTest || params.WithMigrationTest) && params.GenerationType != ResourceCmd {
+		return nil, errors.New("mock and migration tests can only be generated for resources")
+	}
+	if params.MinVersion != "" && !params.WithMigrationTest {
+		return nil, errors.New("min version can only be used when generating migration tests")
+	}
+
 	switch params.GenerationType {
 	case ResourceCmd:
+		if params.WithMockTest || params.WithMigrationTest {
+			return resourceFilesWithTestCase(params, folderPath), nil
+		}
 		return []FileGeneration{
 			{
 				TemplatePath: "tools/scaffold/template/resource.tmpl",
@@ -143,6 +174,52 @@ func filesToGenerate(params *ScaffoldParams) ([]FileGeneration, error) {
 	}
 }
 
+// resourceFilesWithTestCase generates the acceptance test with a reusable basicTestCase so it can be run as a mock and migration test.
+func resourceFilesWithTestCase(params *ScaffoldParams, folderPath string) []FileGeneration {
+	if len(params.ImportIDAttributes) == 0 {
+		params.ImportIDAttributes = []string{"project_id"}
+	}
+	files := []FileGeneration{
+		{
+			TemplatePath: "tools/scaffold/template/resource.tmpl",
+			OutputPath:   fmt.Sprintf("%s/resource.go", folderPath),
+		},
+		{
+			TemplatePath: "tools/scaffold/template/test_case_acc_test.tmpl",
+			OutputPath:   fmt.Sprintf("%s/resource_test.go", folderPath),
+		},
+		{
+			TemplatePath: "tools/scaffold/template/model.tmpl",
+			OutputPath:   fmt.Sprintf("%s/model.go", folderPath),
+		},
+		{
+			TemplatePath: "tools/scaffold/template/model_test.tmpl",
+			OutputPath:   fmt.Sprintf("%s/model_test.go", folderPath),
+		},
+		{
+			TemplatePath: "tools/scaffold/template/generator_config.tmpl",
+			OutputPath:   fmt.Sprintf("%s/tfplugingen/generator_config.yml", folderPath),
+		},
+		{
+			TemplatePath: "tools/scaffold/template/main_test.tmpl",
+			OutputPath:   fmt.Sprintf("%s/main_test.go", folderPath),
+		},
+	}
+	if params.WithMockTest {
+		files = append(files, FileGeneration{
+			TemplatePath: "tools/scaffold/template/mock_testdata.tmpl",
+			OutputPath:   fmt.Sprintf("%s/testdata/TestAccMockable%s_basic.yaml", folderPath, params.NamePascalCase),
+		})
+	}
+	if params.WithMigrationTest {
+		files = append(files, FileGeneration{
+			TemplatePath: "tools/scaffold/template/migration_test.tmpl",
+			OutputPath:   fmt.Sprintf("%s/resource_migration_test.go", folderPath),
+		})
+	}
+	return files
+}
+
 func generateFileFromTemplate(generation FileGeneration, params *ScaffoldParams) error {
 	tmpl, err := template.ParseFiles(generation.TemplatePath)
 	if err != nil {